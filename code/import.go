@@ -3,6 +3,8 @@
 
 package code
 
+import "strings"
+
 // Import represents a required source code import to ensure that generated code
 // compiles or runs successfully using code from sources external to the
 // generated code file. The syntax and semantics of an import is programming
@@ -23,3 +25,13 @@ type Import struct {
 	// github.com/hashicorp/terraform-plugin-framework/types.
 	Path string `json:"path"`
 }
+
+// Name returns the package name which the Import declares in Go source code,
+// which is the Alias, if set, otherwise the last element of the Path.
+func (i Import) Name() string {
+	if i.Alias != nil {
+		return *i.Alias
+	}
+
+	return i.Path[strings.LastIndex(i.Path, "/")+1:]
+}
@@ -0,0 +1,195 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package code
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ImportSource is implemented by types which contain imports, such as
+// spec.Specification, resource.Resource and datasource.DataSource.
+type ImportSource interface {
+	// CodeImports returns each of the imports within the source, which
+	// may contain duplicates.
+	CodeImports() []Import
+}
+
+// CollectImports returns the de-duplicated imports of each of the given
+// sources. See DeduplicateImports for details of the ordering of the
+// imports, and of the errors returned for conflicting imports.
+func CollectImports(sources ...ImportSource) ([]Import, error) {
+	var imports []Import
+
+	for _, source := range sources {
+		imports = append(imports, source.CodeImports()...)
+	}
+
+	return DeduplicateImports(imports)
+}
+
+// DeduplicateImports returns the distinct imports, sorted by Path and then by
+// Alias, with imports without an Alias ordered first. The returned error
+// joins an *AliasConflictError for each package name which is assigned to
+// more than one Path, and a *PathConflictError for each Path which is
+// assigned more than one Alias, or is imported both with and without an
+// Alias. The package name of an import is its Alias, or for an import without
+// an Alias, the last element of its Path, as returned by Import.Name. Blank
+// (_) and dot (.) aliases may be assigned to any number of paths.
+func DeduplicateImports(imports []Import) ([]Import, error) {
+	type key struct {
+		alias    string
+		hasAlias bool
+		path     string
+	}
+
+	seen := make(map[key]struct{}, len(imports))
+
+	var deduplicated []Import
+
+	for _, i := range imports {
+		k := key{
+			path: i.Path,
+		}
+
+		if i.Alias != nil {
+			k.alias = *i.Alias
+			k.hasAlias = true
+		}
+
+		if _, ok := seen[k]; ok {
+			continue
+		}
+
+		seen[k] = struct{}{}
+
+		deduplicated = append(deduplicated, i)
+	}
+
+	sort.SliceStable(deduplicated, func(i, j int) bool {
+		if deduplicated[i].Path != deduplicated[j].Path {
+			return deduplicated[i].Path < deduplicated[j].Path
+		}
+
+		if deduplicated[i].Alias == nil || deduplicated[j].Alias == nil {
+			return deduplicated[i].Alias == nil && deduplicated[j].Alias != nil
+		}
+
+		return *deduplicated[i].Alias < *deduplicated[j].Alias
+	})
+
+	aliasPaths := make(map[string][]string)
+	pathAliases := make(map[string][]string)
+
+	var aliases, paths []string
+
+	for _, i := range deduplicated {
+		alias := ""
+
+		if i.Alias != nil {
+			alias = *i.Alias
+		}
+
+		if _, ok := pathAliases[i.Path]; !ok {
+			paths = append(paths, i.Path)
+		}
+
+		pathAliases[i.Path] = append(pathAliases[i.Path], alias)
+
+		name := i.Name()
+
+		if name == "_" || name == "." {
+			continue
+		}
+
+		namePaths, ok := aliasPaths[name]
+
+		if !ok {
+			aliases = append(aliases, name)
+		}
+
+		// Imports are sorted by Path, so a Path imported both without an
+		// Alias and with an Alias matching the last element of the Path is
+		// recorded once, as it is reported as a *PathConflictError instead.
+		if len(namePaths) > 0 && namePaths[len(namePaths)-1] == i.Path {
+			continue
+		}
+
+		aliasPaths[name] = append(namePaths, i.Path)
+	}
+
+	sort.Strings(aliases)
+
+	var errs []error
+
+	for _, alias := range aliases {
+		if len(aliasPaths[alias]) < 2 {
+			continue
+		}
+
+		errs = append(errs, &AliasConflictError{
+			Alias: alias,
+			Paths: aliasPaths[alias],
+		})
+	}
+
+	for _, path := range paths {
+		if len(pathAliases[path]) < 2 {
+			continue
+		}
+
+		errs = append(errs, &PathConflictError{
+			Path:    path,
+			Aliases: pathAliases[path],
+		})
+	}
+
+	return deduplicated, errors.Join(errs...)
+}
+
+// AliasConflictError is returned when the same package name, whether an
+// Alias or the last element of an import path without an Alias, is assigned
+// to more than one import path.
+type AliasConflictError struct {
+	// Alias is the conflicting package name.
+	Alias string
+
+	// Paths are the sorted import paths which are assigned the Alias.
+	Paths []string
+}
+
+// Error returns a description of the conflict.
+func (e *AliasConflictError) Error() string {
+	return fmt.Sprintf("import alias %q is assigned to multiple paths: %s", e.Alias, quoteJoin(e.Paths))
+}
+
+// PathConflictError is returned when the same import path is assigned more
+// than one alias, or is imported both with and without an alias.
+type PathConflictError struct {
+	// Path is the conflicting import path.
+	Path string
+
+	// Aliases are the sorted aliases which are assigned to the Path. An
+	// empty string represents an import of the Path without an alias.
+	Aliases []string
+}
+
+// Error returns a description of the conflict.
+func (e *PathConflictError) Error() string {
+	return fmt.Sprintf("import path %q is assigned multiple aliases: %s", e.Path, quoteJoin(e.Aliases))
+}
+
+// quoteJoin returns each of the given strings quoted, and separated by a
+// comma and a space.
+func quoteJoin(s []string) string {
+	quoted := make([]string, 0, len(s))
+
+	for _, v := range s {
+		quoted = append(quoted, fmt.Sprintf("%q", v))
+	}
+
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,340 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package code_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+)
+
+func TestImport_Name(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    code.Import
+		expected string
+	}{
+		"path": {
+			input: code.Import{
+				Path: "github.com/hashicorp/terraform-plugin-framework/types/basetypes",
+			},
+			expected: "basetypes",
+		},
+		"path-single-element": {
+			input: code.Import{
+				Path: "regexp",
+			},
+			expected: "regexp",
+		},
+		"alias": {
+			input: code.Import{
+				Alias: pointer("fwtype"),
+				Path:  "github.com/hashicorp/terraform-plugin-framework/types/basetypes",
+			},
+			expected: "fwtype",
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.Name()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDeduplicateImports(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         []code.Import
+		expected      []code.Import
+		expectedError error
+	}{
+		"nil": {},
+		"duplicates": {
+			input: []code.Import{
+				{
+					Path: "regexp",
+				},
+				{
+					Alias: pointer("fwtype"),
+					Path:  "github.com/hashicorp/terraform-plugin-framework/types/basetypes",
+				},
+				{
+					Path: "regexp",
+				},
+				{
+					Alias: pointer("fwtype"),
+					Path:  "github.com/hashicorp/terraform-plugin-framework/types/basetypes",
+				},
+			},
+			expected: []code.Import{
+				{
+					Alias: pointer("fwtype"),
+					Path:  "github.com/hashicorp/terraform-plugin-framework/types/basetypes",
+				},
+				{
+					Path: "regexp",
+				},
+			},
+		},
+		"blank-and-dot-aliases": {
+			input: []code.Import{
+				{
+					Alias: pointer("_"),
+					Path:  "example.com/two",
+				},
+				{
+					Alias: pointer("_"),
+					Path:  "example.com/one",
+				},
+				{
+					Alias: pointer("."),
+					Path:  "example.com/three",
+				},
+				{
+					Alias: pointer("."),
+					Path:  "example.com/four",
+				},
+			},
+			expected: []code.Import{
+				{
+					Alias: pointer("."),
+					Path:  "example.com/four",
+				},
+				{
+					Alias: pointer("_"),
+					Path:  "example.com/one",
+				},
+				{
+					Alias: pointer("."),
+					Path:  "example.com/three",
+				},
+				{
+					Alias: pointer("_"),
+					Path:  "example.com/two",
+				},
+			},
+		},
+		"alias-conflict": {
+			input: []code.Import{
+				{
+					Alias: pointer("sdk"),
+					Path:  "example.com/sdk/v2",
+				},
+				{
+					Alias: pointer("sdk"),
+					Path:  "example.com/sdk",
+				},
+			},
+			expected: []code.Import{
+				{
+					Alias: pointer("sdk"),
+					Path:  "example.com/sdk",
+				},
+				{
+					Alias: pointer("sdk"),
+					Path:  "example.com/sdk/v2",
+				},
+			},
+			expectedError: fmt.Errorf(`import alias "sdk" is assigned to multiple paths: "example.com/sdk", "example.com/sdk/v2"`),
+		},
+		"implicit-name-conflict": {
+			input: []code.Import{
+				{
+					Path: "example.com/a/types",
+				},
+				{
+					Alias: pointer("types"),
+					Path:  "example.com/b/types",
+				},
+			},
+			expected: []code.Import{
+				{
+					Path: "example.com/a/types",
+				},
+				{
+					Alias: pointer("types"),
+					Path:  "example.com/b/types",
+				},
+			},
+			expectedError: fmt.Errorf(`import alias "types" is assigned to multiple paths: "example.com/a/types", "example.com/b/types"`),
+		},
+		"implicit-names-conflict": {
+			input: []code.Import{
+				{
+					Path: "example.com/b/types",
+				},
+				{
+					Path: "example.com/a/types",
+				},
+			},
+			expected: []code.Import{
+				{
+					Path: "example.com/a/types",
+				},
+				{
+					Path: "example.com/b/types",
+				},
+			},
+			expectedError: fmt.Errorf(`import alias "types" is assigned to multiple paths: "example.com/a/types", "example.com/b/types"`),
+		},
+		"path-conflict": {
+			input: []code.Import{
+				{
+					Alias: pointer("fwtype"),
+					Path:  "github.com/hashicorp/terraform-plugin-framework/types/basetypes",
+				},
+				{
+					Path: "github.com/hashicorp/terraform-plugin-framework/types/basetypes",
+				},
+				{
+					Alias: pointer("basetypes"),
+					Path:  "github.com/hashicorp/terraform-plugin-framework/types/basetypes",
+				},
+			},
+			expected: []code.Import{
+				{
+					Path: "github.com/hashicorp/terraform-plugin-framework/types/basetypes",
+				},
+				{
+					Alias: pointer("basetypes"),
+					Path:  "github.com/hashicorp/terraform-plugin-framework/types/basetypes",
+				},
+				{
+					Alias: pointer("fwtype"),
+					Path:  "github.com/hashicorp/terraform-plugin-framework/types/basetypes",
+				},
+			},
+			expectedError: fmt.Errorf(`import path "github.com/hashicorp/terraform-plugin-framework/types/basetypes" is assigned multiple aliases: "", "basetypes", "fwtype"`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := code.DeduplicateImports(testCase.input)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedError.Error()); diff != "" {
+					t.Errorf("unexpected error difference: %s", diff)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDeduplicateImports_Errors(t *testing.T) {
+	t.Parallel()
+
+	_, err := code.DeduplicateImports([]code.Import{
+		{
+			Alias: pointer("sdk"),
+			Path:  "example.com/sdk",
+		},
+		{
+			Alias: pointer("sdk"),
+			Path:  "example.com/other",
+		},
+		{
+			Alias: pointer("other"),
+			Path:  "example.com/other",
+		},
+	})
+
+	var aliasConflictErr *code.AliasConflictError
+
+	if !errors.As(err, &aliasConflictErr) {
+		t.Fatalf("expected *code.AliasConflictError, got: %s", err)
+	}
+
+	if diff := cmp.Diff(aliasConflictErr, &code.AliasConflictError{
+		Alias: "sdk",
+		Paths: []string{"example.com/other", "example.com/sdk"},
+	}); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	var pathConflictErr *code.PathConflictError
+
+	if !errors.As(err, &pathConflictErr) {
+		t.Fatalf("expected *code.PathConflictError, got: %s", err)
+	}
+
+	if diff := cmp.Diff(pathConflictErr, &code.PathConflictError{
+		Path:    "example.com/other",
+		Aliases: []string{"other", "sdk"},
+	}); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+type importSource []code.Import
+
+func (s importSource) CodeImports() []code.Import {
+	return s
+}
+
+func TestCollectImports(t *testing.T) {
+	t.Parallel()
+
+	got, err := code.CollectImports(
+		importSource{
+			{
+				Path: "regexp",
+			},
+		},
+		importSource{
+			{
+				Path: "math/big",
+			},
+			{
+				Path: "regexp",
+			},
+		},
+	)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+
+	expected := []code.Import{
+		{
+			Path: "math/big",
+		},
+		{
+			Path: "regexp",
+		},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
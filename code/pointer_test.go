@@ -0,0 +1,8 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package code_test
+
+func pointer[T any](in T) *T {
+	return &in
+}
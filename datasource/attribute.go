@@ -12,49 +12,99 @@ import (
 )
 
 // AttributeValidateRequest defines the Path of the attribute that is
-// being validated.
+// being validated, and the Blocks which are siblings of the attribute.
 type AttributeValidateRequest struct {
-	Path string
+	// Path defines the location of the object containing the attributes
+	// being validated, such as a schema or nested object.
+	Path schema.Path
+
+	// Blocks defines the blocks which are siblings of the attributes being
+	// validated, and is used to resolve path expressions.
+	Blocks Blocks
+
+	// Root defines whether the attributes being validated are at the root
+	// of the schema, where reserved names cannot be used
+	// unless ReservedNames is set.
+	Root bool
+
+	// ReservedNames defines whether attributes and blocks at the root of the
+	// schema may use names which Terraform reserves, which is permitted by
+	// version 0.1 of the specification.
+	ReservedNames bool
 }
 
 // Attributes type defines Attribute types.
 type Attributes []Attribute
 
-// Validate checks for duplicated attribute names. Validate is called recursively in
-// instances where an attribute contains nested attributes. Validate delegates to
-// ObjectAttributeTypes.Validate when the attribute is an ObjectAttribute.
+// Validate checks for duplicated attribute names, for reserved names at the
+// root of the schema, for invalid computed_optional_required values, and that
+// the paths of path-relationship validators resolve to an attribute or block.
+// Validate is called recursively in instances where an attribute contains
+// nested attributes. Validate delegates to ObjectAttributeTypes.Validate when
+// the attribute is an ObjectAttribute, and to the Validate method of the
+// attribute validators.
 func (a Attributes) Validate(ctx context.Context, req AttributeValidateRequest) error {
 	attributeNames := make(map[string]struct{}, len(a))
 
 	var errs, nestedErrs []error
 
-	for _, attribute := range a {
+	for k, attribute := range a {
+		path := req.Path.Attribute(attribute.Name, k)
+
 		if _, ok := attributeNames[attribute.Name]; ok {
-			errs = append(errs, fmt.Errorf("%s attribute %q is duplicated", req.Path, attribute.Name))
+			errs = append(errs, path.ErrorDiagnostic("Duplicated attribute", "is duplicated"))
 		}
 
 		attributeNames[attribute.Name] = struct{}{}
 
-		var err error
+		if req.Root && !req.ReservedNames && isReservedName(attribute.Name) {
+			errs = append(errs, path.ErrorDiagnostic("Reserved attribute name", "is a reserved name"))
+		}
+
+		switch computedOptionalRequired := attribute.computedOptionalRequired(); computedOptionalRequired {
+		case "", schema.Computed, schema.ComputedOptional, schema.Optional, schema.Required:
+		default:
+			errs = append(errs, path.ErrorDiagnostic("Invalid computed_optional_required", fmt.Sprintf("computed_optional_required %q must be one of computed, computed_optional, optional or required", computedOptionalRequired)))
+		}
+
+		validatorsErr := attribute.validateValidators(ctx, path)
+
+		if validatorsErr != nil {
+			errs = append(errs, validatorsErr)
+		}
 
-		attributeValidateRequest := AttributeValidateRequest{
-			Path: fmt.Sprintf("%s attribute %q", req.Path, attribute.Name),
+		for _, relationship := range attribute.pathRelationships() {
+			if !resolvesPath(a, req.Blocks, relationship.Path.Steps()) {
+				errs = append(errs, path.ErrorDiagnostic("Unresolved validator path", fmt.Sprintf("%s validator path %q does not resolve to an attribute or block", relationship.Name, relationship.Path)))
+			}
 		}
 
+		var err error
+
+		var attributeValidateRequest AttributeValidateRequest
+
 		objectValidateRequest := schema.ObjectValidateRequest{
-			Path: fmt.Sprintf("%s attribute %q", req.Path, attribute.Name),
+			Path: path,
 		}
 
 		switch {
 		case attribute.ListNested != nil:
+			attributeValidateRequest.Path = path.NestedObject("list_nested")
+
 			err = attribute.ListNested.NestedObject.Attributes.Validate(ctx, attributeValidateRequest)
 		case attribute.MapNested != nil:
+			attributeValidateRequest.Path = path.NestedObject("map_nested")
+
 			err = attribute.MapNested.NestedObject.Attributes.Validate(ctx, attributeValidateRequest)
 		case attribute.Object != nil:
 			err = attribute.Object.AttributeTypes.Validate(ctx, objectValidateRequest)
 		case attribute.SetNested != nil:
+			attributeValidateRequest.Path = path.NestedObject("set_nested")
+
 			err = attribute.SetNested.NestedObject.Attributes.Validate(ctx, attributeValidateRequest)
 		case attribute.SingleNested != nil:
+			attributeValidateRequest.Path = path.NestedObject("single_nested")
+
 			err = attribute.SingleNested.Attributes.Validate(ctx, attributeValidateRequest)
 		}
 
@@ -91,6 +141,119 @@ type Attribute struct {
 	String       *StringAttribute       `json:"string,omitempty"`
 }
 
+// validateValidators delegates to the Validate method of the validators of the
+// attribute type which is set, at the given path.
+func (a Attribute) validateValidators(ctx context.Context, path schema.Path) error {
+	switch {
+	case a.Float64 != nil:
+		return a.Float64.Validators.Validate(ctx, schema.Float64ValidatorsValidateRequest{
+			Path: path,
+		})
+	case a.Int64 != nil:
+		return a.Int64.Validators.Validate(ctx, schema.Int64ValidatorsValidateRequest{
+			Path: path,
+		})
+	case a.Number != nil:
+		return a.Number.Validators.Validate(ctx, schema.NumberValidatorsValidateRequest{
+			Path: path,
+		})
+	}
+
+	return nil
+}
+
+// computedOptionalRequired returns the ComputedOptionalRequired of the
+// attribute type which is set.
+func (a Attribute) computedOptionalRequired() schema.ComputedOptionalRequired {
+	switch {
+	case a.Bool != nil:
+		return a.Bool.ComputedOptionalRequired
+	case a.Dynamic != nil:
+		return a.Dynamic.ComputedOptionalRequired
+	case a.Float64 != nil:
+		return a.Float64.ComputedOptionalRequired
+	case a.Int64 != nil:
+		return a.Int64.ComputedOptionalRequired
+	case a.List != nil:
+		return a.List.ComputedOptionalRequired
+	case a.ListNested != nil:
+		return a.ListNested.ComputedOptionalRequired
+	case a.Map != nil:
+		return a.Map.ComputedOptionalRequired
+	case a.MapNested != nil:
+		return a.MapNested.ComputedOptionalRequired
+	case a.Number != nil:
+		return a.Number.ComputedOptionalRequired
+	case a.Object != nil:
+		return a.Object.ComputedOptionalRequired
+	case a.Set != nil:
+		return a.Set.ComputedOptionalRequired
+	case a.SetNested != nil:
+		return a.SetNested.ComputedOptionalRequired
+	case a.SingleNested != nil:
+		return a.SingleNested.ComputedOptionalRequired
+	case a.String != nil:
+		return a.String.ComputedOptionalRequired
+	}
+
+	return ""
+}
+
+// pathRelationships returns the path-relationship validators of the
+// attribute type which is set.
+func (a Attribute) pathRelationships() schema.PathRelationships {
+	switch {
+	case a.Bool != nil:
+		return a.Bool.Validators.PathRelationships()
+	case a.Dynamic != nil:
+		return a.Dynamic.Validators.PathRelationships()
+	case a.Float64 != nil:
+		return a.Float64.Validators.PathRelationships()
+	case a.Int64 != nil:
+		return a.Int64.Validators.PathRelationships()
+	case a.List != nil:
+		return a.List.Validators.PathRelationships()
+	case a.ListNested != nil:
+		return a.ListNested.Validators.PathRelationships()
+	case a.Map != nil:
+		return a.Map.Validators.PathRelationships()
+	case a.MapNested != nil:
+		return a.MapNested.Validators.PathRelationships()
+	case a.Number != nil:
+		return a.Number.Validators.PathRelationships()
+	case a.Object != nil:
+		return a.Object.Validators.PathRelationships()
+	case a.Set != nil:
+		return a.Set.Validators.PathRelationships()
+	case a.SetNested != nil:
+		return a.SetNested.Validators.PathRelationships()
+	case a.SingleNested != nil:
+		return a.SingleNested.Validators.PathRelationships()
+	case a.String != nil:
+		return a.String.Validators.PathRelationships()
+	}
+
+	return nil
+}
+
+// nestedAttributes returns the nested attributes of the attribute type
+// which is set, or nil if the attribute type does not have nested
+// attributes.
+func (a Attribute) nestedAttributes() Attributes {
+	switch {
+	case a.ListNested != nil:
+		return a.ListNested.NestedObject.Attributes
+	case a.MapNested != nil:
+		return a.MapNested.NestedObject.Attributes
+	case a.SetNested != nil:
+		return a.SetNested.NestedObject.Attributes
+	case a.SingleNested != nil:
+		return a.SingleNested.Attributes
+	}
+
+	return nil
+}
+
 // NestedAttributeObject is the underlying object defining the Attributes
 // for a ListNestedAttribute, MapNestedAttribute, or SetNestedAttribute.
 type NestedAttributeObject struct {
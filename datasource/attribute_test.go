@@ -32,9 +32,9 @@ func TestAttributes_Validate(t *testing.T) {
 				},
 			},
 			request: datasource.AttributeValidateRequest{
-				Path: `datasource "example"`,
+				Path: schema.Path{}.DataSource("example", 0).Schema(),
 			},
-			expectedError: fmt.Errorf(`datasource "example" attribute "attr_one" is duplicated`),
+			expectedError: fmt.Errorf(`data source "example" attribute "attr_one" is duplicated`),
 		},
 		"attribute-names-triplicated": {
 			attributes: datasource.Attributes{
@@ -52,10 +52,10 @@ func TestAttributes_Validate(t *testing.T) {
 				},
 			},
 			request: datasource.AttributeValidateRequest{
-				Path: `datasource "example"`,
+				Path: schema.Path{}.DataSource("example", 0).Schema(),
 			},
-			expectedError: fmt.Errorf(`datasource "example" attribute "attr_one" is duplicated` + "\n" +
-				`datasource "example" attribute "attr_one" is duplicated`),
+			expectedError: fmt.Errorf(`data source "example" attribute "attr_one" is duplicated` + "\n" +
+				`data source "example" attribute "attr_one" is duplicated`),
 		},
 		"attribute-names-unique": {
 			attributes: datasource.Attributes{
@@ -69,7 +69,7 @@ func TestAttributes_Validate(t *testing.T) {
 				},
 			},
 			request: datasource.AttributeValidateRequest{
-				Path: `datasource "example"`,
+				Path: schema.Path{}.DataSource("example", 0).Schema(),
 			},
 		},
 		"list-attribute-names-duplicated": {
@@ -91,9 +91,9 @@ func TestAttributes_Validate(t *testing.T) {
 				},
 			},
 			request: datasource.AttributeValidateRequest{
-				Path: `datasource "example"`,
+				Path: schema.Path{}.DataSource("example", 0).Schema(),
 			},
-			expectedError: fmt.Errorf(`datasource "example" attribute "attr_one" attribute "nested_attr_one" is duplicated`),
+			expectedError: fmt.Errorf(`data source "example" attribute "attr_one" attribute "nested_attr_one" is duplicated`),
 		},
 		"list-nested-attribute-names-triplicated": {
 			attributes: datasource.Attributes{
@@ -117,10 +117,10 @@ func TestAttributes_Validate(t *testing.T) {
 				},
 			},
 			request: datasource.AttributeValidateRequest{
-				Path: `datasource "example"`,
+				Path: schema.Path{}.DataSource("example", 0).Schema(),
 			},
-			expectedError: fmt.Errorf(`datasource "example" attribute "attr_one" attribute "nested_attr_one" is duplicated` + "\n" +
-				`datasource "example" attribute "attr_one" attribute "nested_attr_one" is duplicated`),
+			expectedError: fmt.Errorf(`data source "example" attribute "attr_one" attribute "nested_attr_one" is duplicated` + "\n" +
+				`data source "example" attribute "attr_one" attribute "nested_attr_one" is duplicated`),
 		},
 		"list-nested-attribute-names-unique": {
 			attributes: datasource.Attributes{
@@ -141,7 +141,7 @@ func TestAttributes_Validate(t *testing.T) {
 				},
 			},
 			request: datasource.AttributeValidateRequest{
-				Path: `datasource "example"`,
+				Path: schema.Path{}.DataSource("example", 0).Schema(),
 			},
 		},
 		"attribute-and-list-attribute-names-duplicated": {
@@ -178,11 +178,11 @@ func TestAttributes_Validate(t *testing.T) {
 				},
 			},
 			request: datasource.AttributeValidateRequest{
-				Path: `datasource "example"`,
+				Path: schema.Path{}.DataSource("example", 0).Schema(),
 			},
-			expectedError: fmt.Errorf(`datasource "example" attribute "attr_one" is duplicated` + "\n" +
-				`datasource "example" attribute "attr_one" attribute "nested_attr_one" is duplicated` + "\n" +
-				`datasource "example" attribute "attr_one" attribute "nested_attr_one" is duplicated`),
+			expectedError: fmt.Errorf(`data source "example" attribute "attr_one" is duplicated` + "\n" +
+				`data source "example" attribute "attr_one" attribute "nested_attr_one" is duplicated` + "\n" +
+				`data source "example" attribute "attr_one" attribute "nested_attr_one" is duplicated`),
 		},
 		"object-attribute-type-names-duplicated": {
 			attributes: datasource.Attributes{
@@ -201,9 +201,9 @@ func TestAttributes_Validate(t *testing.T) {
 				},
 			},
 			request: datasource.AttributeValidateRequest{
-				Path: `datasource "example"`,
+				Path: schema.Path{}.DataSource("example", 0).Schema(),
 			},
-			expectedError: fmt.Errorf(`datasource "example" attribute "attr_one" object attribute type "obj_attr_one" is duplicated`),
+			expectedError: fmt.Errorf(`data source "example" attribute "attr_one" object attribute type "obj_attr_one" is duplicated`),
 		},
 		"object-attribute-names-and-type-names-duplicated": {
 			attributes: datasource.Attributes{
@@ -235,11 +235,11 @@ func TestAttributes_Validate(t *testing.T) {
 				},
 			},
 			request: datasource.AttributeValidateRequest{
-				Path: `datasource "example"`,
+				Path: schema.Path{}.DataSource("example", 0).Schema(),
 			},
-			expectedError: fmt.Errorf(`datasource "example" attribute "attr_one" is duplicated` + "\n" +
-				`datasource "example" attribute "attr_one" object attribute type "obj_attr_one" is duplicated` + "\n" +
-				`datasource "example" attribute "attr_one" object attribute type "obj_attr_one" is duplicated`),
+			expectedError: fmt.Errorf(`data source "example" attribute "attr_one" is duplicated` + "\n" +
+				`data source "example" attribute "attr_one" object attribute type "obj_attr_one" is duplicated` + "\n" +
+				`data source "example" attribute "attr_one" object attribute type "obj_attr_one" is duplicated`),
 		},
 		"object-object-attribute-type-names-duplicated": {
 			attributes: datasource.Attributes{
@@ -265,9 +265,215 @@ func TestAttributes_Validate(t *testing.T) {
 				},
 			},
 			request: datasource.AttributeValidateRequest{
-				Path: `datasource "example"`,
+				Path: schema.Path{}.DataSource("example", 0).Schema(),
+			},
+			expectedError: fmt.Errorf(`data source "example" attribute "attr_one" object attribute type "obj_attr_one" object attribute type "nested_obj_attr_one" is duplicated`),
+		},
+		"path-relationship-sibling-attribute": {
+			attributes: datasource.Attributes{
+				{
+					Name: "attr_one",
+					String: &datasource.StringAttribute{
+						ComputedOptionalRequired: schema.Optional,
+						Validators: schema.StringValidators{
+							{
+								ConflictsWith: &schema.PathRelationshipValidator{
+									Paths: schema.PathExpressions{
+										"attr_two",
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "attr_two",
+					String: &datasource.StringAttribute{
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			},
+			request: datasource.AttributeValidateRequest{
+				Path: schema.Path{}.DataSource("example", 0).Schema(),
+			},
+		},
+		"path-relationship-sibling-block": {
+			attributes: datasource.Attributes{
+				{
+					Name: "attr_one",
+					String: &datasource.StringAttribute{
+						ComputedOptionalRequired: schema.Optional,
+						Validators: schema.StringValidators{
+							{
+								ExactlyOneOf: &schema.PathRelationshipValidator{
+									Paths: schema.PathExpressions{
+										"block_one.attr_two",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			request: datasource.AttributeValidateRequest{
+				Path: schema.Path{}.DataSource("example", 0).Schema(),
+				Blocks: datasource.Blocks{
+					{
+						Name: "block_one",
+						SingleNested: &datasource.SingleNestedBlock{
+							Attributes: datasource.Attributes{
+								{
+									Name: "attr_two",
+									Bool: &datasource.BoolAttribute{
+										ComputedOptionalRequired: schema.Optional,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"path-relationship-nested-attribute": {
+			attributes: datasource.Attributes{
+				{
+					Name: "attr_one",
+					Int64: &datasource.Int64Attribute{
+						ComputedOptionalRequired: schema.Optional,
+						Validators: schema.Int64Validators{
+							{
+								AlsoRequires: &schema.PathRelationshipValidator{
+									Paths: schema.PathExpressions{
+										"attr_two.attr_three",
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "attr_two",
+					SingleNested: &datasource.SingleNestedAttribute{
+						Attributes: datasource.Attributes{
+							{
+								Name: "attr_three",
+								Bool: &datasource.BoolAttribute{
+									ComputedOptionalRequired: schema.Optional,
+								},
+							},
+						},
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			},
+			request: datasource.AttributeValidateRequest{
+				Path: schema.Path{}.DataSource("example", 0).Schema(),
+			},
+		},
+		"path-relationship-unresolved": {
+			attributes: datasource.Attributes{
+				{
+					Name: "attr_one",
+					Bool: &datasource.BoolAttribute{
+						ComputedOptionalRequired: schema.Optional,
+						Validators: schema.BoolValidators{
+							{
+								AtLeastOneOf: &schema.PathRelationshipValidator{
+									Paths: schema.PathExpressions{
+										"attr_two.attr_three",
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "attr_two",
+					Bool: &datasource.BoolAttribute{
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			},
+			request: datasource.AttributeValidateRequest{
+				Path: schema.Path{}.DataSource("example", 0).Schema(),
+			},
+			expectedError: fmt.Errorf(`data source "example" attribute "attr_one" at_least_one_of validator path "attr_two.attr_three" does not resolve to an attribute or block`),
+		},
+		"computed-optional-required-invalid": {
+			attributes: datasource.Attributes{
+				{
+					Name: "attr_one",
+					ListNested: &datasource.ListNestedAttribute{
+						ComputedOptionalRequired: schema.Optional,
+						NestedObject: datasource.NestedAttributeObject{
+							Attributes: datasource.Attributes{
+								{
+									Name: "nested_attr_one",
+									String: &datasource.StringAttribute{
+										ComputedOptionalRequired: "optional_computed",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			request: datasource.AttributeValidateRequest{
+				Path: schema.Path{}.DataSource("example", 0).Schema(),
+			},
+			expectedError: fmt.Errorf(`data source "example" attribute "attr_one" attribute "nested_attr_one" computed_optional_required "optional_computed" must be one of computed, computed_optional, optional or required`),
+		},
+		"reserved-name-root": {
+			attributes: datasource.Attributes{
+				{
+					Name: "for_each",
+					String: &datasource.StringAttribute{
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			},
+			request: datasource.AttributeValidateRequest{
+				Path: schema.Path{}.DataSource("example", 0).Schema(),
+				Root: true,
+			},
+			expectedError: fmt.Errorf(`data source "example" attribute "for_each" is a reserved name`),
+		},
+		"reserved-name-root-permitted": {
+			attributes: datasource.Attributes{
+				{
+					Name: "for_each",
+					String: &datasource.StringAttribute{
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			},
+			request: datasource.AttributeValidateRequest{
+				Path:          schema.Path{}.DataSource("example", 0).Schema(),
+				Root:          true,
+				ReservedNames: true,
+			},
+		},
+		"reserved-name-nested": {
+			attributes: datasource.Attributes{
+				{
+					Name: "attr_one",
+					SingleNested: &datasource.SingleNestedAttribute{
+						Attributes: datasource.Attributes{
+							{
+								Name: "for_each",
+								String: &datasource.StringAttribute{
+									ComputedOptionalRequired: schema.Optional,
+								},
+							},
+						},
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			},
+			request: datasource.AttributeValidateRequest{
+				Path: schema.Path{}.DataSource("example", 0).Schema(),
+				Root: true,
 			},
-			expectedError: fmt.Errorf(`datasource "example" attribute "attr_one" object attribute type "obj_attr_one" object attribute type "nested_obj_attr_one" is duplicated`),
 		},
 	}
 
@@ -12,47 +12,88 @@ import (
 )
 
 // BlockValidateRequest defines the Path of the block that is
-// being validated.
+// being validated, and the Attributes which are siblings of the block.
 type BlockValidateRequest struct {
-	Path string
+	// Path defines the location of the object containing the blocks
+	// being validated, such as a schema or nested object.
+	Path schema.Path
+
+	// Attributes defines the attributes which are siblings of the blocks
+	// being validated, and is used to resolve path expressions.
+	Attributes Attributes
+
+	// Root defines whether the blocks being validated are at the root of
+	// the schema, where reserved names cannot be used
+	// unless ReservedNames is set.
+	Root bool
+
+	// ReservedNames defines whether attributes and blocks at the root of the
+	// schema may use names which Terraform reserves, which is permitted by
+	// version 0.1 of the specification.
+	ReservedNames bool
 }
 
 // Blocks type defines Block types.
 type Blocks []Block
 
-// Validate checks for duplicated block names. Validate is called recursively in
-// instances where a block contains nested blocks. Validate delegates to
-// Attributes.Validate in instances where the block has attributes.
+// Validate checks for duplicated block names, for reserved names at the root of
+// the schema, and that the paths of path-relationship validators resolve to an
+// attribute or block. Validate is called recursively in instances where a block
+// contains nested blocks. Validate delegates to Attributes.Validate in
+// instances where the block has attributes.
 func (b Blocks) Validate(ctx context.Context, req BlockValidateRequest) error {
 	blockNames := make(map[string]struct{}, len(b))
 
 	var errs, nestedErrs []error
 
-	for _, block := range b {
+	for k, block := range b {
+		path := req.Path.Block(block.Name, k)
+
 		if _, ok := blockNames[block.Name]; ok {
-			errs = append(errs, fmt.Errorf("%s block %q is duplicated", req.Path, block.Name))
+			errs = append(errs, path.ErrorDiagnostic("Duplicated block", "is duplicated"))
 		}
 
 		blockNames[block.Name] = struct{}{}
 
-		attributeValidateRequest := AttributeValidateRequest{
-			Path: fmt.Sprintf("%s block %q", req.Path, block.Name),
+		if req.Root && !req.ReservedNames && isReservedName(block.Name) {
+			errs = append(errs, path.ErrorDiagnostic("Reserved block name", "is a reserved name"))
 		}
 
-		blockValidateRequest := BlockValidateRequest{
-			Path: fmt.Sprintf("%s block %q", req.Path, block.Name),
+		for _, relationship := range block.pathRelationships() {
+			if !resolvesPath(req.Attributes, b, relationship.Path.Steps()) {
+				errs = append(errs, path.ErrorDiagnostic("Unresolved validator path", fmt.Sprintf("%s validator path %q does not resolve to an attribute or block", relationship.Name, relationship.Path)))
+			}
 		}
 
+		var attributeValidateRequest AttributeValidateRequest
+
+		var blockValidateRequest BlockValidateRequest
+
 		var attributeErr, blockErr error
 
 		switch {
 		case block.ListNested != nil:
+			attributeValidateRequest.Path = path.NestedObject("list_nested")
+			attributeValidateRequest.Blocks = block.ListNested.NestedObject.Blocks
+			blockValidateRequest.Path = path.NestedObject("list_nested")
+			blockValidateRequest.Attributes = block.ListNested.NestedObject.Attributes
+
 			attributeErr = block.ListNested.NestedObject.Attributes.Validate(ctx, attributeValidateRequest)
 			blockErr = block.ListNested.NestedObject.Blocks.Validate(ctx, blockValidateRequest)
 		case block.SetNested != nil:
+			attributeValidateRequest.Path = path.NestedObject("set_nested")
+			attributeValidateRequest.Blocks = block.SetNested.NestedObject.Blocks
+			blockValidateRequest.Path = path.NestedObject("set_nested")
+			blockValidateRequest.Attributes = block.SetNested.NestedObject.Attributes
+
 			attributeErr = block.SetNested.NestedObject.Attributes.Validate(ctx, attributeValidateRequest)
 			blockErr = block.SetNested.NestedObject.Blocks.Validate(ctx, blockValidateRequest)
 		case block.SingleNested != nil:
+			attributeValidateRequest.Path = path.NestedObject("single_nested")
+			attributeValidateRequest.Blocks = block.SingleNested.Blocks
+			blockValidateRequest.Path = path.NestedObject("single_nested")
+			blockValidateRequest.Attributes = block.SingleNested.Attributes
+
 			attributeErr = block.SingleNested.Attributes.Validate(ctx, attributeValidateRequest)
 			blockErr = block.SingleNested.Blocks.Validate(ctx, blockValidateRequest)
 		}
@@ -82,6 +123,21 @@ type Block struct {
 	SingleNested *SingleNestedBlock `json:"single_nested,omitempty"`
 }
 
+// pathRelationships returns the path-relationship validators of the block
+// type which is set.
+func (b Block) pathRelationships() schema.PathRelationships {
+	switch {
+	case b.ListNested != nil:
+		return b.ListNested.Validators.PathRelationships()
+	case b.SetNested != nil:
+		return b.SetNested.Validators.PathRelationships()
+	case b.SingleNested != nil:
+		return b.SingleNested.Validators.PathRelationships()
+	}
+
+	return nil
+}
+
 // NestedBlockObject is the underlying object defining the Attributes
 // for a ListNestedBlock, or SetNestedBlock.
 type NestedBlockObject struct {
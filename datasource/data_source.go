@@ -6,13 +6,20 @@ package datasource
 import (
 	"context"
 	"errors"
-	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 )
 
 // ValidateRequest defines the Path of the data source that is
 // being validated.
 type ValidateRequest struct {
-	Path string
+	// Path defines the location of the data source, such as `data source "example"`.
+	Path schema.Path
+
+	// ReservedNames defines whether attributes and blocks at the root of the
+	// schema may use names which Terraform reserves, which is permitted by
+	// version 0.1 of the specification.
+	ReservedNames bool
 }
 
 // DataSource defines an individual data source.
@@ -30,13 +37,21 @@ func (r DataSource) Validate(ctx context.Context, req ValidateRequest) error {
 		return nil
 	}
 
-	schemaValidateRequest := SchemaValidateRequest(req)
+	schemaValidateRequest := SchemaValidateRequest{
+		Path:          req.Path.Schema(),
+		ReservedNames: req.ReservedNames,
+	}
 
 	return r.Schema.Validate(ctx, schemaValidateRequest)
 }
 
 // DataSourcesValidateRequest defines the request sent during validation of DataSources.
-type DataSourcesValidateRequest struct{}
+type DataSourcesValidateRequest struct {
+	// ReservedNames defines whether attributes and blocks at the root of the
+	// schema may use names which Terraform reserves, which is permitted by
+	// version 0.1 of the specification.
+	ReservedNames bool
+}
 
 // DataSources type defines DataSource types.
 type DataSources []DataSource
@@ -48,15 +63,18 @@ func (rs DataSources) Validate(ctx context.Context, req DataSourcesValidateReque
 
 	var errs, nestedErrs []error
 
-	for _, r := range rs {
+	for k, r := range rs {
+		path := schema.Path{}.DataSource(r.Name, k)
+
 		if _, ok := datasourceNames[r.Name]; ok {
-			errs = append(errs, fmt.Errorf("data source %q is duplicated", r.Name))
+			errs = append(errs, path.ErrorDiagnostic("Duplicated data source", "is duplicated"))
 		}
 
 		datasourceNames[r.Name] = struct{}{}
 
 		validateRequest := ValidateRequest{
-			Path: fmt.Sprintf("data source %q", r.Name),
+			Path:          path,
+			ReservedNames: req.ReservedNames,
 		}
 
 		err := r.Validate(ctx, validateRequest)
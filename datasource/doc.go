@@ -5,3 +5,5 @@
 // such as attributes and blocks, for the Go bindings of
 // the JSON schema specification.
 package datasource
+
+//go:generate go run ../internal/cmd/typedattribute -package datasource
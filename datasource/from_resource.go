@@ -0,0 +1,613 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package datasource
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/diag"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+// FromResourceSchemaOptions defines which attributes of the data source
+// schema can be configured. Each of the paths is relative to the schema, for
+// example "name" refers to a top-level attribute, and "filter.name" refers to
+// an attribute nested within the "filter" attribute or block.
+type FromResourceSchemaOptions struct {
+	// Required defines the attributes which are required.
+	Required schema.PathExpressions
+
+	// Optional defines the attributes which are optional. Optional attributes
+	// are also computed, so that the data source can set the value when the
+	// attribute is not configured.
+	Optional schema.PathExpressions
+}
+
+// FromResource returns a DataSource with the same name as the given
+// Resource, and with the Schema returned by FromResourceSchema. The Identity
+// of the Resource is dropped, in which case a warning diagnostic is returned.
+// The paths of diagnostics are relative to the Resource.
+func FromResource(r resource.Resource, opts FromResourceSchemaOptions) (DataSource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	out := DataSource{
+		Name: r.Name,
+	}
+
+	if r.Schema != nil {
+		s, schemaDiags := fromResourceSchemaAt(schema.Path{}.Schema(), *r.Schema, opts)
+
+		out.Schema = &s
+
+		diags = append(diags, schemaDiags...)
+	}
+
+	if r.Identity != nil {
+		diags = append(diags, schema.Path{}.Identity().WarningDiagnostic("Identity not carried over", "identity is not supported by data sources, so has been dropped"))
+	}
+
+	return out, diags
+}
+
+// FromResourceSchema returns a data source Schema which has the same
+// attributes and blocks as the given resource Schema. Attributes are computed
+// unless they are defined as required or optional by the given options.
+// Custom types, validators, descriptions and deprecation messages are
+// preserved.
+//
+// Resource specific fields, such as Default, PlanModifiers, StateUpgraders
+// and Version, and custom config validators, which are specific to
+// resources, are dropped with a warning diagnostic. A warning diagnostic is
+// also returned for each write-only attribute, which is omitted as its value
+// is never available to a data source, and for each path of the options
+// which does not resolve to an attribute which can be configured. An error
+// diagnostic is returned for each path which is both required and optional,
+// and for each validator path which no longer resolves as it references an
+// omitted write-only attribute. The paths of diagnostics are relative to the
+// Schema.
+func FromResourceSchema(s resource.Schema, opts FromResourceSchemaOptions) (Schema, diag.Diagnostics) {
+	return fromResourceSchemaAt(schema.Path{}, s, opts)
+}
+
+// fromResourceSchemaAt implements FromResourceSchema for the resource Schema
+// at the given path.
+func fromResourceSchemaAt(path schema.Path, s resource.Schema, opts FromResourceSchemaOptions) (Schema, diag.Diagnostics) {
+	c := fromResourceSchema{
+		optional: make(map[schema.PathExpression]bool),
+		required: make(map[schema.PathExpression]bool),
+		resolved: make(map[schema.PathExpression]bool),
+	}
+
+	for _, p := range opts.Required {
+		c.required[p] = true
+	}
+
+	for _, p := range opts.Optional {
+		if c.required[p] {
+			c.diags = append(c.diags, path.ErrorDiagnostic("Conflicting options", fmt.Sprintf("path %q is both required and optional", p)))
+		}
+
+		c.optional[p] = true
+	}
+
+	attributes, blocks := c.object(s.Attributes, s.Blocks, path, "", false)
+
+	out := Schema{
+		Attributes:          attributes,
+		Blocks:              blocks,
+		DeprecationMessage:  s.DeprecationMessage,
+		Description:         s.Description,
+		MarkdownDescription: s.MarkdownDescription,
+	}
+
+	for k, validator := range s.ConfigValidators {
+		if validator.Custom != nil {
+			c.drop(path.ConfigValidator(k), true, "Config validator not carried over", "custom config validator is specific to resources, so has been dropped")
+
+			continue
+		}
+
+		for _, relationship := range validator.PathRelationships() {
+			if !resolvesPath(attributes, blocks, relationship.Path.Steps()) {
+				c.diags = append(c.diags, diag.NewErrorDiagnostic(path.String(), diag.Pointer(path.JSONPointer(), "config_validators", k, relationship.Name), "Unresolved validator path", fmt.Sprintf("%s config validator path %q references an omitted write-only attribute", relationship.Name, relationship.Path)))
+			}
+		}
+
+		out.ConfigValidators = append(out.ConfigValidators, validator)
+	}
+
+	c.drop(path, s.Version != nil, "Version not carried over", "version is not supported by data sources, so has been dropped")
+	c.drop(path, len(s.StateUpgraders) > 0, "State upgraders not carried over", "state upgraders are not supported by data sources, so have been dropped")
+
+	for _, paths := range []struct {
+		name  string
+		paths schema.PathExpressions
+	}{
+		{"required", opts.Required},
+		{"optional", opts.Optional},
+	} {
+		for _, p := range paths.paths {
+			if !c.resolved[p] {
+				c.diags = append(c.diags, path.WarningDiagnostic("Unresolved option path", fmt.Sprintf("%s path %q does not resolve to an attribute which can be configured", paths.name, p)))
+			}
+		}
+	}
+
+	return out, c.diags
+}
+
+// fromResourceSchema holds the state of a FromResourceSchema conversion.
+type fromResourceSchema struct {
+	diags    diag.Diagnostics
+	optional map[schema.PathExpression]bool
+	required map[schema.PathExpression]bool
+	resolved map[schema.PathExpression]bool
+}
+
+// expression returns the PathExpression of the attribute or block with the
+// given name, which is nested within the attribute or block with the given
+// prefix.
+func expression(prefix schema.PathExpression, name string) schema.PathExpression {
+	if prefix == "" {
+		return schema.PathExpression(name)
+	}
+
+	return prefix + "." + schema.PathExpression(name)
+}
+
+// computedOptionalRequired returns the ComputedOptionalRequired of the
+// attribute at the given expression. Attributes nested within a computed
+// attribute are always computed.
+func (c *fromResourceSchema) computedOptionalRequired(path schema.Path, expr schema.PathExpression, parentComputed bool) schema.ComputedOptionalRequired {
+	if !c.required[expr] && !c.optional[expr] {
+		return schema.Computed
+	}
+
+	c.resolved[expr] = true
+
+	if parentComputed {
+		c.diags = append(c.diags, path.WarningDiagnostic("Attribute not configurable", "is nested within a computed attribute, so is computed"))
+
+		return schema.Computed
+	}
+
+	if c.required[expr] {
+		return schema.Required
+	}
+
+	return schema.ComputedOptional
+}
+
+// drop appends a warning diagnostic with the given summary and detail if a
+// field of the element at the given path, which is not supported by data
+// sources, is dropped.
+func (c *fromResourceSchema) drop(path schema.Path, dropped bool, summary, detail string) {
+	if !dropped {
+		return
+	}
+
+	c.diags = append(c.diags, path.WarningDiagnostic(summary, detail))
+}
+
+// dropDefault appends a warning diagnostic if the attribute or block at the
+// given path has a Default.
+func (c *fromResourceSchema) dropDefault(path schema.Path, hasDefault bool) {
+	c.drop(path, hasDefault, "Default not carried over", "default is not supported by data sources, so has been dropped")
+}
+
+// dropPlanModifiers appends a warning diagnostic if the attribute, block or
+// nested object at the given path has PlanModifiers.
+func (c *fromResourceSchema) dropPlanModifiers(path schema.Path, hasPlanModifiers bool) {
+	c.drop(path, hasPlanModifiers, "Plan modifiers not carried over", "plan modifiers are not supported by data sources, so have been dropped")
+}
+
+// object returns the data source equivalent of the given attributes and
+// blocks of a schema or nested object, and appends an error diagnostic for
+// each path of their validators which no longer resolves.
+func (c *fromResourceSchema) object(attributes resource.Attributes, blocks resource.Blocks, path schema.Path, prefix schema.PathExpression, parentComputed bool) (Attributes, Blocks) {
+	outAttributes := c.attributes(attributes, path, prefix, parentComputed)
+	outBlocks := c.blocks(blocks, path, prefix)
+
+	outAttributesByName := make(map[string]Attribute, len(outAttributes))
+
+	for _, a := range outAttributes {
+		outAttributesByName[a.Name] = a
+	}
+
+	for k, a := range attributes {
+		outAttribute, ok := outAttributesByName[a.Name]
+
+		if !ok {
+			continue
+		}
+
+		c.unresolvedPaths(path.Attribute(a.Name, k), outAttribute.pathRelationships(), outAttributes, outBlocks)
+	}
+
+	for k, b := range outBlocks {
+		c.unresolvedPaths(path.Block(b.Name, k), b.pathRelationships(), outAttributes, outBlocks)
+	}
+
+	return outAttributes, outBlocks
+}
+
+// unresolvedPaths appends an error diagnostic for each of the given
+// relationships, of the attribute or block at the given path, which does not
+// resolve against the given sibling attributes and blocks. As the resource
+// schema is expected to be valid, such paths reference an omitted
+// write-only attribute.
+func (c *fromResourceSchema) unresolvedPaths(path schema.Path, relationships schema.PathRelationships, attributes Attributes, blocks Blocks) {
+	for _, relationship := range relationships {
+		if !resolvesPath(attributes, blocks, relationship.Path.Steps()) {
+			c.diags = append(c.diags, path.ErrorDiagnostic("Unresolved validator path", fmt.Sprintf("%s validator path %q references an omitted write-only attribute", relationship.Name, relationship.Path)))
+		}
+	}
+}
+
+// attributes returns the data source equivalent of each of the given
+// attributes, omitting write-only attributes.
+func (c *fromResourceSchema) attributes(in resource.Attributes, path schema.Path, prefix schema.PathExpression, parentComputed bool) Attributes {
+	var out Attributes
+
+	for k, a := range in {
+		attributePath := path.Attribute(a.Name, k)
+
+		attribute, ok := c.attribute(a, attributePath, expression(prefix, a.Name), parentComputed)
+
+		if !ok {
+			continue
+		}
+
+		out = append(out, attribute)
+	}
+
+	return out
+}
+
+// attribute returns the data source equivalent of the given attribute, or
+// false if the attribute is write-only.
+func (c *fromResourceSchema) attribute(a resource.Attribute, path schema.Path, expr schema.PathExpression, parentComputed bool) (Attribute, bool) {
+	var writeOnly *bool
+
+	switch {
+	case a.Bool != nil:
+		writeOnly = a.Bool.WriteOnly
+	case a.Dynamic != nil:
+		writeOnly = a.Dynamic.WriteOnly
+	case a.Float64 != nil:
+		writeOnly = a.Float64.WriteOnly
+	case a.Int64 != nil:
+		writeOnly = a.Int64.WriteOnly
+	case a.List != nil:
+		writeOnly = a.List.WriteOnly
+	case a.ListNested != nil:
+		writeOnly = a.ListNested.WriteOnly
+	case a.Map != nil:
+		writeOnly = a.Map.WriteOnly
+	case a.MapNested != nil:
+		writeOnly = a.MapNested.WriteOnly
+	case a.Number != nil:
+		writeOnly = a.Number.WriteOnly
+	case a.Object != nil:
+		writeOnly = a.Object.WriteOnly
+	case a.Set != nil:
+		writeOnly = a.Set.WriteOnly
+	case a.SetNested != nil:
+		writeOnly = a.SetNested.WriteOnly
+	case a.SingleNested != nil:
+		writeOnly = a.SingleNested.WriteOnly
+	case a.String != nil:
+		writeOnly = a.String.WriteOnly
+	}
+
+	if writeOnly != nil && *writeOnly {
+		c.diags = append(c.diags, path.WarningDiagnostic("Attribute not carried over", "is write-only, so is not available to data sources and has been omitted"))
+
+		return Attribute{}, false
+	}
+
+	cor := c.computedOptionalRequired(path, expr, parentComputed)
+	computed := cor == schema.Computed
+
+	out := Attribute{
+		Name: a.Name,
+	}
+
+	switch {
+	case a.Bool != nil:
+		c.dropDefault(path, a.Bool.Default != nil)
+		c.dropPlanModifiers(path, len(a.Bool.PlanModifiers) > 0)
+
+		out.Bool = &BoolAttribute{
+			AssociatedExternalType:   a.Bool.AssociatedExternalType,
+			ComputedOptionalRequired: cor,
+			CustomType:               a.Bool.CustomType,
+			DeprecationMessage:       a.Bool.DeprecationMessage,
+			Description:              a.Bool.Description,
+			Sensitive:                a.Bool.Sensitive,
+			Validators:               a.Bool.Validators,
+		}
+	case a.Dynamic != nil:
+		c.dropDefault(path, a.Dynamic.Default != nil)
+		c.dropPlanModifiers(path, len(a.Dynamic.PlanModifiers) > 0)
+
+		out.Dynamic = &DynamicAttribute{
+			AssociatedExternalType:   a.Dynamic.AssociatedExternalType,
+			ComputedOptionalRequired: cor,
+			CustomType:               a.Dynamic.CustomType,
+			DeprecationMessage:       a.Dynamic.DeprecationMessage,
+			Description:              a.Dynamic.Description,
+			Sensitive:                a.Dynamic.Sensitive,
+			Validators:               a.Dynamic.Validators,
+		}
+	case a.Float64 != nil:
+		c.dropDefault(path, a.Float64.Default != nil)
+		c.dropPlanModifiers(path, len(a.Float64.PlanModifiers) > 0)
+
+		out.Float64 = &Float64Attribute{
+			AssociatedExternalType:   a.Float64.AssociatedExternalType,
+			ComputedOptionalRequired: cor,
+			CustomType:               a.Float64.CustomType,
+			DeprecationMessage:       a.Float64.DeprecationMessage,
+			Description:              a.Float64.Description,
+			Sensitive:                a.Float64.Sensitive,
+			Validators:               a.Float64.Validators,
+		}
+	case a.Int64 != nil:
+		c.dropDefault(path, a.Int64.Default != nil)
+		c.dropPlanModifiers(path, len(a.Int64.PlanModifiers) > 0)
+
+		out.Int64 = &Int64Attribute{
+			AssociatedExternalType:   a.Int64.AssociatedExternalType,
+			ComputedOptionalRequired: cor,
+			CustomType:               a.Int64.CustomType,
+			DeprecationMessage:       a.Int64.DeprecationMessage,
+			Description:              a.Int64.Description,
+			Sensitive:                a.Int64.Sensitive,
+			Validators:               a.Int64.Validators,
+		}
+	case a.List != nil:
+		c.dropDefault(path, a.List.Default != nil)
+		c.dropPlanModifiers(path, len(a.List.PlanModifiers) > 0)
+
+		out.List = &ListAttribute{
+			AssociatedExternalType:   a.List.AssociatedExternalType,
+			ComputedOptionalRequired: cor,
+			CustomType:               a.List.CustomType,
+			DeprecationMessage:       a.List.DeprecationMessage,
+			Description:              a.List.Description,
+			ElementType:              a.List.ElementType,
+			Sensitive:                a.List.Sensitive,
+			Validators:               a.List.Validators,
+		}
+	case a.ListNested != nil:
+		c.dropDefault(path, a.ListNested.Default != nil)
+		c.dropPlanModifiers(path, len(a.ListNested.PlanModifiers) > 0)
+
+		out.ListNested = &ListNestedAttribute{
+			ComputedOptionalRequired: cor,
+			CustomType:               a.ListNested.CustomType,
+			DeprecationMessage:       a.ListNested.DeprecationMessage,
+			Description:              a.ListNested.Description,
+			NestedObject:             c.nestedAttributeObject(a.ListNested.NestedObject, path.NestedObject("list_nested"), expr, computed),
+			Sensitive:                a.ListNested.Sensitive,
+			Validators:               a.ListNested.Validators,
+		}
+	case a.Map != nil:
+		c.dropDefault(path, a.Map.Default != nil)
+		c.dropPlanModifiers(path, len(a.Map.PlanModifiers) > 0)
+
+		out.Map = &MapAttribute{
+			AssociatedExternalType:   a.Map.AssociatedExternalType,
+			ComputedOptionalRequired: cor,
+			CustomType:               a.Map.CustomType,
+			DeprecationMessage:       a.Map.DeprecationMessage,
+			Description:              a.Map.Description,
+			ElementType:              a.Map.ElementType,
+			Sensitive:                a.Map.Sensitive,
+			Validators:               a.Map.Validators,
+		}
+	case a.MapNested != nil:
+		c.dropDefault(path, a.MapNested.Default != nil)
+		c.dropPlanModifiers(path, len(a.MapNested.PlanModifiers) > 0)
+
+		out.MapNested = &MapNestedAttribute{
+			ComputedOptionalRequired: cor,
+			CustomType:               a.MapNested.CustomType,
+			DeprecationMessage:       a.MapNested.DeprecationMessage,
+			Description:              a.MapNested.Description,
+			NestedObject:             c.nestedAttributeObject(a.MapNested.NestedObject, path.NestedObject("map_nested"), expr, computed),
+			Sensitive:                a.MapNested.Sensitive,
+			Validators:               a.MapNested.Validators,
+		}
+	case a.Number != nil:
+		c.dropDefault(path, a.Number.Default != nil)
+		c.dropPlanModifiers(path, len(a.Number.PlanModifiers) > 0)
+
+		out.Number = &NumberAttribute{
+			AssociatedExternalType:   a.Number.AssociatedExternalType,
+			ComputedOptionalRequired: cor,
+			CustomType:               a.Number.CustomType,
+			DeprecationMessage:       a.Number.DeprecationMessage,
+			Description:              a.Number.Description,
+			Sensitive:                a.Number.Sensitive,
+			Validators:               a.Number.Validators,
+		}
+	case a.Object != nil:
+		c.dropDefault(path, a.Object.Default != nil)
+		c.dropPlanModifiers(path, len(a.Object.PlanModifiers) > 0)
+
+		out.Object = &ObjectAttribute{
+			AssociatedExternalType:   a.Object.AssociatedExternalType,
+			AttributeTypes:           a.Object.AttributeTypes,
+			ComputedOptionalRequired: cor,
+			CustomType:               a.Object.CustomType,
+			DeprecationMessage:       a.Object.DeprecationMessage,
+			Description:              a.Object.Description,
+			Sensitive:                a.Object.Sensitive,
+			Validators:               a.Object.Validators,
+		}
+	case a.Set != nil:
+		c.dropDefault(path, a.Set.Default != nil)
+		c.dropPlanModifiers(path, len(a.Set.PlanModifiers) > 0)
+
+		out.Set = &SetAttribute{
+			AssociatedExternalType:   a.Set.AssociatedExternalType,
+			ComputedOptionalRequired: cor,
+			CustomType:               a.Set.CustomType,
+			DeprecationMessage:       a.Set.DeprecationMessage,
+			Description:              a.Set.Description,
+			ElementType:              a.Set.ElementType,
+			Sensitive:                a.Set.Sensitive,
+			Validators:               a.Set.Validators,
+		}
+	case a.SetNested != nil:
+		c.dropDefault(path, a.SetNested.Default != nil)
+		c.dropPlanModifiers(path, len(a.SetNested.PlanModifiers) > 0)
+
+		out.SetNested = &SetNestedAttribute{
+			ComputedOptionalRequired: cor,
+			CustomType:               a.SetNested.CustomType,
+			DeprecationMessage:       a.SetNested.DeprecationMessage,
+			Description:              a.SetNested.Description,
+			NestedObject:             c.nestedAttributeObject(a.SetNested.NestedObject, path.NestedObject("set_nested"), expr, computed),
+			Sensitive:                a.SetNested.Sensitive,
+			Validators:               a.SetNested.Validators,
+		}
+	case a.SingleNested != nil:
+		c.dropDefault(path, a.SingleNested.Default != nil)
+		c.dropPlanModifiers(path, len(a.SingleNested.PlanModifiers) > 0)
+
+		out.SingleNested = &SingleNestedAttribute{
+			AssociatedExternalType:   a.SingleNested.AssociatedExternalType,
+			Attributes:               c.nestedAttributes(a.SingleNested.Attributes, path.NestedObject("single_nested"), expr, computed),
+			ComputedOptionalRequired: cor,
+			CustomType:               a.SingleNested.CustomType,
+			DeprecationMessage:       a.SingleNested.DeprecationMessage,
+			Description:              a.SingleNested.Description,
+			Sensitive:                a.SingleNested.Sensitive,
+			Validators:               a.SingleNested.Validators,
+		}
+	case a.String != nil:
+		c.dropDefault(path, a.String.Default != nil)
+		c.dropPlanModifiers(path, len(a.String.PlanModifiers) > 0)
+
+		out.String = &StringAttribute{
+			AssociatedExternalType:   a.String.AssociatedExternalType,
+			ComputedOptionalRequired: cor,
+			CustomType:               a.String.CustomType,
+			DeprecationMessage:       a.String.DeprecationMessage,
+			Description:              a.String.Description,
+			Sensitive:                a.String.Sensitive,
+			Validators:               a.String.Validators,
+		}
+	}
+
+	return out, true
+}
+
+// nestedAttributes returns the data source equivalent of the given nested
+// attributes, which cannot contain blocks.
+func (c *fromResourceSchema) nestedAttributes(in resource.Attributes, path schema.Path, prefix schema.PathExpression, parentComputed bool) Attributes {
+	attributes, _ := c.object(in, nil, path, prefix, parentComputed)
+
+	return attributes
+}
+
+// nestedAttributeObject returns the data source equivalent of the given
+// nested attribute object.
+func (c *fromResourceSchema) nestedAttributeObject(o resource.NestedAttributeObject, path schema.Path, prefix schema.PathExpression, parentComputed bool) NestedAttributeObject {
+	c.dropPlanModifiers(path, len(o.PlanModifiers) > 0)
+
+	return NestedAttributeObject{
+		AssociatedExternalType: o.AssociatedExternalType,
+		Attributes:             c.nestedAttributes(o.Attributes, path, prefix, parentComputed),
+		CustomType:             o.CustomType,
+		Validators:             o.Validators,
+	}
+}
+
+// blocks returns the data source equivalent of each of the given blocks.
+func (c *fromResourceSchema) blocks(in resource.Blocks, path schema.Path, prefix schema.PathExpression) Blocks {
+	var out Blocks
+
+	for k, b := range in {
+		out = append(out, c.block(b, path.Block(b.Name, k), expression(prefix, b.Name)))
+	}
+
+	return out
+}
+
+// block returns the data source equivalent of the given block. Blocks cannot
+// be computed, so the ComputedOptionalRequired of the block is preserved.
+func (c *fromResourceSchema) block(b resource.Block, path schema.Path, expr schema.PathExpression) Block {
+	out := Block{
+		Name: b.Name,
+	}
+
+	switch {
+	case b.ListNested != nil:
+		c.dropDefault(path, b.ListNested.Default != nil)
+		c.dropPlanModifiers(path, len(b.ListNested.PlanModifiers) > 0)
+
+		out.ListNested = &ListNestedBlock{
+			ComputedOptionalRequired: b.ListNested.ComputedOptionalRequired,
+			CustomType:               b.ListNested.CustomType,
+			DeprecationMessage:       b.ListNested.DeprecationMessage,
+			Description:              b.ListNested.Description,
+			NestedObject:             c.nestedBlockObject(b.ListNested.NestedObject, path.NestedObject("list_nested"), expr),
+			Sensitive:                b.ListNested.Sensitive,
+			Validators:               b.ListNested.Validators,
+		}
+	case b.SetNested != nil:
+		c.dropDefault(path, b.SetNested.Default != nil)
+		c.dropPlanModifiers(path, len(b.SetNested.PlanModifiers) > 0)
+
+		out.SetNested = &SetNestedBlock{
+			ComputedOptionalRequired: b.SetNested.ComputedOptionalRequired,
+			CustomType:               b.SetNested.CustomType,
+			DeprecationMessage:       b.SetNested.DeprecationMessage,
+			Description:              b.SetNested.Description,
+			NestedObject:             c.nestedBlockObject(b.SetNested.NestedObject, path.NestedObject("set_nested"), expr),
+			Sensitive:                b.SetNested.Sensitive,
+			Validators:               b.SetNested.Validators,
+		}
+	case b.SingleNested != nil:
+		c.dropDefault(path, b.SingleNested.Default != nil)
+		c.dropPlanModifiers(path, len(b.SingleNested.PlanModifiers) > 0)
+
+		attributes, blocks := c.object(b.SingleNested.Attributes, b.SingleNested.Blocks, path.NestedObject("single_nested"), expr, false)
+
+		out.SingleNested = &SingleNestedBlock{
+			AssociatedExternalType:   b.SingleNested.AssociatedExternalType,
+			Attributes:               attributes,
+			Blocks:                   blocks,
+			ComputedOptionalRequired: b.SingleNested.ComputedOptionalRequired,
+			CustomType:               b.SingleNested.CustomType,
+			DeprecationMessage:       b.SingleNested.DeprecationMessage,
+			Description:              b.SingleNested.Description,
+			Sensitive:                b.SingleNested.Sensitive,
+			Validators:               b.SingleNested.Validators,
+		}
+	}
+
+	return out
+}
+
+// nestedBlockObject returns the data source equivalent of the given nested
+// block object.
+func (c *fromResourceSchema) nestedBlockObject(o resource.NestedBlockObject, path schema.Path, prefix schema.PathExpression) NestedBlockObject {
+	c.dropPlanModifiers(path, len(o.PlanModifiers) > 0)
+
+	attributes, blocks := c.object(o.Attributes, o.Blocks, path, prefix, false)
+
+	return NestedBlockObject{
+		AssociatedExternalType: o.AssociatedExternalType,
+		Attributes:             attributes,
+		Blocks:                 blocks,
+		CustomType:             o.CustomType,
+		Validators:             o.Validators,
+	}
+}
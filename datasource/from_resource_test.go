@@ -0,0 +1,500 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package datasource_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/datasource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/diag"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+func TestFromResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	description := "The name of the example."
+
+	testCases := map[string]struct {
+		schema              resource.Schema
+		opts                datasource.FromResourceSchemaOptions
+		expected            datasource.Schema
+		expectedDiagnostics diag.Diagnostics
+	}{
+		"empty": {},
+		"computed": {
+			schema: resource.Schema{
+				Attributes: resource.Attributes{
+					{
+						Name: "name",
+						String: &resource.StringAttribute{
+							ComputedOptionalRequired: schema.Required,
+							CustomType: &schema.CustomType{
+								Type: "my_type",
+							},
+							Description: &description,
+							PlanModifiers: schema.StringPlanModifiers{
+								{
+									Custom: &schema.CustomPlanModifier{
+										SchemaDefinition: "my_plan_modifier.Modify()",
+									},
+								},
+							},
+							Validators: schema.StringValidators{
+								{
+									Custom: &schema.CustomValidator{
+										SchemaDefinition: "my_validator.Validate()",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: datasource.Schema{
+				Attributes: datasource.Attributes{
+					{
+						Name: "name",
+						String: &datasource.StringAttribute{
+							ComputedOptionalRequired: schema.Computed,
+							CustomType: &schema.CustomType{
+								Type: "my_type",
+							},
+							Description: &description,
+							Validators: schema.StringValidators{
+								{
+									Custom: &schema.CustomValidator{
+										SchemaDefinition: "my_validator.Validate()",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedDiagnostics: diag.Diagnostics{
+				schema.Path{}.Attribute("name", 0).WarningDiagnostic("Plan modifiers not carried over", "plan modifiers are not supported by data sources, so have been dropped"),
+			},
+		},
+		"required-optional": {
+			schema: resource.Schema{
+				Attributes: resource.Attributes{
+					{
+						Name: "id",
+						String: &resource.StringAttribute{
+							ComputedOptionalRequired: schema.Computed,
+						},
+					},
+					{
+						Name: "name",
+						String: &resource.StringAttribute{
+							ComputedOptionalRequired: schema.Optional,
+						},
+					},
+					{
+						Name: "tags",
+						Map: &resource.MapAttribute{
+							ComputedOptionalRequired: schema.Optional,
+							ElementType: schema.ElementType{
+								String: &schema.StringType{},
+							},
+						},
+					},
+				},
+			},
+			opts: datasource.FromResourceSchemaOptions{
+				Required: schema.PathExpressions{"id"},
+				Optional: schema.PathExpressions{"name"},
+			},
+			expected: datasource.Schema{
+				Attributes: datasource.Attributes{
+					{
+						Name: "id",
+						String: &datasource.StringAttribute{
+							ComputedOptionalRequired: schema.Required,
+						},
+					},
+					{
+						Name: "name",
+						String: &datasource.StringAttribute{
+							ComputedOptionalRequired: schema.ComputedOptional,
+						},
+					},
+					{
+						Name: "tags",
+						Map: &datasource.MapAttribute{
+							ComputedOptionalRequired: schema.Computed,
+							ElementType: schema.ElementType{
+								String: &schema.StringType{},
+							},
+						},
+					},
+				},
+			},
+		},
+		"nested": {
+			schema: resource.Schema{
+				Attributes: resource.Attributes{
+					{
+						Name: "list_nested",
+						ListNested: &resource.ListNestedAttribute{
+							ComputedOptionalRequired: schema.Optional,
+							NestedObject: resource.NestedAttributeObject{
+								Attributes: resource.Attributes{
+									{
+										Name: "bool",
+										Bool: &resource.BoolAttribute{
+											ComputedOptionalRequired: schema.Optional,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				Blocks: resource.Blocks{
+					{
+						Name: "filter",
+						SingleNested: &resource.SingleNestedBlock{
+							Attributes: resource.Attributes{
+								{
+									Name: "name",
+									String: &resource.StringAttribute{
+										ComputedOptionalRequired: schema.Required,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			opts: datasource.FromResourceSchemaOptions{
+				Optional: schema.PathExpressions{"list_nested", "list_nested.bool", "filter.name"},
+			},
+			expected: datasource.Schema{
+				Attributes: datasource.Attributes{
+					{
+						Name: "list_nested",
+						ListNested: &datasource.ListNestedAttribute{
+							ComputedOptionalRequired: schema.ComputedOptional,
+							NestedObject: datasource.NestedAttributeObject{
+								Attributes: datasource.Attributes{
+									{
+										Name: "bool",
+										Bool: &datasource.BoolAttribute{
+											ComputedOptionalRequired: schema.ComputedOptional,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				Blocks: datasource.Blocks{
+					{
+						Name: "filter",
+						SingleNested: &datasource.SingleNestedBlock{
+							Attributes: datasource.Attributes{
+								{
+									Name: "name",
+									String: &datasource.StringAttribute{
+										ComputedOptionalRequired: schema.ComputedOptional,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"nested-within-computed": {
+			schema: resource.Schema{
+				Attributes: resource.Attributes{
+					{
+						Name: "single_nested",
+						SingleNested: &resource.SingleNestedAttribute{
+							ComputedOptionalRequired: schema.Optional,
+							Attributes: resource.Attributes{
+								{
+									Name: "bool",
+									Bool: &resource.BoolAttribute{
+										ComputedOptionalRequired: schema.Required,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			opts: datasource.FromResourceSchemaOptions{
+				Required: schema.PathExpressions{"single_nested.bool"},
+			},
+			expected: datasource.Schema{
+				Attributes: datasource.Attributes{
+					{
+						Name: "single_nested",
+						SingleNested: &datasource.SingleNestedAttribute{
+							ComputedOptionalRequired: schema.Computed,
+							Attributes: datasource.Attributes{
+								{
+									Name: "bool",
+									Bool: &datasource.BoolAttribute{
+										ComputedOptionalRequired: schema.Computed,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedDiagnostics: diag.Diagnostics{
+				schema.Path{}.Attribute("single_nested", 0).NestedObject("single_nested").Attribute("bool", 0).WarningDiagnostic("Attribute not configurable", "is nested within a computed attribute, so is computed"),
+			},
+		},
+		"default-write-only": {
+			schema: resource.Schema{
+				Attributes: resource.Attributes{
+					{
+						Name: "int64",
+						Int64: &resource.Int64Attribute{
+							ComputedOptionalRequired: schema.ComputedOptional,
+							Default: &schema.Int64Default{
+								Static: pointer(int64(1)),
+							},
+						},
+					},
+					{
+						Name: "password",
+						String: &resource.StringAttribute{
+							ComputedOptionalRequired: schema.Optional,
+							WriteOnly:                pointer(true),
+						},
+					},
+				},
+				Blocks: resource.Blocks{
+					{
+						Name: "set_nested",
+						SetNested: &resource.SetNestedBlock{
+							Default: &schema.SetDefault{
+								Custom: &schema.CustomDefault{
+									SchemaDefinition: "my_default.Default()",
+								},
+							},
+						},
+					},
+				},
+			},
+			opts: datasource.FromResourceSchemaOptions{
+				Optional: schema.PathExpressions{"password"},
+			},
+			expected: datasource.Schema{
+				Attributes: datasource.Attributes{
+					{
+						Name: "int64",
+						Int64: &datasource.Int64Attribute{
+							ComputedOptionalRequired: schema.Computed,
+						},
+					},
+				},
+				Blocks: datasource.Blocks{
+					{
+						Name:      "set_nested",
+						SetNested: &datasource.SetNestedBlock{},
+					},
+				},
+			},
+			expectedDiagnostics: diag.Diagnostics{
+				schema.Path{}.Attribute("int64", 0).WarningDiagnostic("Default not carried over", "default is not supported by data sources, so has been dropped"),
+				schema.Path{}.Attribute("password", 1).WarningDiagnostic("Attribute not carried over", "is write-only, so is not available to data sources and has been omitted"),
+				schema.Path{}.Block("set_nested", 0).WarningDiagnostic("Default not carried over", "default is not supported by data sources, so has been dropped"),
+				schema.Path{}.WarningDiagnostic("Unresolved option path", `optional path "password" does not resolve to an attribute which can be configured`),
+			},
+		},
+		"write-only-validator-paths": {
+			schema: resource.Schema{
+				Attributes: resource.Attributes{
+					{
+						Name: "username",
+						String: &resource.StringAttribute{
+							ComputedOptionalRequired: schema.Optional,
+							Validators: schema.StringValidators{
+								{
+									ConflictsWith: &schema.PathRelationshipValidator{
+										Paths: schema.PathExpressions{"pw"},
+									},
+								},
+							},
+						},
+					},
+					{
+						Name: "pw",
+						String: &resource.StringAttribute{
+							ComputedOptionalRequired: schema.Optional,
+							WriteOnly:                pointer(true),
+						},
+					},
+				},
+				ConfigValidators: schema.ConfigValidators{
+					{
+						Custom: &schema.CustomValidator{
+							SchemaDefinition: "resourcevalidator.Conflicting()",
+						},
+					},
+					{
+						ExactlyOneOf: &schema.PathRelationshipValidator{
+							Paths: schema.PathExpressions{"username", "pw"},
+						},
+					},
+				},
+				Version: pointer(int64(1)),
+				StateUpgraders: resource.StateUpgraders{
+					{
+						PriorVersion:     0,
+						SchemaDefinition: "stateupgrader.Upgrade()",
+					},
+				},
+			},
+			expected: datasource.Schema{
+				Attributes: datasource.Attributes{
+					{
+						Name: "username",
+						String: &datasource.StringAttribute{
+							ComputedOptionalRequired: schema.Computed,
+							Validators: schema.StringValidators{
+								{
+									ConflictsWith: &schema.PathRelationshipValidator{
+										Paths: schema.PathExpressions{"pw"},
+									},
+								},
+							},
+						},
+					},
+				},
+				ConfigValidators: schema.ConfigValidators{
+					{
+						ExactlyOneOf: &schema.PathRelationshipValidator{
+							Paths: schema.PathExpressions{"username", "pw"},
+						},
+					},
+				},
+			},
+			expectedDiagnostics: diag.Diagnostics{
+				schema.Path{}.Attribute("pw", 1).WarningDiagnostic("Attribute not carried over", "is write-only, so is not available to data sources and has been omitted"),
+				schema.Path{}.Attribute("username", 0).ErrorDiagnostic("Unresolved validator path", `conflicts_with validator path "pw" references an omitted write-only attribute`),
+				schema.Path{}.ConfigValidator(0).WarningDiagnostic("Config validator not carried over", "custom config validator is specific to resources, so has been dropped"),
+				diag.NewErrorDiagnostic("", "/config_validators/1/exactly_one_of", "Unresolved validator path", `exactly_one_of config validator path "pw" references an omitted write-only attribute`),
+				schema.Path{}.WarningDiagnostic("Version not carried over", "version is not supported by data sources, so has been dropped"),
+				schema.Path{}.WarningDiagnostic("State upgraders not carried over", "state upgraders are not supported by data sources, so have been dropped"),
+			},
+		},
+		"options-invalid": {
+			schema: resource.Schema{
+				Attributes: resource.Attributes{
+					{
+						Name: "name",
+						String: &resource.StringAttribute{
+							ComputedOptionalRequired: schema.Required,
+						},
+					},
+				},
+			},
+			opts: datasource.FromResourceSchemaOptions{
+				Required: schema.PathExpressions{"name", "missing"},
+				Optional: schema.PathExpressions{"name"},
+			},
+			expected: datasource.Schema{
+				Attributes: datasource.Attributes{
+					{
+						Name: "name",
+						String: &datasource.StringAttribute{
+							ComputedOptionalRequired: schema.Required,
+						},
+					},
+				},
+			},
+			expectedDiagnostics: diag.Diagnostics{
+				schema.Path{}.ErrorDiagnostic("Conflicting options", `path "name" is both required and optional`),
+				schema.Path{}.WarningDiagnostic("Unresolved option path", `required path "missing" does not resolve to an attribute which can be configured`),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := datasource.FromResourceSchema(testCase.schema, testCase.opts)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected schema difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFromResource(t *testing.T) {
+	t.Parallel()
+
+	r := resource.Resource{
+		Name: "example",
+		Schema: &resource.Schema{
+			Attributes: resource.Attributes{
+				{
+					Name: "id",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+						Default: &schema.StringDefault{
+							Static: pointer("example"),
+						},
+					},
+				},
+			},
+		},
+		Identity: &resource.IdentitySchema{
+			Attributes: resource.IdentityAttributes{
+				{
+					Name:   "id",
+					String: &resource.IdentityStringAttribute{},
+				},
+			},
+		},
+	}
+
+	expected := datasource.DataSource{
+		Name: "example",
+		Schema: &datasource.Schema{
+			Attributes: datasource.Attributes{
+				{
+					Name: "id",
+					String: &datasource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+					},
+				},
+			},
+		},
+	}
+
+	expectedDiagnostics := diag.Diagnostics{
+		schema.Path{}.Schema().Attribute("id", 0).WarningDiagnostic("Default not carried over", "default is not supported by data sources, so has been dropped"),
+		schema.Path{}.Identity().WarningDiagnostic("Identity not carried over", "identity is not supported by data sources, so has been dropped"),
+	}
+
+	got, diags := datasource.FromResource(r, datasource.FromResourceSchemaOptions{})
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected data source difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(diags, expectedDiagnostics); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+}
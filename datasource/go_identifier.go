@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package datasource
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+// ValidateGoIdentifiers returns an error for each attribute or block name
+// which generates the same Go identifier as another attribute or block name
+// within the same scope. Nested attributes, blocks and object attribute
+// types, including those of element types, are each validated within their
+// own scope. This validation is opt-in, and is not performed by Validate.
+func (s Schema) ValidateGoIdentifiers(ctx context.Context, req SchemaValidateRequest) error {
+	return goIdentifierErrors(ctx, req.Path, s.Attributes, s.Blocks)
+}
+
+// goIdentifierErrors returns an error for each collision between the Go
+// identifiers generated from the names of the given attributes and blocks,
+// which share a single scope, and for each collision within their nested
+// attributes, blocks and object attribute types.
+func goIdentifierErrors(ctx context.Context, path schema.Path, attributes Attributes, blocks Blocks) error {
+	identifiers := schema.GoIdentifiers{
+		Path: path,
+	}
+
+	var errs, nestedErrs []error
+
+	for k, attribute := range attributes {
+		err := identifiers.Add("attribute", attribute.Name)
+
+		if err != nil {
+			errs = append(errs, err)
+		}
+
+		attributePath := path.Attribute(attribute.Name, k)
+
+		switch {
+		case attribute.ListNested != nil:
+			err = goIdentifierErrors(ctx, attributePath.NestedObject("list_nested"), attribute.ListNested.NestedObject.Attributes, nil)
+		case attribute.MapNested != nil:
+			err = goIdentifierErrors(ctx, attributePath.NestedObject("map_nested"), attribute.MapNested.NestedObject.Attributes, nil)
+		case attribute.SetNested != nil:
+			err = goIdentifierErrors(ctx, attributePath.NestedObject("set_nested"), attribute.SetNested.NestedObject.Attributes, nil)
+		case attribute.SingleNested != nil:
+			err = goIdentifierErrors(ctx, attributePath.NestedObject("single_nested"), attribute.SingleNested.Attributes, nil)
+		case attribute.Object != nil:
+			objectValidateRequest := schema.ObjectValidateRequest{
+				Path: attributePath,
+			}
+
+			err = attribute.Object.AttributeTypes.ValidateGoIdentifiers(ctx, objectValidateRequest)
+		case attribute.List != nil:
+			err = attribute.List.ElementType.ValidateGoIdentifiers(ctx, schema.ObjectValidateRequest{Path: attributePath.ElementType("list")})
+		case attribute.Map != nil:
+			err = attribute.Map.ElementType.ValidateGoIdentifiers(ctx, schema.ObjectValidateRequest{Path: attributePath.ElementType("map")})
+		case attribute.Set != nil:
+			err = attribute.Set.ElementType.ValidateGoIdentifiers(ctx, schema.ObjectValidateRequest{Path: attributePath.ElementType("set")})
+		default:
+			err = nil
+		}
+
+		if err != nil {
+			nestedErrs = append(nestedErrs, err)
+		}
+	}
+
+	for k, block := range blocks {
+		err := identifiers.Add("block", block.Name)
+
+		if err != nil {
+			errs = append(errs, err)
+		}
+
+		blockPath := path.Block(block.Name, k)
+
+		switch {
+		case block.ListNested != nil:
+			err = goIdentifierErrors(ctx, blockPath.NestedObject("list_nested"), block.ListNested.NestedObject.Attributes, block.ListNested.NestedObject.Blocks)
+		case block.SetNested != nil:
+			err = goIdentifierErrors(ctx, blockPath.NestedObject("set_nested"), block.SetNested.NestedObject.Attributes, block.SetNested.NestedObject.Blocks)
+		case block.SingleNested != nil:
+			err = goIdentifierErrors(ctx, blockPath.NestedObject("single_nested"), block.SingleNested.Attributes, block.SingleNested.Blocks)
+		default:
+			err = nil
+		}
+
+		if err != nil {
+			nestedErrs = append(nestedErrs, err)
+		}
+	}
+
+	return errors.Join(append(errs, nestedErrs...)...)
+}
@@ -0,0 +1,232 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package datasource
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+// ValidateGoSyntax returns an error for each custom type, associated external
+// type, and custom default, plan modifier or validator schema definition,
+// within the Schema, which is not valid Go syntax or which references a
+// package that is not declared in its imports. This validation is opt-in,
+// and is not performed by Validate.
+func (s Schema) ValidateGoSyntax(ctx context.Context, req SchemaValidateRequest) error {
+	var errs []error
+
+	for k, configValidator := range s.ConfigValidators {
+		configValidatorReq := schema.GoSyntaxValidateRequest{
+			Path: req.Path.ConfigValidator(k),
+		}
+
+		err := configValidator.Custom.ValidateGoSyntax(ctx, configValidatorReq)
+
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	err := goSyntaxErrors(ctx, req.Path, s.Attributes, s.Blocks)
+
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// goSyntaxErrors delegates to the validateGoSyntax method of each of the
+// given attributes and blocks, and of their nested attributes and blocks.
+func goSyntaxErrors(ctx context.Context, path schema.Path, attributes Attributes, blocks Blocks) error {
+	var errs []error
+
+	for k, attribute := range attributes {
+		attributePath := path.Attribute(attribute.Name, k)
+
+		err := attribute.validateGoSyntax(ctx, attributePath)
+
+		switch {
+		case attribute.ListNested != nil:
+			err = errors.Join(err, goSyntaxErrors(ctx, attributePath.NestedObject("list_nested"), attribute.ListNested.NestedObject.Attributes, nil))
+		case attribute.MapNested != nil:
+			err = errors.Join(err, goSyntaxErrors(ctx, attributePath.NestedObject("map_nested"), attribute.MapNested.NestedObject.Attributes, nil))
+		case attribute.SetNested != nil:
+			err = errors.Join(err, goSyntaxErrors(ctx, attributePath.NestedObject("set_nested"), attribute.SetNested.NestedObject.Attributes, nil))
+		case attribute.SingleNested != nil:
+			err = errors.Join(err, goSyntaxErrors(ctx, attributePath.NestedObject("single_nested"), attribute.SingleNested.Attributes, nil))
+		}
+
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for k, block := range blocks {
+		blockPath := path.Block(block.Name, k)
+
+		err := block.validateGoSyntax(ctx, blockPath)
+
+		switch {
+		case block.ListNested != nil:
+			err = errors.Join(err, goSyntaxErrors(ctx, blockPath.NestedObject("list_nested"), block.ListNested.NestedObject.Attributes, block.ListNested.NestedObject.Blocks))
+		case block.SetNested != nil:
+			err = errors.Join(err, goSyntaxErrors(ctx, blockPath.NestedObject("set_nested"), block.SetNested.NestedObject.Attributes, block.SetNested.NestedObject.Blocks))
+		case block.SingleNested != nil:
+			err = errors.Join(err, goSyntaxErrors(ctx, blockPath.NestedObject("single_nested"), block.SingleNested.Attributes, block.SingleNested.Blocks))
+		}
+
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateGoSyntax delegates to the ValidateGoSyntax method of each of the
+// Go code snippets of the attribute type which is set, excluding those of
+// any nested attributes.
+func (a Attribute) validateGoSyntax(ctx context.Context, path schema.Path) error {
+	req := schema.GoSyntaxValidateRequest{
+		Path: path,
+	}
+
+	switch {
+	case a.Bool != nil:
+		return errors.Join(
+			a.Bool.AssociatedExternalType.ValidateGoSyntax(ctx, req),
+			a.Bool.CustomType.ValidateGoSyntax(ctx, req),
+			a.Bool.Validators.CustomValidators().ValidateGoSyntax(ctx, req),
+		)
+	case a.Dynamic != nil:
+		return errors.Join(
+			a.Dynamic.AssociatedExternalType.ValidateGoSyntax(ctx, req),
+			a.Dynamic.CustomType.ValidateGoSyntax(ctx, req),
+			a.Dynamic.Validators.CustomValidators().ValidateGoSyntax(ctx, req),
+		)
+	case a.Float64 != nil:
+		return errors.Join(
+			a.Float64.AssociatedExternalType.ValidateGoSyntax(ctx, req),
+			a.Float64.CustomType.ValidateGoSyntax(ctx, req),
+			a.Float64.Validators.CustomValidators().ValidateGoSyntax(ctx, req),
+		)
+	case a.Int64 != nil:
+		return errors.Join(
+			a.Int64.AssociatedExternalType.ValidateGoSyntax(ctx, req),
+			a.Int64.CustomType.ValidateGoSyntax(ctx, req),
+			a.Int64.Validators.CustomValidators().ValidateGoSyntax(ctx, req),
+		)
+	case a.List != nil:
+		return errors.Join(
+			a.List.AssociatedExternalType.ValidateGoSyntax(ctx, req),
+			a.List.CustomType.ValidateGoSyntax(ctx, req),
+			a.List.Validators.CustomValidators().ValidateGoSyntax(ctx, req),
+			a.List.ElementType.ValidateGoSyntax(ctx, schema.GoSyntaxValidateRequest{Path: path.ElementType("list")}),
+		)
+	case a.ListNested != nil:
+		return errors.Join(
+			a.ListNested.CustomType.ValidateGoSyntax(ctx, req),
+			a.ListNested.Validators.CustomValidators().ValidateGoSyntax(ctx, req),
+			a.ListNested.NestedObject.AssociatedExternalType.ValidateGoSyntax(ctx, schema.GoSyntaxValidateRequest{Path: path.NestedObject("list_nested")}),
+			a.ListNested.NestedObject.CustomType.ValidateGoSyntax(ctx, schema.GoSyntaxValidateRequest{Path: path.NestedObject("list_nested")}),
+			a.ListNested.NestedObject.Validators.CustomValidators().ValidateGoSyntax(ctx, schema.GoSyntaxValidateRequest{Path: path.NestedObject("list_nested")}),
+		)
+	case a.Map != nil:
+		return errors.Join(
+			a.Map.AssociatedExternalType.ValidateGoSyntax(ctx, req),
+			a.Map.CustomType.ValidateGoSyntax(ctx, req),
+			a.Map.Validators.AllCustomValidators().ValidateGoSyntax(ctx, req),
+			a.Map.ElementType.ValidateGoSyntax(ctx, schema.GoSyntaxValidateRequest{Path: path.ElementType("map")}),
+		)
+	case a.MapNested != nil:
+		return errors.Join(
+			a.MapNested.CustomType.ValidateGoSyntax(ctx, req),
+			a.MapNested.Validators.AllCustomValidators().ValidateGoSyntax(ctx, req),
+			a.MapNested.NestedObject.AssociatedExternalType.ValidateGoSyntax(ctx, schema.GoSyntaxValidateRequest{Path: path.NestedObject("map_nested")}),
+			a.MapNested.NestedObject.CustomType.ValidateGoSyntax(ctx, schema.GoSyntaxValidateRequest{Path: path.NestedObject("map_nested")}),
+			a.MapNested.NestedObject.Validators.CustomValidators().ValidateGoSyntax(ctx, schema.GoSyntaxValidateRequest{Path: path.NestedObject("map_nested")}),
+		)
+	case a.Number != nil:
+		return errors.Join(
+			a.Number.AssociatedExternalType.ValidateGoSyntax(ctx, req),
+			a.Number.CustomType.ValidateGoSyntax(ctx, req),
+			a.Number.Validators.CustomValidators().ValidateGoSyntax(ctx, req),
+		)
+	case a.Object != nil:
+		return errors.Join(
+			a.Object.AssociatedExternalType.ValidateGoSyntax(ctx, req),
+			a.Object.CustomType.ValidateGoSyntax(ctx, req),
+			a.Object.Validators.CustomValidators().ValidateGoSyntax(ctx, req),
+			a.Object.AttributeTypes.ValidateGoSyntax(ctx, req),
+		)
+	case a.Set != nil:
+		return errors.Join(
+			a.Set.AssociatedExternalType.ValidateGoSyntax(ctx, req),
+			a.Set.CustomType.ValidateGoSyntax(ctx, req),
+			a.Set.Validators.CustomValidators().ValidateGoSyntax(ctx, req),
+			a.Set.ElementType.ValidateGoSyntax(ctx, schema.GoSyntaxValidateRequest{Path: path.ElementType("set")}),
+		)
+	case a.SetNested != nil:
+		return errors.Join(
+			a.SetNested.CustomType.ValidateGoSyntax(ctx, req),
+			a.SetNested.Validators.CustomValidators().ValidateGoSyntax(ctx, req),
+			a.SetNested.NestedObject.AssociatedExternalType.ValidateGoSyntax(ctx, schema.GoSyntaxValidateRequest{Path: path.NestedObject("set_nested")}),
+			a.SetNested.NestedObject.CustomType.ValidateGoSyntax(ctx, schema.GoSyntaxValidateRequest{Path: path.NestedObject("set_nested")}),
+			a.SetNested.NestedObject.Validators.CustomValidators().ValidateGoSyntax(ctx, schema.GoSyntaxValidateRequest{Path: path.NestedObject("set_nested")}),
+		)
+	case a.SingleNested != nil:
+		return errors.Join(
+			a.SingleNested.AssociatedExternalType.ValidateGoSyntax(ctx, req),
+			a.SingleNested.CustomType.ValidateGoSyntax(ctx, req),
+			a.SingleNested.Validators.CustomValidators().ValidateGoSyntax(ctx, req),
+		)
+	case a.String != nil:
+		return errors.Join(
+			a.String.AssociatedExternalType.ValidateGoSyntax(ctx, req),
+			a.String.CustomType.ValidateGoSyntax(ctx, req),
+			a.String.Validators.CustomValidators().ValidateGoSyntax(ctx, req),
+		)
+	}
+
+	return nil
+}
+
+// validateGoSyntax delegates to the ValidateGoSyntax method of each of the
+// Go code snippets of the block type which is set, excluding those of any
+// nested attributes and blocks.
+func (b Block) validateGoSyntax(ctx context.Context, path schema.Path) error {
+	req := schema.GoSyntaxValidateRequest{
+		Path: path,
+	}
+
+	switch {
+	case b.ListNested != nil:
+		return errors.Join(
+			b.ListNested.CustomType.ValidateGoSyntax(ctx, req),
+			b.ListNested.Validators.CustomValidators().ValidateGoSyntax(ctx, req),
+			b.ListNested.NestedObject.AssociatedExternalType.ValidateGoSyntax(ctx, schema.GoSyntaxValidateRequest{Path: path.NestedObject("list_nested")}),
+			b.ListNested.NestedObject.CustomType.ValidateGoSyntax(ctx, schema.GoSyntaxValidateRequest{Path: path.NestedObject("list_nested")}),
+			b.ListNested.NestedObject.Validators.CustomValidators().ValidateGoSyntax(ctx, schema.GoSyntaxValidateRequest{Path: path.NestedObject("list_nested")}),
+		)
+	case b.SetNested != nil:
+		return errors.Join(
+			b.SetNested.CustomType.ValidateGoSyntax(ctx, req),
+			b.SetNested.Validators.CustomValidators().ValidateGoSyntax(ctx, req),
+			b.SetNested.NestedObject.AssociatedExternalType.ValidateGoSyntax(ctx, schema.GoSyntaxValidateRequest{Path: path.NestedObject("set_nested")}),
+			b.SetNested.NestedObject.CustomType.ValidateGoSyntax(ctx, schema.GoSyntaxValidateRequest{Path: path.NestedObject("set_nested")}),
+			b.SetNested.NestedObject.Validators.CustomValidators().ValidateGoSyntax(ctx, schema.GoSyntaxValidateRequest{Path: path.NestedObject("set_nested")}),
+		)
+	case b.SingleNested != nil:
+		return errors.Join(
+			b.SingleNested.AssociatedExternalType.ValidateGoSyntax(ctx, req),
+			b.SingleNested.CustomType.ValidateGoSyntax(ctx, req),
+			b.SingleNested.Validators.CustomValidators().ValidateGoSyntax(ctx, req),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package datasource
+
+import (
+	"slices"
+)
+
+// reservedNames defines the names which Terraform reserves for use within
+// data source configuration, and which therefore cannot be used for attributes
+// or blocks at the root of the schema.
+var reservedNames = []string{
+	"count",
+	"depends_on",
+	"for_each",
+	"lifecycle",
+	"provider",
+}
+
+// isReservedName returns true if the given name is reserved.
+func isReservedName(name string) bool {
+	return slices.Contains(reservedNames, name)
+}
@@ -6,6 +6,10 @@ package datasource
 import (
 	"context"
 	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/diag"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 )
 
 // Schema defines the Attributes and Blocks associated with a DataSource.
@@ -16,6 +20,10 @@ type Schema struct {
 	// Blocks defines the Block types for the Schema.
 	Blocks Blocks `json:"blocks,omitempty"`
 
+	// ConfigValidators defines validators which apply to the whole of the
+	// Schema, rather than to an individual attribute or block.
+	ConfigValidators schema.ConfigValidators `json:"config_validators,omitempty"`
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this data source is,
 	// what it's for, and how it should be used. It should be written as
@@ -45,14 +53,27 @@ type Schema struct {
 
 // SchemaValidateRequest specifies the data source being validated.
 type SchemaValidateRequest struct {
-	Path string
+	// Path defines the location of the schema, such as the schema of
+	// `data source "example"`.
+	Path schema.Path
+
+	// ReservedNames defines whether attributes and blocks at the root of the
+	// schema may use names which Terraform reserves, which is permitted by
+	// version 0.1 of the specification.
+	ReservedNames bool
 }
 
-// Validate delegates to Attributes.Validate and Blocks.Validate.
+// Validate checks that the paths of ConfigValidators resolve to an attribute
+// or block, and delegates to Attributes.Validate and Blocks.Validate.
 func (s Schema) Validate(ctx context.Context, req SchemaValidateRequest) error {
 	var errs []error
 
-	attributeValidateRequest := AttributeValidateRequest(req)
+	attributeValidateRequest := AttributeValidateRequest{
+		Path:          req.Path,
+		Blocks:        s.Blocks,
+		Root:          true,
+		ReservedNames: req.ReservedNames,
+	}
 
 	err := s.Attributes.Validate(ctx, attributeValidateRequest)
 
@@ -60,7 +81,12 @@ func (s Schema) Validate(ctx context.Context, req SchemaValidateRequest) error {
 		errs = append(errs, err)
 	}
 
-	blockValidateRequest := BlockValidateRequest(req)
+	blockValidateRequest := BlockValidateRequest{
+		Path:          req.Path,
+		Attributes:    s.Attributes,
+		Root:          true,
+		ReservedNames: req.ReservedNames,
+	}
 
 	err = s.Blocks.Validate(ctx, blockValidateRequest)
 
@@ -68,5 +94,13 @@ func (s Schema) Validate(ctx context.Context, req SchemaValidateRequest) error {
 		errs = append(errs, err)
 	}
 
+	for k, validator := range s.ConfigValidators {
+		for _, relationship := range validator.PathRelationships() {
+			if !resolvesPath(s.Attributes, s.Blocks, relationship.Path.Steps()) {
+				errs = append(errs, diag.NewErrorDiagnostic(req.Path.String(), diag.Pointer(req.Path.JSONPointer(), "config_validators", k, relationship.Name), "Unresolved validator path", fmt.Sprintf("%s config validator path %q does not resolve to an attribute or block", relationship.Name, relationship.Path)))
+			}
+		}
+	}
+
 	return errors.Join(errs...)
 }
@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package datasource_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/datasource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+func TestAttribute_TypedAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute datasource.Attribute
+		expected  schema.TypedAttribute
+	}{
+		"none": {
+			attribute: datasource.Attribute{
+				Name: "example",
+			},
+		},
+		"int64": {
+			attribute: datasource.Attribute{
+				Name: "example",
+				Int64: &datasource.Int64Attribute{
+					ComputedOptionalRequired: schema.Computed,
+				},
+			},
+			expected: &datasource.Int64Attribute{
+				ComputedOptionalRequired: schema.Computed,
+			},
+		},
+		"set-nested": {
+			attribute: datasource.Attribute{
+				Name: "example",
+				SetNested: &datasource.SetNestedAttribute{
+					ComputedOptionalRequired: schema.Optional,
+				},
+			},
+			expected: &datasource.SetNestedAttribute{
+				ComputedOptionalRequired: schema.Optional,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var namedAttribute schema.NamedAttribute = testCase.attribute
+
+			if got := namedAttribute.GetName(); got != "example" {
+				t.Errorf("expected name %q, got %q", "example", got)
+			}
+
+			got := namedAttribute.TypedAttribute()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestInt64Attribute_TypedAttribute(t *testing.T) {
+	t.Parallel()
+
+	custom := &schema.CustomValidator{
+		SchemaDefinition: "my_validator.Validate()",
+	}
+
+	conflictsWith := &schema.PathRelationshipValidator{
+		Paths: schema.PathExpressions{"other"},
+	}
+
+	validators := schema.Int64Validators{
+		{
+			Custom: custom,
+		},
+		{
+			ConflictsWith: conflictsWith,
+		},
+	}
+
+	var got schema.TypedAttribute = datasource.Int64Attribute{
+		ComputedOptionalRequired: schema.Optional,
+		Description:              pointer("description"),
+		Sensitive:                pointer(true),
+		Validators:               validators,
+	}
+
+	if got.AttributeKind() != schema.AttributeKindInt64 {
+		t.Errorf("expected kind %q, got %q", schema.AttributeKindInt64, got.AttributeKind())
+	}
+
+	if got.GetComputedOptionalRequired() != schema.Optional {
+		t.Errorf("expected %q, got %q", schema.Optional, got.GetComputedOptionalRequired())
+	}
+
+	if diff := cmp.Diff(got.GetDescription(), pointer("description")); diff != "" {
+		t.Errorf("unexpected description difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(got.GetSensitive(), pointer(true)); diff != "" {
+		t.Errorf("unexpected sensitive difference: %s", diff)
+	}
+
+	var expectedValidators schema.Validators = validators
+
+	if diff := cmp.Diff(got.GetValidators(), expectedValidators); diff != "" {
+		t.Errorf("unexpected validators difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(got.GetValidators().CustomValidators(), schema.CustomValidators{custom}); diff != "" {
+		t.Errorf("unexpected custom validators difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(got.GetValidators().PathRelationships(), schema.PathRelationships{{Name: "conflicts_with", Path: "other"}}); diff != "" {
+		t.Errorf("unexpected path relationships difference: %s", diff)
+	}
+
+	if got.GetPlanModifiers() != nil {
+		t.Errorf("expected no plan modifiers, got %v", got.GetPlanModifiers())
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package datasource
+
+import (
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+// Walk visits each attribute and block of the Schema depth first, including
+// their nested objects, attributes, blocks, element types and object attribute
+// types. Attributes are visited before blocks. The Path of each
+// schema.WalkNode is relative to the Schema.
+func (s Schema) Walk(v schema.Visitor) error {
+	return walk(v, schema.Path{}, s.Attributes, s.Blocks)
+}
+
+// walk visits the given attributes and blocks, which are members of the
+// object at the given path.
+func walk(v schema.Visitor, path schema.Path, attributes Attributes, blocks Blocks) error {
+	for k, attribute := range attributes {
+		if err := attribute.walk(v, path.Attribute(attribute.Name, k)); err != nil {
+			return err
+		}
+	}
+
+	for k, block := range blocks {
+		if err := block.walk(v, path.Block(block.Name, k)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// walk visits the Attribute at the given path, followed by any nested object,
+// element type or object attribute types.
+func (a Attribute) walk(v schema.Visitor, path schema.Path) error {
+	node := schema.WalkNode{
+		Kind:  schema.WalkNodeKindAttribute,
+		Name:  a.Name,
+		Path:  path,
+		Value: a,
+	}
+
+	return schema.Walk(v, node, func() error {
+		switch {
+		case a.List != nil:
+			return a.List.ElementType.Walk(v, path.ElementType("list"))
+		case a.ListNested != nil:
+			return walkNestedObject(v, path.NestedObject("list_nested"), a.ListNested.NestedObject, a.ListNested.NestedObject.Attributes, nil)
+		case a.Map != nil:
+			return a.Map.ElementType.Walk(v, path.ElementType("map"))
+		case a.MapNested != nil:
+			return walkNestedObject(v, path.NestedObject("map_nested"), a.MapNested.NestedObject, a.MapNested.NestedObject.Attributes, nil)
+		case a.Object != nil:
+			return a.Object.AttributeTypes.Walk(v, path)
+		case a.Set != nil:
+			return a.Set.ElementType.Walk(v, path.ElementType("set"))
+		case a.SetNested != nil:
+			return walkNestedObject(v, path.NestedObject("set_nested"), a.SetNested.NestedObject, a.SetNested.NestedObject.Attributes, nil)
+		case a.SingleNested != nil:
+			return walkNestedObject(v, path.NestedObject("single_nested"), a.SingleNested, a.SingleNested.Attributes, nil)
+		}
+
+		return nil
+	})
+}
+
+// walk visits the Block at the given path, followed by its nested object.
+func (b Block) walk(v schema.Visitor, path schema.Path) error {
+	node := schema.WalkNode{
+		Kind:  schema.WalkNodeKindBlock,
+		Name:  b.Name,
+		Path:  path,
+		Value: b,
+	}
+
+	return schema.Walk(v, node, func() error {
+		switch {
+		case b.ListNested != nil:
+			return walkNestedObject(v, path.NestedObject("list_nested"), b.ListNested.NestedObject, b.ListNested.NestedObject.Attributes, b.ListNested.NestedObject.Blocks)
+		case b.SetNested != nil:
+			return walkNestedObject(v, path.NestedObject("set_nested"), b.SetNested.NestedObject, b.SetNested.NestedObject.Attributes, b.SetNested.NestedObject.Blocks)
+		case b.SingleNested != nil:
+			return walkNestedObject(v, path.NestedObject("single_nested"), b.SingleNested, b.SingleNested.Attributes, b.SingleNested.Blocks)
+		}
+
+		return nil
+	})
+}
+
+// walkNestedObject visits the nested object at the given path, followed by
+// the given attributes and blocks of the nested object.
+func walkNestedObject(v schema.Visitor, path schema.Path, value any, attributes Attributes, blocks Blocks) error {
+	node := schema.WalkNode{
+		Kind:  schema.WalkNodeKindNestedObject,
+		Path:  path,
+		Value: value,
+	}
+
+	return schema.Walk(v, node, func() error {
+		return walk(v, path, attributes, blocks)
+	})
+}
@@ -0,0 +1,231 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package diag
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Severity defines the severity of a Diagnostic.
+type Severity int
+
+const (
+	// SeverityInvalid is the zero value of Severity, and is not valid.
+	SeverityInvalid Severity = iota
+
+	// SeverityError indicates that the specification is invalid.
+	SeverityError
+
+	// SeverityWarning indicates that the specification is valid, but may
+	// not behave as expected.
+	SeverityWarning
+)
+
+// String returns the lower case name of the Severity.
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	}
+
+	return "invalid"
+}
+
+// Diagnostic describes a problem found within a specification.
+type Diagnostic struct {
+	// Severity defines the severity of the problem.
+	Severity Severity
+
+	// Summary is a short description of the problem, such as
+	// "Duplicated attribute".
+	Summary string
+
+	// Detail is a full description of the problem, which does not include
+	// the Path, such as "is duplicated".
+	Detail string
+
+	// Path is the human readable path of the element of the specification
+	// with the problem, such as `resource "example" attribute "id"`.
+	Path string
+
+	// Pointer is the RFC 6901 JSON pointer of the element of the
+	// specification document with the problem, such as
+	// /resources/0/schema/attributes/1. An empty Pointer refers to the whole
+	// document.
+	Pointer string
+
+	// Position is the location of the element with the problem within the
+	// specification document. Position is only known when the Diagnostic
+	// is returned from parsing or validating a document.
+	Position Position
+}
+
+// Position defines a location within a document.
+type Position struct {
+	// Offset is the zero-based byte offset within the document.
+	Offset int
+
+	// Line is the one-based line number, or zero if the Position is unknown.
+	Line int
+
+	// Column is the one-based column number, counted in characters from
+	// the start of the Line.
+	Column int
+}
+
+// NewPosition returns the Position of the given byte offset within the given
+// document.
+func NewPosition(document []byte, offset int) Position {
+	offset = min(max(offset, 0), len(document))
+
+	lineStart := bytes.LastIndexByte(document[:offset], '\n') + 1
+
+	return Position{
+		Offset: offset,
+		Line:   bytes.Count(document[:offset], []byte("\n")) + 1,
+		Column: utf8.RuneCount(document[lineStart:offset]) + 1,
+	}
+}
+
+// IsKnown returns true if the Position refers to a location within a
+// document.
+func (p Position) IsKnown() bool {
+	return p.Line > 0
+}
+
+// String returns the Position as line:column, or an empty string if the
+// Position is unknown.
+func (p Position) String() string {
+	if !p.IsKnown() {
+		return ""
+	}
+
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
+
+// NewErrorDiagnostic returns a Diagnostic with SeverityError for the element
+// at the given path and pointer.
+func NewErrorDiagnostic(path, pointer, summary, detail string) Diagnostic {
+	return Diagnostic{
+		Severity: SeverityError,
+		Summary:  summary,
+		Detail:   detail,
+		Path:     path,
+		Pointer:  pointer,
+	}
+}
+
+// NewWarningDiagnostic returns a Diagnostic with SeverityWarning for the
+// element at the given path and pointer.
+func NewWarningDiagnostic(path, pointer, summary, detail string) Diagnostic {
+	d := NewErrorDiagnostic(path, pointer, summary, detail)
+
+	d.Severity = SeverityWarning
+
+	return d
+}
+
+// Error returns the Path followed by the Detail, such that the Diagnostic
+// reads as a single sentence, prefixed with the Position if known, so that a
+// Diagnostic can be returned wherever an error is expected.
+func (d Diagnostic) Error() string {
+	message := strings.TrimSpace(d.Path + " " + d.Detail)
+
+	if d.Position.IsKnown() {
+		return d.Position.String() + ": " + message
+	}
+
+	return message
+}
+
+// Diagnostics type defines Diagnostic types.
+type Diagnostics []Diagnostic
+
+// Error returns the error of each Diagnostic, separated by newlines, in the
+// same format as errors.Join.
+func (d Diagnostics) Error() string {
+	details := make([]string, 0, len(d))
+
+	for _, diagnostic := range d {
+		details = append(details, diagnostic.Error())
+	}
+
+	return strings.Join(details, "\n")
+}
+
+// Err returns the Diagnostics as an error, or nil if there are no
+// Diagnostics with SeverityError.
+func (d Diagnostics) Err() error {
+	if !d.HasError() {
+		return nil
+	}
+
+	return d
+}
+
+// HasError returns true if any Diagnostic has SeverityError.
+func (d Diagnostics) HasError() bool {
+	for _, diagnostic := range d {
+		if diagnostic.Severity == SeverityError {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FromError returns the Diagnostics within the given error, which may be a
+// Diagnostic, Diagnostics, or an error which wraps or joins these, such as an
+// error returned by any of the Validate methods. Any other error, such as a
+// JSON syntax error, is returned as a Diagnostic with SeverityError which
+// refers to the whole document.
+func FromError(err error) Diagnostics {
+	if err == nil {
+		return nil
+	}
+
+	switch e := err.(type) {
+	case Diagnostic:
+		return Diagnostics{e}
+	case Diagnostics:
+		return e
+	case interface{ Unwrap() []error }:
+		var diagnostics Diagnostics
+
+		for _, wrapped := range e.Unwrap() {
+			diagnostics = append(diagnostics, FromError(wrapped)...)
+		}
+
+		return diagnostics
+	}
+
+	return Diagnostics{
+		{
+			Severity: SeverityError,
+			Summary:  "Invalid specification",
+			Detail:   err.Error(),
+		},
+	}
+}
+
+// Pointer returns the given RFC 6901 JSON pointer with each of the given
+// reference tokens appended. Each token is formatted with fmt.Sprint, and
+// escaped, so that object member names may contain "~" or "/".
+func Pointer(pointer string, tokens ...any) string {
+	var b strings.Builder
+
+	b.WriteString(pointer)
+
+	for _, token := range tokens {
+		b.WriteString("/")
+		b.WriteString(strings.NewReplacer("~", "~0", "/", "~1").Replace(fmt.Sprint(token)))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,276 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package diag_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/diag"
+)
+
+func TestNewErrorDiagnostic(t *testing.T) {
+	t.Parallel()
+
+	got := diag.NewErrorDiagnostic(`resource "example"`, "/resources/0", "Duplicated resource", "is duplicated")
+
+	expected := diag.Diagnostic{
+		Severity: diag.SeverityError,
+		Summary:  "Duplicated resource",
+		Detail:   "is duplicated",
+		Path:     `resource "example"`,
+		Pointer:  "/resources/0",
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	if got.Error() != `resource "example" is duplicated` {
+		t.Errorf("expected error %q, got %q", `resource "example" is duplicated`, got.Error())
+	}
+}
+
+func TestDiagnostic_Error(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    diag.Diagnostic
+		expected string
+	}{
+		"position-unknown": {
+			input:    diag.NewErrorDiagnostic(`resource "example"`, "/resources/0", "Duplicated resource", "is duplicated"),
+			expected: `resource "example" is duplicated`,
+		},
+		"position": {
+			input: diag.Diagnostic{
+				Severity: diag.SeverityError,
+				Summary:  "Duplicated resource",
+				Detail:   "is duplicated",
+				Path:     `resource "example"`,
+				Position: diag.Position{
+					Offset: 42,
+					Line:   3,
+					Column: 5,
+				},
+			},
+			expected: `3:5: resource "example" is duplicated`,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.Error()
+
+			if got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestNewPosition(t *testing.T) {
+	t.Parallel()
+
+	document := []byte("{\n  \"name\": \"éx\",\n  \"version\": \"0.2\"\n}")
+
+	testCases := map[string]struct {
+		offset   int
+		expected diag.Position
+	}{
+		"start": {
+			offset: 0,
+			expected: diag.Position{
+				Offset: 0,
+				Line:   1,
+				Column: 1,
+			},
+		},
+		"line": {
+			offset: 4,
+			expected: diag.Position{
+				Offset: 4,
+				Line:   2,
+				Column: 3,
+			},
+		},
+		"multi-byte-characters": {
+			offset: 15,
+			expected: diag.Position{
+				Offset: 15,
+				Line:   2,
+				Column: 13,
+			},
+		},
+		"out-of-range": {
+			offset: 100,
+			expected: diag.Position{
+				Offset: 39,
+				Line:   4,
+				Column: 2,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := diag.NewPosition(document, testCase.offset)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDiagnostics_Err(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    diag.Diagnostics
+		expected error
+	}{
+		"nil": {},
+		"warning": {
+			input: diag.Diagnostics{
+				diag.NewWarningDiagnostic(`resource "example"`, "/resources/0", "Summary", "is a warning"),
+			},
+		},
+		"errors": {
+			input: diag.Diagnostics{
+				diag.NewWarningDiagnostic(`resource "example"`, "/resources/0", "Summary", "is a warning"),
+				diag.NewErrorDiagnostic(`resource "example"`, "/resources/0", "Summary", "is an error"),
+			},
+			expected: fmt.Errorf(`resource "example" is a warning` + "\n" + `resource "example" is an error`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.input.Err()
+
+			if err != nil {
+				if testCase.expected == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expected.Error() {
+					t.Errorf("expected error %q, got: %s", testCase.expected, err)
+				}
+			}
+
+			if err == nil && testCase.expected != nil {
+				t.Errorf("got no error, expected: %s", testCase.expected)
+			}
+		})
+	}
+}
+
+func TestFromError(t *testing.T) {
+	t.Parallel()
+
+	duplicated := diag.NewErrorDiagnostic(`resource "example"`, "/resources/1", "Duplicated resource", "is duplicated")
+	reserved := diag.NewErrorDiagnostic(`resource "example" attribute "id"`, "/resources/0/schema/attributes/0", "Reserved attribute name", "is a reserved name")
+
+	testCases := map[string]struct {
+		input    error
+		expected diag.Diagnostics
+	}{
+		"nil": {},
+		"diagnostic": {
+			input: duplicated,
+			expected: diag.Diagnostics{
+				duplicated,
+			},
+		},
+		"diagnostics": {
+			input: diag.Diagnostics{
+				duplicated,
+				reserved,
+			},
+			expected: diag.Diagnostics{
+				duplicated,
+				reserved,
+			},
+		},
+		"joined": {
+			input: errors.Join(duplicated, errors.Join(reserved)),
+			expected: diag.Diagnostics{
+				duplicated,
+				reserved,
+			},
+		},
+		"error": {
+			input: errors.New("unexpected end of JSON input"),
+			expected: diag.Diagnostics{
+				{
+					Severity: diag.SeverityError,
+					Summary:  "Invalid specification",
+					Detail:   "unexpected end of JSON input",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := diag.FromError(testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestPointer(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		pointer  string
+		tokens   []any
+		expected string
+	}{
+		"root": {
+			expected: "",
+		},
+		"tokens": {
+			pointer:  "/resources",
+			tokens:   []any{3, "schema", "attributes", 2},
+			expected: "/resources/3/schema/attributes/2",
+		},
+		"escaped": {
+			tokens:   []any{"a/b", "c~d"},
+			expected: "/a~1b/c~0d",
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := diag.Pointer(testCase.pointer, testCase.tokens...)
+
+			if got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
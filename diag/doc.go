@@ -0,0 +1,8 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package diag contains the Diagnostic type, which describes a problem found
+// while validating a specification, along with the location of the problem
+// as a human readable path, a JSON pointer into the document and, when the
+// document is available, a line and column.
+package diag
@@ -0,0 +1,703 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ephemeralresource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+// AttributeValidateRequest defines the Path of the attribute that is
+// being validated, and the Blocks which are siblings of the attribute.
+type AttributeValidateRequest struct {
+	// Path defines the location of the object containing the attributes
+	// being validated, such as a schema or nested object.
+	Path schema.Path
+
+	// Blocks defines the blocks which are siblings of the attributes being
+	// validated, and is used to resolve path expressions.
+	Blocks Blocks
+
+	// Root defines whether the attributes being validated are at the root
+	// of the schema, where reserved names cannot be used.
+	Root bool
+}
+
+// Attributes type defines Attribute types.
+type Attributes []Attribute
+
+// Validate checks for duplicated attribute names, for reserved names at the
+// root of the schema, for invalid computed_optional_required values, and that
+// the paths of path-relationship validators resolve to an attribute or block.
+// Validate is called recursively in instances where an attribute contains
+// nested attributes. Validate delegates to ObjectAttributeTypes.Validate when
+// the attribute is an ObjectAttribute, and to the Validate method of the
+// attribute validators.
+func (a Attributes) Validate(ctx context.Context, req AttributeValidateRequest) error {
+	attributeNames := make(map[string]struct{}, len(a))
+
+	var errs, nestedErrs []error
+
+	for k, attribute := range a {
+		path := req.Path.Attribute(attribute.Name, k)
+
+		if _, ok := attributeNames[attribute.Name]; ok {
+			errs = append(errs, path.ErrorDiagnostic("Duplicated attribute", "is duplicated"))
+		}
+
+		attributeNames[attribute.Name] = struct{}{}
+
+		if req.Root && isReservedName(attribute.Name) {
+			errs = append(errs, path.ErrorDiagnostic("Reserved attribute name", "is a reserved name"))
+		}
+
+		switch computedOptionalRequired := attribute.computedOptionalRequired(); computedOptionalRequired {
+		case "", schema.Computed, schema.ComputedOptional, schema.Optional, schema.Required:
+		default:
+			errs = append(errs, path.ErrorDiagnostic("Invalid computed_optional_required", fmt.Sprintf("computed_optional_required %q must be one of computed, computed_optional, optional or required", computedOptionalRequired)))
+		}
+
+		validatorsErr := attribute.validateValidators(ctx, path)
+
+		if validatorsErr != nil {
+			errs = append(errs, validatorsErr)
+		}
+
+		for _, relationship := range attribute.pathRelationships() {
+			if !resolvesPath(a, req.Blocks, relationship.Path.Steps()) {
+				errs = append(errs, path.ErrorDiagnostic("Unresolved validator path", fmt.Sprintf("%s validator path %q does not resolve to an attribute or block", relationship.Name, relationship.Path)))
+			}
+		}
+
+		var err error
+
+		var attributeValidateRequest AttributeValidateRequest
+
+		objectValidateRequest := schema.ObjectValidateRequest{
+			Path: path,
+		}
+
+		switch {
+		case attribute.ListNested != nil:
+			attributeValidateRequest.Path = path.NestedObject("list_nested")
+
+			err = attribute.ListNested.NestedObject.Attributes.Validate(ctx, attributeValidateRequest)
+		case attribute.MapNested != nil:
+			attributeValidateRequest.Path = path.NestedObject("map_nested")
+
+			err = attribute.MapNested.NestedObject.Attributes.Validate(ctx, attributeValidateRequest)
+		case attribute.Object != nil:
+			err = attribute.Object.AttributeTypes.Validate(ctx, objectValidateRequest)
+		case attribute.SetNested != nil:
+			attributeValidateRequest.Path = path.NestedObject("set_nested")
+
+			err = attribute.SetNested.NestedObject.Attributes.Validate(ctx, attributeValidateRequest)
+		case attribute.SingleNested != nil:
+			attributeValidateRequest.Path = path.NestedObject("single_nested")
+
+			err = attribute.SingleNested.Attributes.Validate(ctx, attributeValidateRequest)
+		}
+
+		if err != nil {
+			nestedErrs = append(nestedErrs, err)
+		}
+	}
+
+	e := append(errs, nestedErrs...)
+
+	return errors.Join(e...)
+}
+
+// Attribute defines a value field inside a Schema. The attribute types
+// (e.g., Bool, Float64) are mutually exclusive, one and only one must
+// be specified.
+type Attribute struct {
+	// Name defines the attribute name.
+	Name string `json:"name"`
+
+	Bool         *BoolAttribute         `json:"bool,omitempty"`
+	Dynamic      *DynamicAttribute      `json:"dynamic,omitempty"`
+	Float64      *Float64Attribute      `json:"float64,omitempty"`
+	Int64        *Int64Attribute        `json:"int64,omitempty"`
+	List         *ListAttribute         `json:"list,omitempty"`
+	ListNested   *ListNestedAttribute   `json:"list_nested,omitempty"`
+	Map          *MapAttribute          `json:"map,omitempty"`
+	MapNested    *MapNestedAttribute    `json:"map_nested,omitempty"`
+	Number       *NumberAttribute       `json:"number,omitempty"`
+	Object       *ObjectAttribute       `json:"object,omitempty"`
+	Set          *SetAttribute          `json:"set,omitempty"`
+	SetNested    *SetNestedAttribute    `json:"set_nested,omitempty"`
+	SingleNested *SingleNestedAttribute `json:"single_nested,omitempty"`
+	String       *StringAttribute       `json:"string,omitempty"`
+}
+
+// validateValidators delegates to the Validate method of the validators of the
+// attribute type which is set, at the given path.
+func (a Attribute) validateValidators(ctx context.Context, path schema.Path) error {
+	switch {
+	case a.Float64 != nil:
+		return a.Float64.Validators.Validate(ctx, schema.Float64ValidatorsValidateRequest{
+			Path: path,
+		})
+	case a.Int64 != nil:
+		return a.Int64.Validators.Validate(ctx, schema.Int64ValidatorsValidateRequest{
+			Path: path,
+		})
+	case a.Number != nil:
+		return a.Number.Validators.Validate(ctx, schema.NumberValidatorsValidateRequest{
+			Path: path,
+		})
+	}
+
+	return nil
+}
+
+// computedOptionalRequired returns the ComputedOptionalRequired of the
+// attribute type which is set.
+func (a Attribute) computedOptionalRequired() schema.ComputedOptionalRequired {
+	switch {
+	case a.Bool != nil:
+		return a.Bool.ComputedOptionalRequired
+	case a.Dynamic != nil:
+		return a.Dynamic.ComputedOptionalRequired
+	case a.Float64 != nil:
+		return a.Float64.ComputedOptionalRequired
+	case a.Int64 != nil:
+		return a.Int64.ComputedOptionalRequired
+	case a.List != nil:
+		return a.List.ComputedOptionalRequired
+	case a.ListNested != nil:
+		return a.ListNested.ComputedOptionalRequired
+	case a.Map != nil:
+		return a.Map.ComputedOptionalRequired
+	case a.MapNested != nil:
+		return a.MapNested.ComputedOptionalRequired
+	case a.Number != nil:
+		return a.Number.ComputedOptionalRequired
+	case a.Object != nil:
+		return a.Object.ComputedOptionalRequired
+	case a.Set != nil:
+		return a.Set.ComputedOptionalRequired
+	case a.SetNested != nil:
+		return a.SetNested.ComputedOptionalRequired
+	case a.SingleNested != nil:
+		return a.SingleNested.ComputedOptionalRequired
+	case a.String != nil:
+		return a.String.ComputedOptionalRequired
+	}
+
+	return ""
+}
+
+// pathRelationships returns the path-relationship validators of the
+// attribute type which is set.
+func (a Attribute) pathRelationships() schema.PathRelationships {
+	switch {
+	case a.Bool != nil:
+		return a.Bool.Validators.PathRelationships()
+	case a.Dynamic != nil:
+		return a.Dynamic.Validators.PathRelationships()
+	case a.Float64 != nil:
+		return a.Float64.Validators.PathRelationships()
+	case a.Int64 != nil:
+		return a.Int64.Validators.PathRelationships()
+	case a.List != nil:
+		return a.List.Validators.PathRelationships()
+	case a.ListNested != nil:
+		return a.ListNested.Validators.PathRelationships()
+	case a.Map != nil:
+		return a.Map.Validators.PathRelationships()
+	case a.MapNested != nil:
+		return a.MapNested.Validators.PathRelationships()
+	case a.Number != nil:
+		return a.Number.Validators.PathRelationships()
+	case a.Object != nil:
+		return a.Object.Validators.PathRelationships()
+	case a.Set != nil:
+		return a.Set.Validators.PathRelationships()
+	case a.SetNested != nil:
+		return a.SetNested.Validators.PathRelationships()
+	case a.SingleNested != nil:
+		return a.SingleNested.Validators.PathRelationships()
+	case a.String != nil:
+		return a.String.Validators.PathRelationships()
+	}
+
+	return nil
+}
+
+// nestedAttributes returns the nested attributes of the attribute type
+// which is set, or nil if the attribute type does not have nested
+// attributes.
+func (a Attribute) nestedAttributes() Attributes {
+	switch {
+	case a.ListNested != nil:
+		return a.ListNested.NestedObject.Attributes
+	case a.MapNested != nil:
+		return a.MapNested.NestedObject.Attributes
+	case a.SetNested != nil:
+		return a.SetNested.NestedObject.Attributes
+	case a.SingleNested != nil:
+		return a.SingleNested.Attributes
+	}
+
+	return nil
+}
+
+// NestedAttributeObject is the underlying object defining the Attributes
+// for a ListNestedAttribute, MapNestedAttribute, or SetNestedAttribute.
+type NestedAttributeObject struct {
+	// AssociatedExternalType defines a type that can be used as a NestedAttributeObject.
+	AssociatedExternalType *schema.AssociatedExternalType `json:"associated_external_type,omitempty"`
+
+	// Attributes defines the Attribute types associated with a NestedAttributeObject.
+	Attributes Attributes `json:"attributes,omitempty"`
+
+	// CustomType defines a custom type and value for the NestedAttributeObject.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// Validators define types and functions that provide validation
+	// functionality for the NestedAttributeObject.
+	Validators schema.ObjectValidators `json:"validators,omitempty"`
+}
+
+// BoolAttribute represents a Schema attribute that is a boolean.
+type BoolAttribute struct {
+	// AssociatedExternalType defines a Go type that can be used to represent a BoolAttribute.
+	AssociatedExternalType *schema.AssociatedExternalType `json:"associated_external_type,omitempty"`
+
+	// ComputedOptionalRequired indicates whether the attribute is required
+	// (`required`), optional (`optional`), computed (`computed`), or
+	// computed and optional (`computed_optional`).
+	ComputedOptionalRequired schema.ComputedOptionalRequired `json:"computed_optional_required"`
+
+	// CustomType defines a custom type and value for the attribute.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// DeprecationMessage defines a message describing that the attribute
+	// is deprecated.
+	DeprecationMessage *string `json:"deprecation_message,omitempty"`
+
+	// Description defines the purpose and usage of the attribute.
+	Description *string `json:"description,omitempty"`
+
+	// Sensitive indicates whether the value of the attribute should
+	// be considered sensitive data.
+	Sensitive *bool `json:"sensitive,omitempty"`
+
+	// Validators define types and functions that provide validation
+	// functionality for the attribute.
+	Validators schema.BoolValidators `json:"validators,omitempty"`
+}
+
+// DynamicAttribute represents a Schema attribute that is dynamic.
+type DynamicAttribute struct {
+	// AssociatedExternalType defines a Go type that can be used to represent a DynamicAttribute.
+	AssociatedExternalType *schema.AssociatedExternalType `json:"associated_external_type,omitempty"`
+
+	// ComputedOptionalRequired indicates whether the attribute is required
+	// (`required`), optional (`optional`), computed (`computed`), or
+	// computed and optional (`computed_optional`).
+	ComputedOptionalRequired schema.ComputedOptionalRequired `json:"computed_optional_required"`
+
+	// CustomType defines a custom type and value for the attribute.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// DeprecationMessage defines a message describing that the attribute
+	// is deprecated.
+	DeprecationMessage *string `json:"deprecation_message,omitempty"`
+
+	// Description defines the purpose and usage of the attribute.
+	Description *string `json:"description,omitempty"`
+
+	// Sensitive indicates whether the value of the attribute should
+	// be considered sensitive data.
+	Sensitive *bool `json:"sensitive,omitempty"`
+
+	// Validators define types and functions that provide validation
+	// functionality for the attribute.
+	Validators schema.DynamicValidators `json:"validators,omitempty"`
+}
+
+// Float64Attribute represents a Schema attribute that is a 64-bit
+// floating point number.
+//
+// Use Int64Attribute for a 64-bit integer attribute, or NumberAttribute
+// for a 512-bit generic number attribute.
+type Float64Attribute struct {
+	// AssociatedExternalType defines a Go type that can be used to represent a Float64Attribute.
+	AssociatedExternalType *schema.AssociatedExternalType `json:"associated_external_type,omitempty"`
+
+	// ComputedOptionalRequired indicates whether the attribute is required
+	// (`required`), optional (`optional`), computed (`computed`), or
+	// computed and optional (`computed_optional`).
+	ComputedOptionalRequired schema.ComputedOptionalRequired `json:"computed_optional_required"`
+
+	// CustomType defines a custom type and value for the attribute.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// DeprecationMessage defines a message describing that the attribute
+	// is deprecated.
+	DeprecationMessage *string `json:"deprecation_message,omitempty"`
+
+	// Description defines the purpose and usage of the attribute.
+	Description *string `json:"description,omitempty"`
+
+	// Sensitive indicates whether the value of the attribute should
+	// be considered sensitive data.
+	Sensitive *bool `json:"sensitive,omitempty"`
+
+	// Validators define types and functions that provide validation
+	// functionality for the attribute.
+	Validators schema.Float64Validators `json:"validators,omitempty"`
+}
+
+// Int64Attribute represents a schema attribute that is a 64-bit
+// integer.
+//
+// Use Float64Attribute for a 64-bit floating point number, or
+// NumberAttribute for a 512-bit generic number attribute.
+type Int64Attribute struct {
+	// AssociatedExternalType defines a Go type that can be used to represent a Int64Attribute.
+	AssociatedExternalType *schema.AssociatedExternalType `json:"associated_external_type,omitempty"`
+
+	// ComputedOptionalRequired indicates whether the attribute is required
+	// (`required`), optional (`optional`), computed (`computed`), or
+	// computed and optional (`computed_optional`).
+	ComputedOptionalRequired schema.ComputedOptionalRequired `json:"computed_optional_required"`
+
+	// CustomType defines a custom type and value for the attribute.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// DeprecationMessage defines a message describing that the attribute
+	// is deprecated.
+	DeprecationMessage *string `json:"deprecation_message,omitempty"`
+
+	// Description defines the purpose and usage of the attribute.
+	Description *string `json:"description,omitempty"`
+
+	// Sensitive indicates whether the value of the attribute should
+	// be considered sensitive data.
+	Sensitive *bool `json:"sensitive,omitempty"`
+
+	// Validators define types and functions that provide validation
+	// functionality for the attribute.
+	Validators schema.Int64Validators `json:"validators,omitempty"`
+}
+
+// ListAttribute represents a Schema attribute that is a list with a single
+// element type.
+type ListAttribute struct {
+	// AssociatedExternalType defines a Go type that can be used to represent a ListAttribute.
+	AssociatedExternalType *schema.AssociatedExternalType `json:"associated_external_type,omitempty"`
+
+	// ComputedOptionalRequired indicates whether the attribute is required
+	// (`required`), optional (`optional`), computed (`computed`), or
+	// computed and optional (`computed_optional`).
+	ComputedOptionalRequired schema.ComputedOptionalRequired `json:"computed_optional_required"`
+
+	// ElementType is the type for all elements of the list.
+	ElementType schema.ElementType `json:"element_type"`
+
+	// CustomType defines a custom type and value for the attribute.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// DeprecationMessage defines a message describing that the attribute
+	// is deprecated.
+	DeprecationMessage *string `json:"deprecation_message,omitempty"`
+
+	// Description defines the purpose and usage of the attribute.
+	Description *string `json:"description,omitempty"`
+
+	// Sensitive indicates whether the value of the attribute should
+	// be considered sensitive data.
+	Sensitive *bool `json:"sensitive,omitempty"`
+
+	// Validators define types and functions that provide validation
+	// functionality for the attribute.
+	Validators schema.ListValidators `json:"validators,omitempty"`
+}
+
+// ListNestedAttribute represents a Schema attribute that is a list of
+// objects, where the object attributes can be fully defined.
+type ListNestedAttribute struct {
+	// ComputedOptionalRequired indicates whether the attribute is required
+	// (`required`), optional (`optional`), computed (`computed`), or
+	// computed and optional (`computed_optional`).
+	ComputedOptionalRequired schema.ComputedOptionalRequired `json:"computed_optional_required"`
+
+	// NestedObject defines the underlying object attributes.
+	NestedObject NestedAttributeObject `json:"nested_object"`
+
+	// CustomType defines a custom type and value for the attribute.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// DeprecationMessage defines a message describing that the attribute
+	// is deprecated.
+	DeprecationMessage *string `json:"deprecation_message,omitempty"`
+
+	// Description defines the purpose and usage of the attribute.
+	Description *string `json:"description,omitempty"`
+
+	// Sensitive indicates whether the value of the attribute should
+	// be considered sensitive data.
+	Sensitive *bool `json:"sensitive,omitempty"`
+
+	// Validators define types and functions that provide validation
+	// functionality for the attribute.
+	Validators schema.ListValidators `json:"validators,omitempty"`
+}
+
+// MapAttribute represents a Schema attribute that is a map with a single
+// element type.
+type MapAttribute struct {
+	// AssociatedExternalType defines a Go type that can be used to represent a MapAttribute.
+	AssociatedExternalType *schema.AssociatedExternalType `json:"associated_external_type,omitempty"`
+
+	// ComputedOptionalRequired indicates whether the attribute is required
+	// (`required`), optional (`optional`), computed (`computed`), or
+	// computed and optional (`computed_optional`).
+	ComputedOptionalRequired schema.ComputedOptionalRequired `json:"computed_optional_required"`
+
+	// ElementType is the type for all elements of the map.
+	ElementType schema.ElementType `json:"element_type"`
+
+	// CustomType defines a custom type and value for the attribute.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+	// DeprecationMessage defines a message describing that the attribute
+	// is deprecated.
+	DeprecationMessage *string `json:"deprecation_message,omitempty"`
+
+	// Description defines the purpose and usage of the attribute.
+	Description *string `json:"description,omitempty"`
+
+	// Sensitive indicates whether the value of the attribute should
+	// be considered sensitive data.
+	Sensitive *bool `json:"sensitive,omitempty"`
+
+	// Validators define types and functions that provide validation
+	// functionality for the attribute.
+	Validators schema.MapValidators `json:"validators,omitempty"`
+}
+
+// MapNestedAttribute represents a Schema attribute that is a map of
+// name to objects, where the object attributes can be fully defined.
+type MapNestedAttribute struct {
+	// ComputedOptionalRequired indicates whether the attribute is required
+	// (`required`), optional (`optional`), computed (`computed`), or
+	// computed and optional (`computed_optional`).
+	ComputedOptionalRequired schema.ComputedOptionalRequired `json:"computed_optional_required"`
+
+	// NestedObject defines the underlying object attributes.
+	NestedObject NestedAttributeObject `json:"nested_object"`
+
+	// CustomType defines a custom type and value for the attribute.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// DeprecationMessage defines a message describing that the attribute
+	// is deprecated.
+	DeprecationMessage *string `json:"deprecation_message,omitempty"`
+
+	// Description defines the purpose and usage of the attribute.
+	Description *string `json:"description,omitempty"`
+
+	// Sensitive indicates whether the value of the attribute should
+	// be considered sensitive data.
+	Sensitive *bool `json:"sensitive,omitempty"`
+
+	// Validators define types and functions that provide validation
+	// functionality for the attribute.
+	Validators schema.MapValidators `json:"validators,omitempty"`
+}
+
+// NumberAttribute represents a schema attribute that is a generic
+// number with up to 512 bits of floating point or integer precision.
+//
+// Use Float64Attribute for a 64-bit floating point number attribute,
+// or Int64Attribute for a 64-bit integer number attribute.
+type NumberAttribute struct {
+	// AssociatedExternalType defines a Go type that can be used to represent a NumberAttribute.
+	AssociatedExternalType *schema.AssociatedExternalType `json:"associated_external_type,omitempty"`
+
+	// ComputedOptionalRequired indicates whether the attribute is required
+	// (`required`), optional (`optional`), computed (`computed`), or
+	// computed and optional (`computed_optional`).
+	ComputedOptionalRequired schema.ComputedOptionalRequired `json:"computed_optional_required"`
+
+	// CustomType defines a custom type and value for the attribute.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// DeprecationMessage defines a message describing that the attribute
+	// is deprecated.
+	DeprecationMessage *string `json:"deprecation_message,omitempty"`
+
+	// Description defines the purpose and usage of the attribute.
+	Description *string `json:"description,omitempty"`
+
+	// Sensitive indicates whether the value of the attribute should
+	// be considered sensitive data.
+	Sensitive *bool `json:"sensitive,omitempty"`
+
+	// Validators define types and functions that provide validation
+	// functionality for the attribute.
+	Validators schema.NumberValidators `json:"validators,omitempty"`
+}
+
+// ObjectAttribute represents a Schema attribute that is an object with only
+// type information for underlying attributes.
+type ObjectAttribute struct {
+	// AssociatedExternalType defines a Go type that can be used to represent an ObjectAttribute.
+	AssociatedExternalType *schema.AssociatedExternalType `json:"associated_external_type,omitempty"`
+
+	// AttributeTypes provides the mapping of underlying names to types.
+	AttributeTypes schema.ObjectAttributeTypes `json:"attribute_types"`
+
+	// ComputedOptionalRequired indicates whether the attribute is required
+	// (`required`), optional (`optional`), computed (`computed`), or
+	// computed and optional (`computed_optional`).
+	ComputedOptionalRequired schema.ComputedOptionalRequired `json:"computed_optional_required"`
+
+	// CustomType defines a custom type and value for the attribute.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// DeprecationMessage defines a message describing that the attribute
+	// is deprecated.
+	DeprecationMessage *string `json:"deprecation_message,omitempty"`
+
+	// Description defines the purpose and usage of the attribute.
+	Description *string `json:"description,omitempty"`
+
+	// Sensitive indicates whether the value of the attribute should
+	// be considered sensitive data.
+	Sensitive *bool `json:"sensitive,omitempty"`
+
+	// Validators define types and functions that provide validation
+	// functionality for the attribute.
+	Validators schema.ObjectValidators `json:"validators,omitempty"`
+}
+
+// SetAttribute represents a Schema attribute that is a set with a single
+// element type.
+type SetAttribute struct {
+	// AssociatedExternalType defines a Go type that can be used to represent a SetAttribute.
+	AssociatedExternalType *schema.AssociatedExternalType `json:"associated_external_type,omitempty"`
+
+	// ComputedOptionalRequired indicates whether the attribute is required
+	// (`required`), optional (`optional`), computed (`computed`), or
+	// computed and optional (`computed_optional`).
+	ComputedOptionalRequired schema.ComputedOptionalRequired `json:"computed_optional_required"`
+
+	// ElementType is the type for all elements of the set.
+	ElementType schema.ElementType `json:"element_type"`
+
+	// CustomType defines a custom type and value for the attribute.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// DeprecationMessage defines a message describing that the attribute
+	// is deprecated.
+	DeprecationMessage *string `json:"deprecation_message,omitempty"`
+
+	// Description defines the purpose and usage of the attribute.
+	Description *string `json:"description,omitempty"`
+
+	// Sensitive indicates whether the value of the attribute should
+	// be considered sensitive data.
+	Sensitive *bool `json:"sensitive,omitempty"`
+
+	// Validators define types and functions that provide validation
+	// functionality for the attribute.
+	Validators schema.SetValidators `json:"validators,omitempty"`
+}
+
+// SetNestedAttribute represents a Schema attribute that is a list of
+// objects, where the object attributes can be fully defined.
+type SetNestedAttribute struct {
+	// ComputedOptionalRequired indicates whether the attribute is required
+	// (`required`), optional (`optional`), computed (`computed`), or
+	// computed and optional (`computed_optional`).
+	ComputedOptionalRequired schema.ComputedOptionalRequired `json:"computed_optional_required"`
+
+	// NestedObject defines the underlying object attributes.
+	NestedObject NestedAttributeObject `json:"nested_object"`
+
+	// CustomType defines a custom type and value for the attribute.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// DeprecationMessage defines a message describing that the attribute
+	// is deprecated.
+	DeprecationMessage *string `json:"deprecation_message,omitempty"`
+
+	// Description defines the purpose and usage of the attribute.
+	Description *string `json:"description,omitempty"`
+
+	// Sensitive indicates whether the value of the attribute should
+	// be considered sensitive data.
+	Sensitive *bool `json:"sensitive,omitempty"`
+
+	// Validators define types and functions that provide validation
+	// functionality for the attribute.
+	Validators schema.SetValidators `json:"validators,omitempty"`
+}
+
+// SingleNestedAttribute represents a Schema attribute that is a single object where
+// the object attributes can be fully defined
+type SingleNestedAttribute struct {
+	// ComputedOptionalRequired indicates whether the attribute is required
+	// (`required`), optional (`optional`), computed (`computed`), or
+	// computed and optional (`computed_optional`).
+	ComputedOptionalRequired schema.ComputedOptionalRequired `json:"computed_optional_required"`
+
+	// Attributes defines the Attribute types associated with a SingleNestedAttribute.
+	Attributes Attributes `json:"attributes,omitempty"`
+
+	// AssociatedExternalType defines a type that can be used as a NestedAttributeObject.
+	AssociatedExternalType *schema.AssociatedExternalType `json:"associated_external_type,omitempty"`
+
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+	// DeprecationMessage defines a message describing that the attribute
+	// is deprecated.
+	DeprecationMessage *string `json:"deprecation_message,omitempty"`
+
+	Description *string `json:"description,omitempty"`
+
+	// Sensitive indicates whether the value of the attribute should
+	// be considered sensitive data.
+	Sensitive *bool `json:"sensitive,omitempty"`
+
+	// Validators define types and functions that provide validation
+	// functionality for the attribute.
+	Validators schema.ObjectValidators `json:"validators,omitempty"`
+}
+
+// StringAttribute represents a Schema attribute that is a string.
+type StringAttribute struct {
+	// AssociatedExternalType defines a Go type that can be used to represent a StringAttribute.
+	AssociatedExternalType *schema.AssociatedExternalType `json:"associated_external_type,omitempty"`
+
+	// ComputedOptionalRequired indicates whether the attribute is required
+	// (`required`), optional (`optional`), computed (`computed`), or
+	// computed and optional (`computed_optional`).
+	ComputedOptionalRequired schema.ComputedOptionalRequired `json:"computed_optional_required"`
+
+	// CustomType defines a custom type and value for the attribute.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// DeprecationMessage defines a message describing that the attribute
+	// is deprecated.
+	DeprecationMessage *string `json:"deprecation_message,omitempty"`
+
+	// Description defines the purpose and usage of the attribute.
+	Description *string `json:"description,omitempty"`
+
+	// Sensitive indicates whether the value of the attribute should
+	// be considered sensitive data.
+	Sensitive *bool `json:"sensitive,omitempty"`
+
+	// Validators define types and functions that provide validation
+	// functionality for the attribute.
+	Validators schema.StringValidators `json:"validators,omitempty"`
+}
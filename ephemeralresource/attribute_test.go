@@ -0,0 +1,296 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ephemeralresource_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/ephemeralresource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+func TestAttributes_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attributes    ephemeralresource.Attributes
+		request       ephemeralresource.AttributeValidateRequest
+		expectedError error
+	}{
+		"attribute-names-duplicated": {
+			attributes: ephemeralresource.Attributes{
+				{
+					Name: "attr_one",
+					Bool: &ephemeralresource.BoolAttribute{},
+				},
+				{
+					Name: "attr_one",
+					Bool: &ephemeralresource.BoolAttribute{},
+				},
+			},
+			request: ephemeralresource.AttributeValidateRequest{
+				Path: schema.Path{}.EphemeralResource("example", 0).Schema(),
+			},
+			expectedError: fmt.Errorf(`ephemeral resource "example" attribute "attr_one" is duplicated`),
+		},
+		"attribute-names-triplicated": {
+			attributes: ephemeralresource.Attributes{
+				{
+					Name: "attr_one",
+					Bool: &ephemeralresource.BoolAttribute{},
+				},
+				{
+					Name: "attr_one",
+					Bool: &ephemeralresource.BoolAttribute{},
+				},
+				{
+					Name: "attr_one",
+					Bool: &ephemeralresource.BoolAttribute{},
+				},
+			},
+			request: ephemeralresource.AttributeValidateRequest{
+				Path: schema.Path{}.EphemeralResource("example", 0).Schema(),
+			},
+			expectedError: fmt.Errorf(`ephemeral resource "example" attribute "attr_one" is duplicated` + "\n" +
+				`ephemeral resource "example" attribute "attr_one" is duplicated`),
+		},
+		"attribute-names-unique": {
+			attributes: ephemeralresource.Attributes{
+				{
+					Name: "attr_one",
+					Bool: &ephemeralresource.BoolAttribute{},
+				},
+				{
+					Name: "attr_two",
+					Bool: &ephemeralresource.BoolAttribute{},
+				},
+			},
+			request: ephemeralresource.AttributeValidateRequest{
+				Path: schema.Path{}.EphemeralResource("example", 0).Schema(),
+			},
+		},
+		"list-attribute-names-duplicated": {
+			attributes: ephemeralresource.Attributes{
+				{
+					Name: "attr_one",
+					ListNested: &ephemeralresource.ListNestedAttribute{
+						NestedObject: ephemeralresource.NestedAttributeObject{
+							Attributes: ephemeralresource.Attributes{
+								{
+									Name: "nested_attr_one",
+								},
+								{
+									Name: "nested_attr_one",
+								},
+							},
+						},
+					},
+				},
+			},
+			request: ephemeralresource.AttributeValidateRequest{
+				Path: schema.Path{}.EphemeralResource("example", 0).Schema(),
+			},
+			expectedError: fmt.Errorf(`ephemeral resource "example" attribute "attr_one" attribute "nested_attr_one" is duplicated`),
+		},
+		"list-nested-attribute-names-triplicated": {
+			attributes: ephemeralresource.Attributes{
+				{
+					Name: "attr_one",
+					ListNested: &ephemeralresource.ListNestedAttribute{
+						NestedObject: ephemeralresource.NestedAttributeObject{
+							Attributes: ephemeralresource.Attributes{
+								{
+									Name: "nested_attr_one",
+								},
+								{
+									Name: "nested_attr_one",
+								},
+								{
+									Name: "nested_attr_one",
+								},
+							},
+						},
+					},
+				},
+			},
+			request: ephemeralresource.AttributeValidateRequest{
+				Path: schema.Path{}.EphemeralResource("example", 0).Schema(),
+			},
+			expectedError: fmt.Errorf(`ephemeral resource "example" attribute "attr_one" attribute "nested_attr_one" is duplicated` + "\n" +
+				`ephemeral resource "example" attribute "attr_one" attribute "nested_attr_one" is duplicated`),
+		},
+		"list-nested-attribute-names-unique": {
+			attributes: ephemeralresource.Attributes{
+				{
+					Name: "attr_one",
+					ListNested: &ephemeralresource.ListNestedAttribute{
+						NestedObject: ephemeralresource.NestedAttributeObject{
+							Attributes: ephemeralresource.Attributes{
+								{
+									Name: "nested_attr_one",
+								},
+								{
+									Name: "nested_attr_two",
+								},
+							},
+						},
+					},
+				},
+			},
+			request: ephemeralresource.AttributeValidateRequest{
+				Path: schema.Path{}.EphemeralResource("example", 0).Schema(),
+			},
+		},
+		"attribute-and-list-attribute-names-duplicated": {
+			attributes: ephemeralresource.Attributes{
+				{
+					Name: "attr_one",
+					ListNested: &ephemeralresource.ListNestedAttribute{
+						NestedObject: ephemeralresource.NestedAttributeObject{
+							Attributes: ephemeralresource.Attributes{
+								{
+									Name: "nested_attr_one",
+								},
+								{
+									Name: "nested_attr_one",
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "attr_one",
+					ListNested: &ephemeralresource.ListNestedAttribute{
+						NestedObject: ephemeralresource.NestedAttributeObject{
+							Attributes: ephemeralresource.Attributes{
+								{
+									Name: "nested_attr_one",
+								},
+								{
+									Name: "nested_attr_one",
+								},
+							},
+						},
+					},
+				},
+			},
+			request: ephemeralresource.AttributeValidateRequest{
+				Path: schema.Path{}.EphemeralResource("example", 0).Schema(),
+			},
+			expectedError: fmt.Errorf(`ephemeral resource "example" attribute "attr_one" is duplicated` + "\n" +
+				`ephemeral resource "example" attribute "attr_one" attribute "nested_attr_one" is duplicated` + "\n" +
+				`ephemeral resource "example" attribute "attr_one" attribute "nested_attr_one" is duplicated`),
+		},
+		"object-attribute-type-names-duplicated": {
+			attributes: ephemeralresource.Attributes{
+				{
+					Name: "attr_one",
+					Object: &ephemeralresource.ObjectAttribute{
+						AttributeTypes: schema.ObjectAttributeTypes{
+							{
+								Name: "obj_attr_one",
+							},
+							{
+								Name: "obj_attr_one",
+							},
+						},
+					},
+				},
+			},
+			request: ephemeralresource.AttributeValidateRequest{
+				Path: schema.Path{}.EphemeralResource("example", 0).Schema(),
+			},
+			expectedError: fmt.Errorf(`ephemeral resource "example" attribute "attr_one" object attribute type "obj_attr_one" is duplicated`),
+		},
+		"object-attribute-names-and-type-names-duplicated": {
+			attributes: ephemeralresource.Attributes{
+				{
+					Name: "attr_one",
+					Object: &ephemeralresource.ObjectAttribute{
+						AttributeTypes: schema.ObjectAttributeTypes{
+							{
+								Name: "obj_attr_one",
+							},
+							{
+								Name: "obj_attr_one",
+							},
+						},
+					},
+				},
+				{
+					Name: "attr_one",
+					Object: &ephemeralresource.ObjectAttribute{
+						AttributeTypes: schema.ObjectAttributeTypes{
+							{
+								Name: "obj_attr_one",
+							},
+							{
+								Name: "obj_attr_one",
+							},
+						},
+					},
+				},
+			},
+			request: ephemeralresource.AttributeValidateRequest{
+				Path: schema.Path{}.EphemeralResource("example", 0).Schema(),
+			},
+			expectedError: fmt.Errorf(`ephemeral resource "example" attribute "attr_one" is duplicated` + "\n" +
+				`ephemeral resource "example" attribute "attr_one" object attribute type "obj_attr_one" is duplicated` + "\n" +
+				`ephemeral resource "example" attribute "attr_one" object attribute type "obj_attr_one" is duplicated`),
+		},
+		"object-object-attribute-type-names-duplicated": {
+			attributes: ephemeralresource.Attributes{
+				{
+					Name: "attr_one",
+					Object: &ephemeralresource.ObjectAttribute{
+						AttributeTypes: schema.ObjectAttributeTypes{
+							{
+								Name: "obj_attr_one",
+								Object: &schema.ObjectType{
+									AttributeTypes: schema.ObjectAttributeTypes{
+										{
+											Name: "nested_obj_attr_one",
+										},
+										{
+											Name: "nested_obj_attr_one",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			request: ephemeralresource.AttributeValidateRequest{
+				Path: schema.Path{}.EphemeralResource("example", 0).Schema(),
+			},
+			expectedError: fmt.Errorf(`ephemeral resource "example" attribute "attr_one" object attribute type "obj_attr_one" object attribute type "nested_obj_attr_one" is duplicated`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.attributes.Validate(context.Background(), testCase.request)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError.Error() {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+		})
+	}
+}
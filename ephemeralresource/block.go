@@ -0,0 +1,249 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ephemeralresource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+// BlockValidateRequest defines the Path of the block that is
+// being validated, and the Attributes which are siblings of the block.
+type BlockValidateRequest struct {
+	// Path defines the location of the object containing the blocks
+	// being validated, such as a schema or nested object.
+	Path schema.Path
+
+	// Attributes defines the attributes which are siblings of the blocks
+	// being validated, and is used to resolve path expressions.
+	Attributes Attributes
+
+	// Root defines whether the blocks being validated are at the root of
+	// the schema, where reserved names cannot be used.
+	Root bool
+}
+
+// Blocks type defines Block types.
+type Blocks []Block
+
+// Validate checks for duplicated block names, for reserved names at the root of
+// the schema, and that the paths of path-relationship validators resolve to an
+// attribute or block. Validate is called recursively in instances where a block
+// contains nested blocks. Validate delegates to Attributes.Validate in
+// instances where the block has attributes.
+func (b Blocks) Validate(ctx context.Context, req BlockValidateRequest) error {
+	blockNames := make(map[string]struct{}, len(b))
+
+	var errs, nestedErrs []error
+
+	for k, block := range b {
+		path := req.Path.Block(block.Name, k)
+
+		if _, ok := blockNames[block.Name]; ok {
+			errs = append(errs, path.ErrorDiagnostic("Duplicated block", "is duplicated"))
+		}
+
+		blockNames[block.Name] = struct{}{}
+
+		if req.Root && isReservedName(block.Name) {
+			errs = append(errs, path.ErrorDiagnostic("Reserved block name", "is a reserved name"))
+		}
+
+		for _, relationship := range block.pathRelationships() {
+			if !resolvesPath(req.Attributes, b, relationship.Path.Steps()) {
+				errs = append(errs, path.ErrorDiagnostic("Unresolved validator path", fmt.Sprintf("%s validator path %q does not resolve to an attribute or block", relationship.Name, relationship.Path)))
+			}
+		}
+
+		var attributeValidateRequest AttributeValidateRequest
+
+		var blockValidateRequest BlockValidateRequest
+
+		var attributeErr, blockErr error
+
+		switch {
+		case block.ListNested != nil:
+			attributeValidateRequest.Path = path.NestedObject("list_nested")
+			attributeValidateRequest.Blocks = block.ListNested.NestedObject.Blocks
+			blockValidateRequest.Path = path.NestedObject("list_nested")
+			blockValidateRequest.Attributes = block.ListNested.NestedObject.Attributes
+
+			attributeErr = block.ListNested.NestedObject.Attributes.Validate(ctx, attributeValidateRequest)
+			blockErr = block.ListNested.NestedObject.Blocks.Validate(ctx, blockValidateRequest)
+		case block.SetNested != nil:
+			attributeValidateRequest.Path = path.NestedObject("set_nested")
+			attributeValidateRequest.Blocks = block.SetNested.NestedObject.Blocks
+			blockValidateRequest.Path = path.NestedObject("set_nested")
+			blockValidateRequest.Attributes = block.SetNested.NestedObject.Attributes
+
+			attributeErr = block.SetNested.NestedObject.Attributes.Validate(ctx, attributeValidateRequest)
+			blockErr = block.SetNested.NestedObject.Blocks.Validate(ctx, blockValidateRequest)
+		case block.SingleNested != nil:
+			attributeValidateRequest.Path = path.NestedObject("single_nested")
+			attributeValidateRequest.Blocks = block.SingleNested.Blocks
+			blockValidateRequest.Path = path.NestedObject("single_nested")
+			blockValidateRequest.Attributes = block.SingleNested.Attributes
+
+			attributeErr = block.SingleNested.Attributes.Validate(ctx, attributeValidateRequest)
+			blockErr = block.SingleNested.Blocks.Validate(ctx, blockValidateRequest)
+		}
+
+		if attributeErr != nil {
+			nestedErrs = append(nestedErrs, attributeErr)
+		}
+
+		if blockErr != nil {
+			nestedErrs = append(nestedErrs, blockErr)
+		}
+	}
+
+	e := append(errs, nestedErrs...)
+
+	return errors.Join(e...)
+}
+
+// Block defines a structural field inside a Schema. The block types
+// (e.g., ListNested, SetNested) are mutually exclusive, one and
+// only one must be specified.
+type Block struct {
+	Name string `json:"name"`
+
+	ListNested   *ListNestedBlock   `json:"list_nested,omitempty"`
+	SetNested    *SetNestedBlock    `json:"set_nested,omitempty"`
+	SingleNested *SingleNestedBlock `json:"single_nested,omitempty"`
+}
+
+// pathRelationships returns the path-relationship validators of the block
+// type which is set.
+func (b Block) pathRelationships() schema.PathRelationships {
+	switch {
+	case b.ListNested != nil:
+		return b.ListNested.Validators.PathRelationships()
+	case b.SetNested != nil:
+		return b.SetNested.Validators.PathRelationships()
+	case b.SingleNested != nil:
+		return b.SingleNested.Validators.PathRelationships()
+	}
+
+	return nil
+}
+
+// NestedBlockObject is the underlying object defining the Attributes
+// for a ListNestedBlock, or SetNestedBlock.
+type NestedBlockObject struct {
+	// Attributes defines the Attribute types associated with a NestedBlockObject.
+	Attributes Attributes `json:"attributes,omitempty"`
+
+	// Blocks defines the Block types associated with a NestedBlockObject.
+	Blocks Blocks `json:"blocks,omitempty"`
+
+	// AssociatedExternalType defines a type that can be used as a NestedBlockObject.
+	AssociatedExternalType *schema.AssociatedExternalType `json:"associated_external_type,omitempty"`
+
+	// CustomType defines a custom type and value for the NestedBlockObject.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// Validators define types and functions that provide validation
+	// functionality for the NestedBlockObject.
+	Validators schema.ObjectValidators `json:"validators,omitempty"`
+}
+
+// ListNestedBlock represents a block that is a list of objects where
+// the object attributes can be fully defined
+type ListNestedBlock struct {
+	// ComputedOptionalRequired indicates whether the attribute is required
+	// (`required`), optional (`optional`), computed (`computed`), or
+	// computed and optional (`computed_optional`).
+	ComputedOptionalRequired schema.ComputedOptionalRequired `json:"computed_optional_required"`
+
+	// NestedObject defines the underlying object attributes and blocks.
+	NestedObject NestedBlockObject `json:"nested_object"`
+
+	// CustomType defines a custom type and value for the block.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// DeprecationMessage defines a message describing that the block
+	// is deprecated.
+	DeprecationMessage *string `json:"deprecation_message,omitempty"`
+
+	// Description defines the purpose and usage of the block.
+	Description *string `json:"description,omitempty"`
+
+	// Sensitive indicates whether the value of the block should
+	// be considered sensitive data.
+	Sensitive *bool `json:"sensitive,omitempty"`
+
+	// Validators define types and functions that provide validation
+	// functionality for the block.
+	Validators schema.ListValidators `json:"validators,omitempty"`
+}
+
+// SetNestedBlock represents a block that is a set of objects where
+// the object attributes can be fully defined
+type SetNestedBlock struct {
+	// ComputedOptionalRequired indicates whether the attribute is required
+	// (`required`), optional (`optional`), computed (`computed`), or
+	// computed and optional (`computed_optional`).
+	ComputedOptionalRequired schema.ComputedOptionalRequired `json:"computed_optional_required"`
+
+	// NestedObject defines the underlying object attributes and blocks.
+	NestedObject NestedBlockObject `json:"nested_object"`
+
+	// CustomType defines a custom type and value for the block.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// DeprecationMessage defines a message describing that the block
+	// is deprecated.
+	DeprecationMessage *string `json:"deprecation_message,omitempty"`
+
+	// Description defines the purpose and usage of the block.
+	Description *string `json:"description,omitempty"`
+
+	// Sensitive indicates whether the value of the block should
+	// be considered sensitive data.
+	Sensitive *bool `json:"sensitive,omitempty"`
+
+	// Validators define types and functions that provide validation
+	// functionality for the block.
+	Validators schema.SetValidators `json:"validators,omitempty"`
+}
+
+// SingleNestedBlock represents a block that is a single object where
+// the object attributes can be fully defined.
+type SingleNestedBlock struct {
+	// Attributes defines the Attribute types associated with the SingleNestedBlock.
+	Attributes Attributes `json:"attributes,omitempty"`
+
+	// Blocks defines the Block types associated with the SingleNestedBlock.
+	Blocks Blocks `json:"blocks,omitempty"`
+
+	// ComputedOptionalRequired indicates whether the attribute is required
+	// (`required`), optional (`optional`), computed (`computed`), or
+	// computed and optional (`computed_optional`).
+	ComputedOptionalRequired schema.ComputedOptionalRequired `json:"computed_optional_required"`
+
+	// AssociatedExternalType defines a type that can be used as a NestedAttributeObject.
+	AssociatedExternalType *schema.AssociatedExternalType `json:"associated_external_type,omitempty"`
+
+	// CustomType defines a custom type and value for the block.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// DeprecationMessage defines a message describing that the block
+	// is deprecated.
+	DeprecationMessage *string `json:"deprecation_message,omitempty"`
+
+	// Description defines the purpose and usage of the block.
+	Description *string `json:"description,omitempty"`
+
+	// Sensitive indicates whether the value of the block should
+	// be considered sensitive data.
+	Sensitive *bool `json:"sensitive,omitempty"`
+
+	// Validators define types and functions that provide validation
+	// functionality for the block.
+	Validators schema.ObjectValidators `json:"validators,omitempty"`
+}
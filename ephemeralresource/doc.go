@@ -0,0 +1,9 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package ephemeralresource contains ephemeral resource specific types,
+// such as attributes and blocks, for the Go bindings of
+// the JSON schema specification.
+package ephemeralresource
+
+//go:generate go run ../internal/cmd/typedattribute -package ephemeralresource
@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ephemeralresource
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+// ValidateRequest defines the Path of the ephemeral resource that is
+// being validated.
+type ValidateRequest struct {
+	// Path defines the location of the ephemeral resource, such as `ephemeral resource "example"`.
+	Path schema.Path
+}
+
+// EphemeralResource defines an individual ephemeral resource.
+type EphemeralResource struct {
+	// Name is the string identifier for the ephemeral resource.
+	Name string `json:"name"`
+
+	// Schema defines the Attributes and Blocks for the ephemeral resource.
+	Schema *Schema `json:"schema,omitempty"`
+}
+
+// Validate delegates to Schema.Validate.
+func (r EphemeralResource) Validate(ctx context.Context, req ValidateRequest) error {
+	if r.Schema == nil {
+		return nil
+	}
+
+	schemaValidateRequest := SchemaValidateRequest{
+		Path: req.Path.Schema(),
+	}
+
+	return r.Schema.Validate(ctx, schemaValidateRequest)
+}
+
+// EphemeralResourcesValidateRequest defines the request sent during validation of EphemeralResources.
+type EphemeralResourcesValidateRequest struct{}
+
+// EphemeralResources type defines EphemeralResource types.
+type EphemeralResources []EphemeralResource
+
+// Validate checks for duplicated ephemeral resource names and delegates to EphemeralResource.Validate
+// for each ephemeral resource.
+func (rs EphemeralResources) Validate(ctx context.Context, req EphemeralResourcesValidateRequest) error {
+	ephemeralResourceNames := make(map[string]struct{}, len(rs))
+
+	var errs, nestedErrs []error
+
+	for k, r := range rs {
+		path := schema.Path{}.EphemeralResource(r.Name, k)
+
+		if _, ok := ephemeralResourceNames[r.Name]; ok {
+			errs = append(errs, path.ErrorDiagnostic("Duplicated ephemeral resource", "is duplicated"))
+		}
+
+		ephemeralResourceNames[r.Name] = struct{}{}
+
+		validateRequest := ValidateRequest{
+			Path: path,
+		}
+
+		err := r.Validate(ctx, validateRequest)
+
+		if err != nil {
+			nestedErrs = append(nestedErrs, err)
+		}
+	}
+
+	e := append(errs, nestedErrs...)
+
+	return errors.Join(e...)
+}
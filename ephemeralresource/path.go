@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ephemeralresource
+
+// resolvesPath returns true if the given steps of a path expression resolve
+// to an attribute or block. The first step is resolved against the given
+// attributes and blocks, and each subsequent step is resolved against the
+// nested attributes and blocks of the preceding step.
+func resolvesPath(attributes Attributes, blocks Blocks, steps []string) bool {
+	if len(steps) == 0 {
+		return false
+	}
+
+	for _, attribute := range attributes {
+		if attribute.Name != steps[0] {
+			continue
+		}
+
+		if len(steps) == 1 {
+			return true
+		}
+
+		return resolvesPath(attribute.nestedAttributes(), nil, steps[1:])
+	}
+
+	for _, block := range blocks {
+		if block.Name != steps[0] {
+			continue
+		}
+
+		if len(steps) == 1 {
+			return true
+		}
+
+		switch {
+		case block.ListNested != nil:
+			return resolvesPath(block.ListNested.NestedObject.Attributes, block.ListNested.NestedObject.Blocks, steps[1:])
+		case block.SetNested != nil:
+			return resolvesPath(block.SetNested.NestedObject.Attributes, block.SetNested.NestedObject.Blocks, steps[1:])
+		case block.SingleNested != nil:
+			return resolvesPath(block.SingleNested.Attributes, block.SingleNested.Blocks, steps[1:])
+		}
+	}
+
+	return false
+}
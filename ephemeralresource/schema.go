@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ephemeralresource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/diag"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+// Schema defines the Attributes and Blocks associated with an EphemeralResource.
+type Schema struct {
+	// Attributes defines the Attribute types for the Schema..
+	Attributes Attributes `json:"attributes,omitempty"`
+
+	// Blocks defines the Block types for the Schema.
+	Blocks Blocks `json:"blocks,omitempty"`
+
+	// ConfigValidators defines validators which apply to the whole of the
+	// Schema, rather than to an individual attribute or block.
+	ConfigValidators schema.ConfigValidators `json:"config_validators,omitempty"`
+
+	// Description is used in various tooling, like the language server, to
+	// give practitioners more information about what this ephemeral resource is,
+	// what it's for, and how it should be used. It should be written as
+	// plain text, with no special formatting.
+	Description *string `json:"description,omitempty"`
+
+	// MarkdownDescription is used in various tooling, like the
+	// documentation generator, to give practitioners more information
+	// about what this ephemeral resource is, what it's for, and how it should be
+	// used. It should be formatted using Markdown.
+	MarkdownDescription *string `json:"markdown_description,omitempty"`
+
+	// DeprecationMessage defines warning diagnostic details to display when
+	// practitioner configurations use this ephemeral resource. The warning diagnostic
+	// summary is automatically set to "Ephemeral Resource Deprecated" along with
+	// configuration source file and line information.
+	//
+	// Set this field to a practitioner actionable message such as:
+	//
+	//  - "Use examplecloud_other ephemeral resource instead. This ephemeral resource
+	//    will be removed in the next major version of the provider."
+	//  - "Remove this ephemeral resource as it no longer is valid and
+	//    will be removed in the next major version of the provider."
+	//
+	DeprecationMessage *string `json:"deprecation_message,omitempty"`
+}
+
+// SchemaValidateRequest specifies the ephemeral resource being validated.
+type SchemaValidateRequest struct {
+	// Path defines the location of the schema, such as the schema of
+	// `ephemeral resource "example"`.
+	Path schema.Path
+}
+
+// Validate checks that the paths of ConfigValidators resolve to an attribute
+// or block, and delegates to Attributes.Validate and Blocks.Validate.
+func (s Schema) Validate(ctx context.Context, req SchemaValidateRequest) error {
+	var errs []error
+
+	attributeValidateRequest := AttributeValidateRequest{
+		Path:   req.Path,
+		Blocks: s.Blocks,
+		Root:   true,
+	}
+
+	err := s.Attributes.Validate(ctx, attributeValidateRequest)
+
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	blockValidateRequest := BlockValidateRequest{
+		Path:       req.Path,
+		Attributes: s.Attributes,
+		Root:       true,
+	}
+
+	err = s.Blocks.Validate(ctx, blockValidateRequest)
+
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	for k, validator := range s.ConfigValidators {
+		for _, relationship := range validator.PathRelationships() {
+			if !resolvesPath(s.Attributes, s.Blocks, relationship.Path.Steps()) {
+				errs = append(errs, diag.NewErrorDiagnostic(req.Path.String(), diag.Pointer(req.Path.JSONPointer(), "config_validators", k, relationship.Name), "Unresolved validator path", fmt.Sprintf("%s config validator path %q does not resolve to an attribute or block", relationship.Name, relationship.Path)))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ephemeralresource_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/ephemeralresource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+func TestAttribute_TypedAttribute(t *testing.T) {
+	t.Parallel()
+
+	custom := &schema.CustomValidator{
+		SchemaDefinition: "my_validator.Validate()",
+	}
+
+	testCases := map[string]struct {
+		attribute          ephemeralresource.Attribute
+		expected           schema.TypedAttribute
+		expectedValidators schema.Validators
+		expectedCustom     schema.CustomValidators
+	}{
+		"none": {
+			attribute: ephemeralresource.Attribute{
+				Name: "example",
+			},
+		},
+		"map": {
+			attribute: ephemeralresource.Attribute{
+				Name: "example",
+				Map: &ephemeralresource.MapAttribute{
+					ComputedOptionalRequired: schema.Required,
+					Validators: schema.MapValidators{
+						{
+							Custom: custom,
+						},
+					},
+				},
+			},
+			expected: &ephemeralresource.MapAttribute{
+				ComputedOptionalRequired: schema.Required,
+				Validators: schema.MapValidators{
+					{
+						Custom: custom,
+					},
+				},
+			},
+			expectedValidators: schema.MapValidators{
+				{
+					Custom: custom,
+				},
+			},
+			expectedCustom: schema.CustomValidators{custom},
+		},
+		"single-nested": {
+			attribute: ephemeralresource.Attribute{
+				Name: "example",
+				SingleNested: &ephemeralresource.SingleNestedAttribute{
+					ComputedOptionalRequired: schema.Computed,
+				},
+			},
+			expected: &ephemeralresource.SingleNestedAttribute{
+				ComputedOptionalRequired: schema.Computed,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var namedAttribute schema.NamedAttribute = testCase.attribute
+
+			if got := namedAttribute.GetName(); got != "example" {
+				t.Errorf("expected name %q, got %q", "example", got)
+			}
+
+			got := namedAttribute.TypedAttribute()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if got == nil {
+				return
+			}
+
+			validators := got.GetValidators()
+
+			if diff := cmp.Diff(validators, testCase.expectedValidators); diff != "" {
+				t.Errorf("unexpected validators difference: %s", diff)
+			}
+
+			if validators != nil {
+				if diff := cmp.Diff(validators.CustomValidators(), testCase.expectedCustom); diff != "" {
+					t.Errorf("unexpected custom validators difference: %s", diff)
+				}
+			}
+
+			if got.GetPlanModifiers() != nil {
+				t.Errorf("expected no plan modifiers, got %v", got.GetPlanModifiers())
+			}
+		})
+	}
+}
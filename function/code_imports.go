@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import "github.com/hashicorp/terraform-plugin-codegen-spec/code"
+
+// CodeImports returns each of the imports of the custom types within the
+// parameters, variadic parameter and return of the Function, which may
+// contain duplicates.
+func (f Function) CodeImports() []code.Import {
+	var imports []code.Import
+
+	for _, parameter := range f.Parameters {
+		imports = append(imports, parameter.codeImports()...)
+	}
+
+	if f.VariadicParameter != nil {
+		imports = append(imports, f.VariadicParameter.codeImports()...)
+	}
+
+	return append(imports, f.Return.codeImports()...)
+}
+
+// codeImports returns each of the imports of the parameter type which is set.
+func (p Parameter) codeImports() []code.Import {
+	var imports []code.Import
+
+	switch {
+	case p.Bool != nil:
+		imports = append(imports, p.Bool.CustomType.CodeImports()...)
+	case p.Dynamic != nil:
+		imports = append(imports, p.Dynamic.CustomType.CodeImports()...)
+	case p.Float64 != nil:
+		imports = append(imports, p.Float64.CustomType.CodeImports()...)
+	case p.Int64 != nil:
+		imports = append(imports, p.Int64.CustomType.CodeImports()...)
+	case p.List != nil:
+		imports = append(imports, p.List.CustomType.CodeImports()...)
+		imports = append(imports, p.List.ElementType.CodeImports()...)
+	case p.Map != nil:
+		imports = append(imports, p.Map.CustomType.CodeImports()...)
+		imports = append(imports, p.Map.ElementType.CodeImports()...)
+	case p.Number != nil:
+		imports = append(imports, p.Number.CustomType.CodeImports()...)
+	case p.Object != nil:
+		imports = append(imports, p.Object.CustomType.CodeImports()...)
+		imports = append(imports, p.Object.AttributeTypes.CodeImports()...)
+	case p.Set != nil:
+		imports = append(imports, p.Set.CustomType.CodeImports()...)
+		imports = append(imports, p.Set.ElementType.CodeImports()...)
+	case p.String != nil:
+		imports = append(imports, p.String.CustomType.CodeImports()...)
+	}
+
+	return imports
+}
+
+// codeImports returns each of the imports of the return type which is set.
+func (r Return) codeImports() []code.Import {
+	var imports []code.Import
+
+	switch {
+	case r.Bool != nil:
+		imports = append(imports, r.Bool.CustomType.CodeImports()...)
+	case r.Dynamic != nil:
+		imports = append(imports, r.Dynamic.CustomType.CodeImports()...)
+	case r.Float64 != nil:
+		imports = append(imports, r.Float64.CustomType.CodeImports()...)
+	case r.Int64 != nil:
+		imports = append(imports, r.Int64.CustomType.CodeImports()...)
+	case r.List != nil:
+		imports = append(imports, r.List.CustomType.CodeImports()...)
+		imports = append(imports, r.List.ElementType.CodeImports()...)
+	case r.Map != nil:
+		imports = append(imports, r.Map.CustomType.CodeImports()...)
+		imports = append(imports, r.Map.ElementType.CodeImports()...)
+	case r.Number != nil:
+		imports = append(imports, r.Number.CustomType.CodeImports()...)
+	case r.Object != nil:
+		imports = append(imports, r.Object.CustomType.CodeImports()...)
+		imports = append(imports, r.Object.AttributeTypes.CodeImports()...)
+	case r.Set != nil:
+		imports = append(imports, r.Set.CustomType.CodeImports()...)
+		imports = append(imports, r.Set.ElementType.CodeImports()...)
+	case r.String != nil:
+		imports = append(imports, r.String.CustomType.CodeImports()...)
+	}
+
+	return imports
+}
@@ -0,0 +1,7 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package function contains provider-defined function specific types,
+// such as parameters and return, for the Go bindings of the JSON
+// schema specification.
+package function
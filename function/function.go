@@ -0,0 +1,134 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+// ValidateRequest defines the Path of the function that is
+// being validated.
+type ValidateRequest struct {
+	// Path defines the location of the function, such as `function "example"`.
+	Path schema.Path
+}
+
+// Function defines an individual provider-defined function.
+type Function struct {
+	// Name is the string identifier for the function.
+	Name string `json:"name"`
+
+	// Parameters defines the ordered Parameter types for the function.
+	Parameters Parameters `json:"parameters,omitempty"`
+
+	// VariadicParameter defines an optional final Parameter which
+	// accepts zero, one, or more arguments.
+	VariadicParameter *Parameter `json:"variadic_parameter,omitempty"`
+
+	// Return defines the type of the value returned by the function.
+	Return Return `json:"return"`
+
+	// Summary is a short description of the function. It should be
+	// written as plain text, with no special formatting.
+	Summary *string `json:"summary,omitempty"`
+
+	// Description is used in various tooling, like the language server, to
+	// give practitioners more information about what this function is,
+	// what it's for, and how it should be used. It should be written as
+	// plain text, with no special formatting.
+	Description *string `json:"description,omitempty"`
+
+	// MarkdownDescription is used in various tooling, like the
+	// documentation generator, to give practitioners more information
+	// about what this function is, what it's for, and how it should be
+	// used. It should be formatted using Markdown.
+	MarkdownDescription *string `json:"markdown_description,omitempty"`
+
+	// DeprecationMessage defines warning diagnostic details to display when
+	// practitioner configurations use this function.
+	//
+	// Set this field to a practitioner actionable message such as:
+	//
+	//  - "Use examplecloud_other function instead. This function
+	//    will be removed in the next major version of the provider."
+	//
+	DeprecationMessage *string `json:"deprecation_message,omitempty"`
+}
+
+// Validate checks for duplicated parameter names, including the name of
+// the VariadicParameter, and delegates to Parameters.Validate and
+// Return.Validate.
+func (f Function) Validate(ctx context.Context, req ValidateRequest) error {
+	parameters := make(Parameters, 0, len(f.Parameters)+1)
+
+	parameters = append(parameters, f.Parameters...)
+
+	if f.VariadicParameter != nil {
+		parameters = append(parameters, *f.VariadicParameter)
+	}
+
+	var errs []error
+
+	parameterValidateRequest := ParameterValidateRequest{
+		Path:     req.Path,
+		Variadic: f.VariadicParameter != nil,
+	}
+
+	err := parameters.Validate(ctx, parameterValidateRequest)
+
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	returnValidateRequest := ReturnValidateRequest(req)
+
+	err = f.Return.Validate(ctx, returnValidateRequest)
+
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// FunctionsValidateRequest defines the request sent during validation of Functions.
+type FunctionsValidateRequest struct{}
+
+// Functions type defines Function types.
+type Functions []Function
+
+// Validate checks for duplicated function names and delegates to Function.Validate
+// for each function.
+func (fs Functions) Validate(ctx context.Context, req FunctionsValidateRequest) error {
+	functionNames := make(map[string]struct{}, len(fs))
+
+	var errs, nestedErrs []error
+
+	for k, f := range fs {
+		path := schema.Path{}.Function(f.Name, k)
+
+		if _, ok := functionNames[f.Name]; ok {
+			errs = append(errs, path.ErrorDiagnostic("Duplicated function", "is duplicated"))
+		}
+
+		functionNames[f.Name] = struct{}{}
+
+		validateRequest := ValidateRequest{
+			Path: path,
+		}
+
+		err := f.Validate(ctx, validateRequest)
+
+		if err != nil {
+			nestedErrs = append(nestedErrs, err)
+		}
+	}
+
+	e := append(errs, nestedErrs...)
+
+	return errors.Join(e...)
+}
@@ -0,0 +1,244 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/function"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+func TestFunction_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		function      function.Function
+		request       function.ValidateRequest
+		expectedError error
+	}{
+		"parameter-names-duplicated": {
+			function: function.Function{
+				Parameters: function.Parameters{
+					{
+						Name: "param_one",
+						Bool: &function.BoolParameter{},
+					},
+					{
+						Name: "param_one",
+						Bool: &function.BoolParameter{},
+					},
+				},
+			},
+			request: function.ValidateRequest{
+				Path: schema.Path{}.Function("example", 0),
+			},
+			expectedError: fmt.Errorf(`function "example" parameter "param_one" is duplicated`),
+		},
+		"parameter-names-triplicated": {
+			function: function.Function{
+				Parameters: function.Parameters{
+					{
+						Name: "param_one",
+						Bool: &function.BoolParameter{},
+					},
+					{
+						Name: "param_one",
+						Bool: &function.BoolParameter{},
+					},
+					{
+						Name: "param_one",
+						Bool: &function.BoolParameter{},
+					},
+				},
+			},
+			request: function.ValidateRequest{
+				Path: schema.Path{}.Function("example", 0),
+			},
+			expectedError: fmt.Errorf(`function "example" parameter "param_one" is duplicated` + "\n" +
+				`function "example" parameter "param_one" is duplicated`),
+		},
+		"parameter-names-unique": {
+			function: function.Function{
+				Parameters: function.Parameters{
+					{
+						Name: "param_one",
+						Bool: &function.BoolParameter{},
+					},
+					{
+						Name: "param_two",
+						Bool: &function.BoolParameter{},
+					},
+				},
+				VariadicParameter: &function.Parameter{
+					Name:   "param_three",
+					String: &function.StringParameter{},
+				},
+			},
+			request: function.ValidateRequest{
+				Path: schema.Path{}.Function("example", 0),
+			},
+		},
+		"variadic-parameter-name-duplicated": {
+			function: function.Function{
+				Parameters: function.Parameters{
+					{
+						Name: "param_one",
+						Bool: &function.BoolParameter{},
+					},
+				},
+				VariadicParameter: &function.Parameter{
+					Name:   "param_one",
+					String: &function.StringParameter{},
+				},
+			},
+			request: function.ValidateRequest{
+				Path: schema.Path{}.Function("example", 0),
+			},
+			expectedError: fmt.Errorf(`function "example" parameter "param_one" is duplicated`),
+		},
+		"object-parameter-attribute-type-names-duplicated": {
+			function: function.Function{
+				Parameters: function.Parameters{
+					{
+						Name: "param_one",
+						Object: &function.ObjectParameter{
+							AttributeTypes: schema.ObjectAttributeTypes{
+								{
+									Name: "obj_attr_one",
+								},
+								{
+									Name: "obj_attr_one",
+								},
+							},
+						},
+					},
+				},
+			},
+			request: function.ValidateRequest{
+				Path: schema.Path{}.Function("example", 0),
+			},
+			expectedError: fmt.Errorf(`function "example" parameter "param_one" object attribute type "obj_attr_one" is duplicated`),
+		},
+		"object-return-attribute-type-names-duplicated": {
+			function: function.Function{
+				Return: function.Return{
+					Object: &function.ObjectReturn{
+						AttributeTypes: schema.ObjectAttributeTypes{
+							{
+								Name: "obj_attr_one",
+							},
+							{
+								Name: "obj_attr_one",
+							},
+						},
+					},
+				},
+			},
+			request: function.ValidateRequest{
+				Path: schema.Path{}.Function("example", 0),
+			},
+			expectedError: fmt.Errorf(`function "example" return object attribute type "obj_attr_one" is duplicated`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.function.Validate(context.Background(), testCase.request)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError.Error() {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestFunctions_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		functions     function.Functions
+		expectedError error
+	}{
+		"function-names-duplicated": {
+			functions: function.Functions{
+				{
+					Name: "example",
+				},
+				{
+					Name: "example",
+				},
+			},
+			expectedError: fmt.Errorf(`function "example" is duplicated`),
+		},
+		"function-names-unique": {
+			functions: function.Functions{
+				{
+					Name: "example",
+				},
+				{
+					Name: "different",
+				},
+			},
+		},
+		"function-names-duplicated-and-parameter-names-duplicated": {
+			functions: function.Functions{
+				{
+					Name: "example",
+				},
+				{
+					Name: "example",
+					Parameters: function.Parameters{
+						{
+							Name: "param_one",
+						},
+						{
+							Name: "param_one",
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`function "example" is duplicated` + "\n" +
+				`function "example" parameter "param_one" is duplicated`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.functions.Validate(context.Background(), function.FunctionsValidateRequest{})
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError.Error() {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+// ValidateGoIdentifiers delegates to ObjectAttributeTypes.ValidateGoIdentifiers
+// for each object type, including an object type nested within list, map or
+// set element types, within the parameters, variadic parameter and return of
+// the Function. Parameter names are not validated, as parameters are passed
+// positionally, and generated code does not derive Go identifiers from their
+// names. This validation is opt-in, and is not performed by Validate.
+func (f Function) ValidateGoIdentifiers(ctx context.Context, req ValidateRequest) error {
+	var errs []error
+
+	for k, parameter := range f.Parameters {
+		err := parameter.validateGoIdentifiers(ctx, req.Path.Parameter(parameter.Name, k))
+
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if f.VariadicParameter != nil {
+		err := f.VariadicParameter.validateGoIdentifiers(ctx, req.Path.VariadicParameter(f.VariadicParameter.Name))
+
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	err := f.Return.validateGoIdentifiers(ctx, req.Path.Return())
+
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateGoIdentifiers delegates to the ValidateGoIdentifiers method of the
+// element or object attribute types of the parameter type which is set.
+func (p Parameter) validateGoIdentifiers(ctx context.Context, path schema.Path) error {
+	switch {
+	case p.List != nil:
+		return p.List.ElementType.ValidateGoIdentifiers(ctx, schema.ObjectValidateRequest{Path: path.ElementType("list")})
+	case p.Map != nil:
+		return p.Map.ElementType.ValidateGoIdentifiers(ctx, schema.ObjectValidateRequest{Path: path.ElementType("map")})
+	case p.Object != nil:
+		return p.Object.AttributeTypes.ValidateGoIdentifiers(ctx, schema.ObjectValidateRequest{Path: path})
+	case p.Set != nil:
+		return p.Set.ElementType.ValidateGoIdentifiers(ctx, schema.ObjectValidateRequest{Path: path.ElementType("set")})
+	}
+
+	return nil
+}
+
+// validateGoIdentifiers delegates to the ValidateGoIdentifiers method of the
+// element or object attribute types of the return type which is set.
+func (r Return) validateGoIdentifiers(ctx context.Context, path schema.Path) error {
+	switch {
+	case r.List != nil:
+		return r.List.ElementType.ValidateGoIdentifiers(ctx, schema.ObjectValidateRequest{Path: path.ElementType("list")})
+	case r.Map != nil:
+		return r.Map.ElementType.ValidateGoIdentifiers(ctx, schema.ObjectValidateRequest{Path: path.ElementType("map")})
+	case r.Object != nil:
+		return r.Object.AttributeTypes.ValidateGoIdentifiers(ctx, schema.ObjectValidateRequest{Path: path})
+	case r.Set != nil:
+		return r.Set.ElementType.ValidateGoIdentifiers(ctx, schema.ObjectValidateRequest{Path: path.ElementType("set")})
+	}
+
+	return nil
+}
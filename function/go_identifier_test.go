@@ -0,0 +1,155 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/function"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+func TestFunction_ValidateGoIdentifiers(t *testing.T) {
+	t.Parallel()
+
+	collidingAttributeTypes := schema.ObjectAttributeTypes{
+		{
+			Name:   "foo_bar",
+			String: &schema.StringType{},
+		},
+		{
+			Name:   "foo__bar",
+			String: &schema.StringType{},
+		},
+	}
+
+	testCases := map[string]struct {
+		function      function.Function
+		expectedError error
+	}{
+		"unique": {
+			function: function.Function{
+				Parameters: function.Parameters{
+					{
+						Name: "foo_bar",
+						Object: &function.ObjectParameter{
+							AttributeTypes: schema.ObjectAttributeTypes{
+								{
+									Name:   "foo_bar",
+									String: &schema.StringType{},
+								},
+								{
+									Name:   "foo_baz",
+									String: &schema.StringType{},
+								},
+							},
+						},
+					},
+					{
+						Name:   "foo__bar",
+						String: &function.StringParameter{},
+					},
+				},
+				Return: function.Return{
+					String: &function.StringReturn{},
+				},
+			},
+		},
+		"parameter-object-collision": {
+			function: function.Function{
+				Parameters: function.Parameters{
+					{
+						Name: "param",
+						Object: &function.ObjectParameter{
+							AttributeTypes: collidingAttributeTypes,
+						},
+					},
+				},
+				Return: function.Return{
+					String: &function.StringReturn{},
+				},
+			},
+			expectedError: fmt.Errorf(`function "example" parameter "param" object attribute type "foo_bar" and object attribute type "foo__bar" both generate Go identifier "FooBar"`),
+		},
+		"parameter-element-type-collision": {
+			function: function.Function{
+				Parameters: function.Parameters{
+					{
+						Name: "param",
+						List: &function.ListParameter{
+							ElementType: schema.ElementType{
+								Object: &schema.ObjectType{
+									AttributeTypes: collidingAttributeTypes,
+								},
+							},
+						},
+					},
+				},
+				Return: function.Return{
+					String: &function.StringReturn{},
+				},
+			},
+			expectedError: fmt.Errorf(`function "example" parameter "param" element type object attribute type "foo_bar" and object attribute type "foo__bar" both generate Go identifier "FooBar"`),
+		},
+		"variadic-parameter-collision": {
+			function: function.Function{
+				VariadicParameter: &function.Parameter{
+					Name: "variadic",
+					Set: &function.SetParameter{
+						ElementType: schema.ElementType{
+							Object: &schema.ObjectType{
+								AttributeTypes: collidingAttributeTypes,
+							},
+						},
+					},
+				},
+				Return: function.Return{
+					String: &function.StringReturn{},
+				},
+			},
+			expectedError: fmt.Errorf(`function "example" parameter "variadic" element type object attribute type "foo_bar" and object attribute type "foo__bar" both generate Go identifier "FooBar"`),
+		},
+		"return-collision": {
+			function: function.Function{
+				Return: function.Return{
+					Map: &function.MapReturn{
+						ElementType: schema.ElementType{
+							Object: &schema.ObjectType{
+								AttributeTypes: collidingAttributeTypes,
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`function "example" return element type object attribute type "foo_bar" and object attribute type "foo__bar" both generate Go identifier "FooBar"`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.function.ValidateGoIdentifiers(context.Background(), function.ValidateRequest{
+				Path: schema.Path{}.Function("example", 0),
+			})
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError.Error() {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+// ValidateGoSyntax returns an error for each custom type, within the
+// parameters, variadic parameter and return of the Function, which is not
+// valid Go syntax or which references a package that is not declared in its
+// import. This validation is opt-in, and is not performed by Validate.
+func (f Function) ValidateGoSyntax(ctx context.Context, req ValidateRequest) error {
+	var errs []error
+
+	for k, parameter := range f.Parameters {
+		err := parameter.validateGoSyntax(ctx, req.Path.Parameter(parameter.Name, k))
+
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if f.VariadicParameter != nil {
+		err := f.VariadicParameter.validateGoSyntax(ctx, req.Path.VariadicParameter(f.VariadicParameter.Name))
+
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	err := f.Return.validateGoSyntax(ctx, req.Path.Return())
+
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateGoSyntax delegates to the ValidateGoSyntax method of the custom
+// type, and any element or object attribute types, of the parameter type
+// which is set.
+func (p Parameter) validateGoSyntax(ctx context.Context, path schema.Path) error {
+	req := schema.GoSyntaxValidateRequest{
+		Path: path,
+	}
+
+	switch {
+	case p.Bool != nil:
+		return p.Bool.CustomType.ValidateGoSyntax(ctx, req)
+	case p.Dynamic != nil:
+		return p.Dynamic.CustomType.ValidateGoSyntax(ctx, req)
+	case p.Float64 != nil:
+		return p.Float64.CustomType.ValidateGoSyntax(ctx, req)
+	case p.Int64 != nil:
+		return p.Int64.CustomType.ValidateGoSyntax(ctx, req)
+	case p.List != nil:
+		return errors.Join(
+			p.List.CustomType.ValidateGoSyntax(ctx, req),
+			p.List.ElementType.ValidateGoSyntax(ctx, schema.GoSyntaxValidateRequest{Path: path.ElementType("list")}),
+		)
+	case p.Map != nil:
+		return errors.Join(
+			p.Map.CustomType.ValidateGoSyntax(ctx, req),
+			p.Map.ElementType.ValidateGoSyntax(ctx, schema.GoSyntaxValidateRequest{Path: path.ElementType("map")}),
+		)
+	case p.Number != nil:
+		return p.Number.CustomType.ValidateGoSyntax(ctx, req)
+	case p.Object != nil:
+		return errors.Join(
+			p.Object.CustomType.ValidateGoSyntax(ctx, req),
+			p.Object.AttributeTypes.ValidateGoSyntax(ctx, req),
+		)
+	case p.Set != nil:
+		return errors.Join(
+			p.Set.CustomType.ValidateGoSyntax(ctx, req),
+			p.Set.ElementType.ValidateGoSyntax(ctx, schema.GoSyntaxValidateRequest{Path: path.ElementType("set")}),
+		)
+	case p.String != nil:
+		return p.String.CustomType.ValidateGoSyntax(ctx, req)
+	}
+
+	return nil
+}
+
+// validateGoSyntax delegates to the ValidateGoSyntax method of the custom
+// type, and any element or object attribute types, of the return type which
+// is set.
+func (r Return) validateGoSyntax(ctx context.Context, path schema.Path) error {
+	req := schema.GoSyntaxValidateRequest{
+		Path: path,
+	}
+
+	switch {
+	case r.Bool != nil:
+		return r.Bool.CustomType.ValidateGoSyntax(ctx, req)
+	case r.Dynamic != nil:
+		return r.Dynamic.CustomType.ValidateGoSyntax(ctx, req)
+	case r.Float64 != nil:
+		return r.Float64.CustomType.ValidateGoSyntax(ctx, req)
+	case r.Int64 != nil:
+		return r.Int64.CustomType.ValidateGoSyntax(ctx, req)
+	case r.List != nil:
+		return errors.Join(
+			r.List.CustomType.ValidateGoSyntax(ctx, req),
+			r.List.ElementType.ValidateGoSyntax(ctx, schema.GoSyntaxValidateRequest{Path: path.ElementType("list")}),
+		)
+	case r.Map != nil:
+		return errors.Join(
+			r.Map.CustomType.ValidateGoSyntax(ctx, req),
+			r.Map.ElementType.ValidateGoSyntax(ctx, schema.GoSyntaxValidateRequest{Path: path.ElementType("map")}),
+		)
+	case r.Number != nil:
+		return r.Number.CustomType.ValidateGoSyntax(ctx, req)
+	case r.Object != nil:
+		return errors.Join(
+			r.Object.CustomType.ValidateGoSyntax(ctx, req),
+			r.Object.AttributeTypes.ValidateGoSyntax(ctx, req),
+		)
+	case r.Set != nil:
+		return errors.Join(
+			r.Set.CustomType.ValidateGoSyntax(ctx, req),
+			r.Set.ElementType.ValidateGoSyntax(ctx, schema.GoSyntaxValidateRequest{Path: path.ElementType("set")}),
+		)
+	case r.String != nil:
+		return r.String.CustomType.ValidateGoSyntax(ctx, req)
+	}
+
+	return nil
+}
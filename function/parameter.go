@@ -0,0 +1,313 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+// ParameterValidateRequest defines the Path of the parameter that is
+// being validated.
+type ParameterValidateRequest struct {
+	// Path defines the location of the function, such as
+	// `function "example"`.
+	Path schema.Path
+
+	// Variadic defines whether the last of the parameters being validated
+	// is the variadic_parameter of the function.
+	Variadic bool
+}
+
+// Parameters type defines Parameter types.
+type Parameters []Parameter
+
+// Validate checks for duplicated parameter names. Validate delegates to
+// ObjectAttributeTypes.Validate when the parameter is an ObjectParameter.
+func (p Parameters) Validate(ctx context.Context, req ParameterValidateRequest) error {
+	parameterNames := make(map[string]struct{}, len(p))
+
+	var errs, nestedErrs []error
+
+	for k, parameter := range p {
+		path := req.Path.Parameter(parameter.Name, k)
+
+		if req.Variadic && k == len(p)-1 {
+			path = req.Path.VariadicParameter(parameter.Name)
+		}
+
+		if _, ok := parameterNames[parameter.Name]; ok {
+			errs = append(errs, path.ErrorDiagnostic("Duplicated parameter", "is duplicated"))
+		}
+
+		parameterNames[parameter.Name] = struct{}{}
+
+		if parameter.Object == nil {
+			continue
+		}
+
+		objectValidateRequest := schema.ObjectValidateRequest{
+			Path: path,
+		}
+
+		err := parameter.Object.AttributeTypes.Validate(ctx, objectValidateRequest)
+
+		if err != nil {
+			nestedErrs = append(nestedErrs, err)
+		}
+	}
+
+	e := append(errs, nestedErrs...)
+
+	return errors.Join(e...)
+}
+
+// Parameter defines an argument to a Function. The parameter types
+// (e.g., Bool, Float64) are mutually exclusive, one and only one must
+// be specified.
+type Parameter struct {
+	// Name defines the parameter name.
+	Name string `json:"name"`
+
+	Bool    *BoolParameter    `json:"bool,omitempty"`
+	Dynamic *DynamicParameter `json:"dynamic,omitempty"`
+	Float64 *Float64Parameter `json:"float64,omitempty"`
+	Int64   *Int64Parameter   `json:"int64,omitempty"`
+	List    *ListParameter    `json:"list,omitempty"`
+	Map     *MapParameter     `json:"map,omitempty"`
+	Number  *NumberParameter  `json:"number,omitempty"`
+	Object  *ObjectParameter  `json:"object,omitempty"`
+	Set     *SetParameter     `json:"set,omitempty"`
+	String  *StringParameter  `json:"string,omitempty"`
+}
+
+// BoolParameter represents a function parameter that is a boolean.
+type BoolParameter struct {
+	// AllowNullValue indicates whether the parameter accepts a null
+	// value.
+	AllowNullValue *bool `json:"allow_null_value,omitempty"`
+
+	// AllowUnknownValues indicates whether the parameter accepts an
+	// unknown value.
+	AllowUnknownValues *bool `json:"allow_unknown_values,omitempty"`
+
+	// CustomType defines a custom type and value for the parameter.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// Description defines the purpose and usage of the parameter.
+	Description *string `json:"description,omitempty"`
+
+	// MarkdownDescription defines the purpose and usage of the parameter,
+	// formatted using Markdown.
+	MarkdownDescription *string `json:"markdown_description,omitempty"`
+}
+
+// DynamicParameter represents a function parameter that is dynamic.
+type DynamicParameter struct {
+	// AllowNullValue indicates whether the parameter accepts a null
+	// value.
+	AllowNullValue *bool `json:"allow_null_value,omitempty"`
+
+	// AllowUnknownValues indicates whether the parameter accepts an
+	// unknown value.
+	AllowUnknownValues *bool `json:"allow_unknown_values,omitempty"`
+
+	// CustomType defines a custom type and value for the parameter.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// Description defines the purpose and usage of the parameter.
+	Description *string `json:"description,omitempty"`
+
+	// MarkdownDescription defines the purpose and usage of the parameter,
+	// formatted using Markdown.
+	MarkdownDescription *string `json:"markdown_description,omitempty"`
+}
+
+// Float64Parameter represents a function parameter that is a 64-bit floating
+// point number.
+type Float64Parameter struct {
+	// AllowNullValue indicates whether the parameter accepts a null
+	// value.
+	AllowNullValue *bool `json:"allow_null_value,omitempty"`
+
+	// AllowUnknownValues indicates whether the parameter accepts an
+	// unknown value.
+	AllowUnknownValues *bool `json:"allow_unknown_values,omitempty"`
+
+	// CustomType defines a custom type and value for the parameter.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// Description defines the purpose and usage of the parameter.
+	Description *string `json:"description,omitempty"`
+
+	// MarkdownDescription defines the purpose and usage of the parameter,
+	// formatted using Markdown.
+	MarkdownDescription *string `json:"markdown_description,omitempty"`
+}
+
+// Int64Parameter represents a function parameter that is a 64-bit integer.
+type Int64Parameter struct {
+	// AllowNullValue indicates whether the parameter accepts a null
+	// value.
+	AllowNullValue *bool `json:"allow_null_value,omitempty"`
+
+	// AllowUnknownValues indicates whether the parameter accepts an
+	// unknown value.
+	AllowUnknownValues *bool `json:"allow_unknown_values,omitempty"`
+
+	// CustomType defines a custom type and value for the parameter.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// Description defines the purpose and usage of the parameter.
+	Description *string `json:"description,omitempty"`
+
+	// MarkdownDescription defines the purpose and usage of the parameter,
+	// formatted using Markdown.
+	MarkdownDescription *string `json:"markdown_description,omitempty"`
+}
+
+// ListParameter represents a function parameter that is a list with a single element
+// type.
+type ListParameter struct {
+	// AllowNullValue indicates whether the parameter accepts a null
+	// value.
+	AllowNullValue *bool `json:"allow_null_value,omitempty"`
+
+	// AllowUnknownValues indicates whether the parameter accepts an
+	// unknown value.
+	AllowUnknownValues *bool `json:"allow_unknown_values,omitempty"`
+
+	// CustomType defines a custom type and value for the parameter.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// Description defines the purpose and usage of the parameter.
+	Description *string `json:"description,omitempty"`
+
+	// ElementType is the type for all elements of the list.
+	ElementType schema.ElementType `json:"element_type"`
+
+	// MarkdownDescription defines the purpose and usage of the parameter,
+	// formatted using Markdown.
+	MarkdownDescription *string `json:"markdown_description,omitempty"`
+}
+
+// MapParameter represents a function parameter that is a map with a single element
+// type.
+type MapParameter struct {
+	// AllowNullValue indicates whether the parameter accepts a null
+	// value.
+	AllowNullValue *bool `json:"allow_null_value,omitempty"`
+
+	// AllowUnknownValues indicates whether the parameter accepts an
+	// unknown value.
+	AllowUnknownValues *bool `json:"allow_unknown_values,omitempty"`
+
+	// CustomType defines a custom type and value for the parameter.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// Description defines the purpose and usage of the parameter.
+	Description *string `json:"description,omitempty"`
+
+	// ElementType is the type for all elements of the map.
+	ElementType schema.ElementType `json:"element_type"`
+
+	// MarkdownDescription defines the purpose and usage of the parameter,
+	// formatted using Markdown.
+	MarkdownDescription *string `json:"markdown_description,omitempty"`
+}
+
+// NumberParameter represents a function parameter that is a generic number with up
+// to 512 bits of floating point or integer precision.
+type NumberParameter struct {
+	// AllowNullValue indicates whether the parameter accepts a null
+	// value.
+	AllowNullValue *bool `json:"allow_null_value,omitempty"`
+
+	// AllowUnknownValues indicates whether the parameter accepts an
+	// unknown value.
+	AllowUnknownValues *bool `json:"allow_unknown_values,omitempty"`
+
+	// CustomType defines a custom type and value for the parameter.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// Description defines the purpose and usage of the parameter.
+	Description *string `json:"description,omitempty"`
+
+	// MarkdownDescription defines the purpose and usage of the parameter,
+	// formatted using Markdown.
+	MarkdownDescription *string `json:"markdown_description,omitempty"`
+}
+
+// ObjectParameter represents a function parameter that is an object with only type
+// information for underlying attributes.
+type ObjectParameter struct {
+	// AllowNullValue indicates whether the parameter accepts a null
+	// value.
+	AllowNullValue *bool `json:"allow_null_value,omitempty"`
+
+	// AllowUnknownValues indicates whether the parameter accepts an
+	// unknown value.
+	AllowUnknownValues *bool `json:"allow_unknown_values,omitempty"`
+
+	// AttributeTypes provides the mapping of underlying names to types.
+	AttributeTypes schema.ObjectAttributeTypes `json:"attribute_types"`
+
+	// CustomType defines a custom type and value for the parameter.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// Description defines the purpose and usage of the parameter.
+	Description *string `json:"description,omitempty"`
+
+	// MarkdownDescription defines the purpose and usage of the parameter,
+	// formatted using Markdown.
+	MarkdownDescription *string `json:"markdown_description,omitempty"`
+}
+
+// SetParameter represents a function parameter that is a set with a single element
+// type.
+type SetParameter struct {
+	// AllowNullValue indicates whether the parameter accepts a null
+	// value.
+	AllowNullValue *bool `json:"allow_null_value,omitempty"`
+
+	// AllowUnknownValues indicates whether the parameter accepts an
+	// unknown value.
+	AllowUnknownValues *bool `json:"allow_unknown_values,omitempty"`
+
+	// CustomType defines a custom type and value for the parameter.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// Description defines the purpose and usage of the parameter.
+	Description *string `json:"description,omitempty"`
+
+	// ElementType is the type for all elements of the set.
+	ElementType schema.ElementType `json:"element_type"`
+
+	// MarkdownDescription defines the purpose and usage of the parameter,
+	// formatted using Markdown.
+	MarkdownDescription *string `json:"markdown_description,omitempty"`
+}
+
+// StringParameter represents a function parameter that is a string.
+type StringParameter struct {
+	// AllowNullValue indicates whether the parameter accepts a null
+	// value.
+	AllowNullValue *bool `json:"allow_null_value,omitempty"`
+
+	// AllowUnknownValues indicates whether the parameter accepts an
+	// unknown value.
+	AllowUnknownValues *bool `json:"allow_unknown_values,omitempty"`
+
+	// CustomType defines a custom type and value for the parameter.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// Description defines the purpose and usage of the parameter.
+	Description *string `json:"description,omitempty"`
+
+	// MarkdownDescription defines the purpose and usage of the parameter,
+	// formatted using Markdown.
+	MarkdownDescription *string `json:"markdown_description,omitempty"`
+}
@@ -0,0 +1,126 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+// ReturnValidateRequest defines the Path of the function return that is
+// being validated.
+type ReturnValidateRequest struct {
+	// Path defines the location of the function, such as
+	// `function "example"`.
+	Path schema.Path
+}
+
+// Return defines the type of value returned by a Function. The return
+// types (e.g., Bool, Float64) are mutually exclusive, one and only one
+// must be specified.
+type Return struct {
+	Bool    *BoolReturn    `json:"bool,omitempty"`
+	Dynamic *DynamicReturn `json:"dynamic,omitempty"`
+	Float64 *Float64Return `json:"float64,omitempty"`
+	Int64   *Int64Return   `json:"int64,omitempty"`
+	List    *ListReturn    `json:"list,omitempty"`
+	Map     *MapReturn     `json:"map,omitempty"`
+	Number  *NumberReturn  `json:"number,omitempty"`
+	Object  *ObjectReturn  `json:"object,omitempty"`
+	Set     *SetReturn     `json:"set,omitempty"`
+	String  *StringReturn  `json:"string,omitempty"`
+}
+
+// Validate delegates to ObjectAttributeTypes.Validate when the return is an
+// ObjectReturn.
+func (r Return) Validate(ctx context.Context, req ReturnValidateRequest) error {
+	if r.Object == nil {
+		return nil
+	}
+
+	objectValidateRequest := schema.ObjectValidateRequest{
+		Path: req.Path.Return(),
+	}
+
+	return r.Object.AttributeTypes.Validate(ctx, objectValidateRequest)
+}
+
+// BoolReturn represents a function return that is a boolean.
+type BoolReturn struct {
+	// CustomType defines a custom type and value for the return.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+}
+
+// DynamicReturn represents a function return that is dynamic.
+type DynamicReturn struct {
+	// CustomType defines a custom type and value for the return.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+}
+
+// Float64Return represents a function return that is a 64-bit floating
+// point number.
+type Float64Return struct {
+	// CustomType defines a custom type and value for the return.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+}
+
+// Int64Return represents a function return that is a 64-bit integer.
+type Int64Return struct {
+	// CustomType defines a custom type and value for the return.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+}
+
+// ListReturn represents a function return that is a list with a single element
+// type.
+type ListReturn struct {
+	// CustomType defines a custom type and value for the return.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// ElementType is the type for all elements of the list.
+	ElementType schema.ElementType `json:"element_type"`
+}
+
+// MapReturn represents a function return that is a map with a single element
+// type.
+type MapReturn struct {
+	// CustomType defines a custom type and value for the return.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// ElementType is the type for all elements of the map.
+	ElementType schema.ElementType `json:"element_type"`
+}
+
+// NumberReturn represents a function return that is a generic number with up
+// to 512 bits of floating point or integer precision.
+type NumberReturn struct {
+	// CustomType defines a custom type and value for the return.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+}
+
+// ObjectReturn represents a function return that is an object with only type
+// information for underlying attributes.
+type ObjectReturn struct {
+	// AttributeTypes provides the mapping of underlying names to types.
+	AttributeTypes schema.ObjectAttributeTypes `json:"attribute_types"`
+
+	// CustomType defines a custom type and value for the return.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+}
+
+// SetReturn represents a function return that is a set with a single element
+// type.
+type SetReturn struct {
+	// CustomType defines a custom type and value for the return.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// ElementType is the type for all elements of the set.
+	ElementType schema.ElementType `json:"element_type"`
+}
+
+// StringReturn represents a function return that is a string.
+type StringReturn struct {
+	// CustomType defines a custom type and value for the return.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+}
@@ -0,0 +1,190 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Command typedattribute generates the typed_attribute.go file of the
+// datasource, ephemeralresource, provider and resource packages, which
+// implements schema.NamedAttribute and schema.TypedAttribute for the
+// attribute types of each package. It is run with go generate from within
+// each package directory.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"text/template"
+)
+
+// attributeType defines an attribute type, such as StringAttribute.
+type attributeType struct {
+	// Name is the attribute type name without the Attribute suffix, which is
+	// also the name of the corresponding field of the Attribute type.
+	Name string
+
+	// Nested is true for list, map and set nested attributes, for which the
+	// AssociatedExternalType is defined by the NestedObject.
+	Nested bool
+}
+
+var attributeTypes = []attributeType{
+	{Name: "Bool"},
+	{Name: "Dynamic"},
+	{Name: "Float64"},
+	{Name: "Int64"},
+	{Name: "List"},
+	{Name: "ListNested", Nested: true},
+	{Name: "Map"},
+	{Name: "MapNested", Nested: true},
+	{Name: "Number"},
+	{Name: "Object"},
+	{Name: "Set"},
+	{Name: "SetNested", Nested: true},
+	{Name: "SingleNested"},
+	{Name: "String"},
+}
+
+// templateData defines the data passed to typedAttributeTemplate.
+type templateData struct {
+	AttributeTypes                []attributeType
+	ComputedOptionalRequiredField string
+	Package                       string
+	PlanModifiers                 bool
+}
+
+var typedAttributeTemplate = template.Must(template.New("typed_attribute.go").Parse(`// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/cmd/typedattribute; DO NOT EDIT.
+
+package {{ .Package }}
+
+import (
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+var (
+	_ schema.NamedAttribute = Attribute{}
+
+{{ range .AttributeTypes }}	_ schema.TypedAttribute = {{ .Name }}Attribute{}
+{{ end }})
+
+// GetName returns the Name of the Attribute.
+func (a Attribute) GetName() string {
+	return a.Name
+}
+
+// TypedAttribute returns the attribute type which is set, such as the
+// *StringAttribute, or nil if no attribute type is set.
+func (a Attribute) TypedAttribute() schema.TypedAttribute {
+	switch {
+{{- range .AttributeTypes }}
+	case a.{{ .Name }} != nil:
+		return a.{{ .Name }}
+{{- end }}
+	}
+
+	return nil
+}
+{{ range .AttributeTypes }}
+// AttributeKind returns schema.AttributeKind{{ .Name }}.
+func (a {{ .Name }}Attribute) AttributeKind() schema.AttributeKind {
+	return schema.AttributeKind{{ .Name }}
+}
+{{ if .Nested }}
+// GetAssociatedExternalType returns nil, as the AssociatedExternalType is
+// defined by the NestedObject.
+func (a {{ .Name }}Attribute) GetAssociatedExternalType() *schema.AssociatedExternalType {
+	return nil
+}
+{{ else }}
+// GetAssociatedExternalType returns the AssociatedExternalType.
+func (a {{ .Name }}Attribute) GetAssociatedExternalType() *schema.AssociatedExternalType {
+	return a.AssociatedExternalType
+}
+{{ end }}
+// GetComputedOptionalRequired returns the {{ $.ComputedOptionalRequiredField }}.
+func (a {{ .Name }}Attribute) GetComputedOptionalRequired() schema.ComputedOptionalRequired {
+	return a.{{ $.ComputedOptionalRequiredField }}
+}
+
+// GetCustomType returns the CustomType.
+func (a {{ .Name }}Attribute) GetCustomType() *schema.CustomType {
+	return a.CustomType
+}
+
+// GetDeprecationMessage returns the DeprecationMessage.
+func (a {{ .Name }}Attribute) GetDeprecationMessage() *string {
+	return a.DeprecationMessage
+}
+
+// GetDescription returns the Description.
+func (a {{ .Name }}Attribute) GetDescription() *string {
+	return a.Description
+}
+
+// GetSensitive returns the Sensitive flag.
+func (a {{ .Name }}Attribute) GetSensitive() *bool {
+	return a.Sensitive
+}
+
+// GetValidators returns the Validators, or nil if there are no validators.
+func (a {{ .Name }}Attribute) GetValidators() schema.Validators {
+	if len(a.Validators) == 0 {
+		return nil
+	}
+
+	return a.Validators
+}
+{{ if $.PlanModifiers }}
+// GetPlanModifiers returns the PlanModifiers, or nil if there are no plan
+// modifiers.
+func (a {{ .Name }}Attribute) GetPlanModifiers() schema.PlanModifiers {
+	if len(a.PlanModifiers) == 0 {
+		return nil
+	}
+
+	return a.PlanModifiers
+}
+{{ else }}
+// GetPlanModifiers returns nil, as plan modifiers are not supported.
+func (a {{ .Name }}Attribute) GetPlanModifiers() schema.PlanModifiers {
+	return nil
+}
+{{ end }}{{ end }}`))
+
+func main() {
+	data := templateData{
+		AttributeTypes: attributeTypes,
+	}
+
+	output := flag.String("output", "typed_attribute.go", "path of the generated file")
+
+	flag.StringVar(&data.ComputedOptionalRequiredField, "computed-optional-required-field", "ComputedOptionalRequired", "name of the field returned by GetComputedOptionalRequired")
+	flag.StringVar(&data.Package, "package", "", "name of the package")
+	flag.BoolVar(&data.PlanModifiers, "plan-modifiers", false, "whether the attribute types have PlanModifiers")
+
+	flag.Parse()
+
+	if data.Package == "" {
+		log.Fatal("-package is required")
+	}
+
+	var b bytes.Buffer
+
+	if err := typedAttributeTemplate.Execute(&b, data); err != nil {
+		log.Fatalf("executing template: %s", err)
+	}
+
+	source, err := format.Source(b.Bytes())
+
+	if err != nil {
+		log.Fatalf("formatting generated source: %s", err)
+	}
+
+	if err := os.WriteFile(*output, source, 0o644); err != nil {
+		log.Fatal(fmt.Errorf("writing %s: %w", *output, err))
+	}
+}
@@ -32,7 +32,7 @@ func TestAttributes_Validate(t *testing.T) {
 				},
 			},
 			request: provider.AttributeValidateRequest{
-				Path: `provider "example"`,
+				Path: schema.Path{}.Provider("example").Schema(),
 			},
 			expectedError: fmt.Errorf(`provider "example" attribute "attr_one" is duplicated`),
 		},
@@ -52,7 +52,7 @@ func TestAttributes_Validate(t *testing.T) {
 				},
 			},
 			request: provider.AttributeValidateRequest{
-				Path: `provider "example"`,
+				Path: schema.Path{}.Provider("example").Schema(),
 			},
 			expectedError: fmt.Errorf(`provider "example" attribute "attr_one" is duplicated` + "\n" +
 				`provider "example" attribute "attr_one" is duplicated`),
@@ -69,7 +69,7 @@ func TestAttributes_Validate(t *testing.T) {
 				},
 			},
 			request: provider.AttributeValidateRequest{
-				Path: `provider "example"`,
+				Path: schema.Path{}.Provider("example").Schema(),
 			},
 		},
 		"list-attribute-names-duplicated": {
@@ -91,7 +91,7 @@ func TestAttributes_Validate(t *testing.T) {
 				},
 			},
 			request: provider.AttributeValidateRequest{
-				Path: `provider "example"`,
+				Path: schema.Path{}.Provider("example").Schema(),
 			},
 			expectedError: fmt.Errorf(`provider "example" attribute "attr_one" attribute "nested_attr_one" is duplicated`),
 		},
@@ -117,7 +117,7 @@ func TestAttributes_Validate(t *testing.T) {
 				},
 			},
 			request: provider.AttributeValidateRequest{
-				Path: `provider "example"`,
+				Path: schema.Path{}.Provider("example").Schema(),
 			},
 			expectedError: fmt.Errorf(`provider "example" attribute "attr_one" attribute "nested_attr_one" is duplicated` + "\n" +
 				`provider "example" attribute "attr_one" attribute "nested_attr_one" is duplicated`),
@@ -141,7 +141,7 @@ func TestAttributes_Validate(t *testing.T) {
 				},
 			},
 			request: provider.AttributeValidateRequest{
-				Path: `provider "example"`,
+				Path: schema.Path{}.Provider("example").Schema(),
 			},
 		},
 		"attribute-and-list-attribute-names-duplicated": {
@@ -178,7 +178,7 @@ func TestAttributes_Validate(t *testing.T) {
 				},
 			},
 			request: provider.AttributeValidateRequest{
-				Path: `provider "example"`,
+				Path: schema.Path{}.Provider("example").Schema(),
 			},
 			expectedError: fmt.Errorf(`provider "example" attribute "attr_one" is duplicated` + "\n" +
 				`provider "example" attribute "attr_one" attribute "nested_attr_one" is duplicated` + "\n" +
@@ -201,7 +201,7 @@ func TestAttributes_Validate(t *testing.T) {
 				},
 			},
 			request: provider.AttributeValidateRequest{
-				Path: `provider "example"`,
+				Path: schema.Path{}.Provider("example").Schema(),
 			},
 			expectedError: fmt.Errorf(`provider "example" attribute "attr_one" object attribute type "obj_attr_one" is duplicated`),
 		},
@@ -235,7 +235,7 @@ func TestAttributes_Validate(t *testing.T) {
 				},
 			},
 			request: provider.AttributeValidateRequest{
-				Path: `provider "example"`,
+				Path: schema.Path{}.Provider("example").Schema(),
 			},
 			expectedError: fmt.Errorf(`provider "example" attribute "attr_one" is duplicated` + "\n" +
 				`provider "example" attribute "attr_one" object attribute type "obj_attr_one" is duplicated` + "\n" +
@@ -265,10 +265,90 @@ func TestAttributes_Validate(t *testing.T) {
 				},
 			},
 			request: provider.AttributeValidateRequest{
-				Path: `provider "example"`,
+				Path: schema.Path{}.Provider("example").Schema(),
 			},
 			expectedError: fmt.Errorf(`provider "example" attribute "attr_one" object attribute type "obj_attr_one" object attribute type "nested_obj_attr_one" is duplicated`),
 		},
+		"optional-required-computed": {
+			attributes: provider.Attributes{
+				{
+					Name: "attr_one",
+					String: &provider.StringAttribute{
+						OptionalRequired: schema.Computed,
+					},
+				},
+			},
+			request: provider.AttributeValidateRequest{
+				Path: schema.Path{}.Provider("example").Schema(),
+			},
+			expectedError: fmt.Errorf(`provider "example" attribute "attr_one" optional_required "computed" must be one of optional or required`),
+		},
+		"optional-required-computed-optional": {
+			attributes: provider.Attributes{
+				{
+					Name: "attr_one",
+					Bool: &provider.BoolAttribute{
+						OptionalRequired: schema.ComputedOptional,
+					},
+				},
+			},
+			request: provider.AttributeValidateRequest{
+				Path: schema.Path{}.Provider("example").Schema(),
+			},
+			expectedError: fmt.Errorf(`provider "example" attribute "attr_one" optional_required "computed_optional" must be one of optional or required`),
+		},
+		"reserved-name-root": {
+			attributes: provider.Attributes{
+				{
+					Name: "alias",
+					String: &provider.StringAttribute{
+						OptionalRequired: schema.Optional,
+					},
+				},
+			},
+			request: provider.AttributeValidateRequest{
+				Path: schema.Path{}.Provider("example").Schema(),
+				Root: true,
+			},
+			expectedError: fmt.Errorf(`provider "example" attribute "alias" is a reserved name`),
+		},
+		"reserved-name-root-permitted": {
+			attributes: provider.Attributes{
+				{
+					Name: "alias",
+					String: &provider.StringAttribute{
+						OptionalRequired: schema.Optional,
+					},
+				},
+			},
+			request: provider.AttributeValidateRequest{
+				Path:          schema.Path{}.Provider("example").Schema(),
+				Root:          true,
+				ReservedNames: true,
+			},
+		},
+		"reserved-name-nested": {
+			attributes: provider.Attributes{
+				{
+					Name: "attr_one",
+					SingleNested: &provider.SingleNestedAttribute{
+						Attributes: provider.Attributes{
+							{
+								Name: "alias",
+								String: &provider.StringAttribute{
+									OptionalRequired: schema.Optional,
+								},
+							},
+						},
+						OptionalRequired: schema.Optional,
+					},
+				},
+			},
+			request: provider.AttributeValidateRequest{
+				Path: schema.Path{}.Provider("example").Schema(),
+				Root: true,
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
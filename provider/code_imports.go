@@ -0,0 +1,154 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "github.com/hashicorp/terraform-plugin-codegen-spec/code"
+
+// CodeImports returns each of the imports within the Schema of the
+// Provider, which may contain duplicates.
+func (r Provider) CodeImports() []code.Import {
+	if r.Schema == nil {
+		return nil
+	}
+
+	return r.Schema.CodeImports()
+}
+
+// CodeImports returns each of the imports within the Schema, including
+// those of the config validators, which may contain duplicates.
+func (s Schema) CodeImports() []code.Import {
+	imports := s.ConfigValidators.CustomValidators().CodeImports()
+
+	return append(imports, codeImports(s.Attributes, s.Blocks)...)
+}
+
+// codeImports returns each of the imports within the given attributes and
+// blocks, and their nested attributes and blocks.
+func codeImports(attributes Attributes, blocks Blocks) []code.Import {
+	var imports []code.Import
+
+	for _, attribute := range attributes {
+		imports = append(imports, attribute.codeImports()...)
+		imports = append(imports, codeImports(attribute.nestedAttributes(), nil)...)
+	}
+
+	for _, block := range blocks {
+		imports = append(imports, block.codeImports()...)
+
+		switch {
+		case block.ListNested != nil:
+			imports = append(imports, codeImports(block.ListNested.NestedObject.Attributes, block.ListNested.NestedObject.Blocks)...)
+		case block.SetNested != nil:
+			imports = append(imports, codeImports(block.SetNested.NestedObject.Attributes, block.SetNested.NestedObject.Blocks)...)
+		case block.SingleNested != nil:
+			imports = append(imports, codeImports(block.SingleNested.Attributes, block.SingleNested.Blocks)...)
+		}
+	}
+
+	return imports
+}
+
+// codeImports returns each of the imports of the attribute type which is
+// set, excluding those of any nested attributes.
+func (a Attribute) codeImports() []code.Import {
+	var imports []code.Import
+
+	switch {
+	case a.Bool != nil:
+		imports = append(imports, a.Bool.AssociatedExternalType.CodeImports()...)
+		imports = append(imports, a.Bool.CustomType.CodeImports()...)
+		imports = append(imports, a.Bool.Validators.CustomValidators().CodeImports()...)
+	case a.Dynamic != nil:
+		imports = append(imports, a.Dynamic.AssociatedExternalType.CodeImports()...)
+		imports = append(imports, a.Dynamic.CustomType.CodeImports()...)
+		imports = append(imports, a.Dynamic.Validators.CustomValidators().CodeImports()...)
+	case a.Float64 != nil:
+		imports = append(imports, a.Float64.AssociatedExternalType.CodeImports()...)
+		imports = append(imports, a.Float64.CustomType.CodeImports()...)
+		imports = append(imports, a.Float64.Validators.CustomValidators().CodeImports()...)
+	case a.Int64 != nil:
+		imports = append(imports, a.Int64.AssociatedExternalType.CodeImports()...)
+		imports = append(imports, a.Int64.CustomType.CodeImports()...)
+		imports = append(imports, a.Int64.Validators.CustomValidators().CodeImports()...)
+	case a.List != nil:
+		imports = append(imports, a.List.AssociatedExternalType.CodeImports()...)
+		imports = append(imports, a.List.CustomType.CodeImports()...)
+		imports = append(imports, a.List.Validators.CustomValidators().CodeImports()...)
+		imports = append(imports, a.List.ElementType.CodeImports()...)
+	case a.ListNested != nil:
+		imports = append(imports, a.ListNested.CustomType.CodeImports()...)
+		imports = append(imports, a.ListNested.Validators.CustomValidators().CodeImports()...)
+		imports = append(imports, a.ListNested.NestedObject.AssociatedExternalType.CodeImports()...)
+		imports = append(imports, a.ListNested.NestedObject.CustomType.CodeImports()...)
+		imports = append(imports, a.ListNested.NestedObject.Validators.CustomValidators().CodeImports()...)
+	case a.Map != nil:
+		imports = append(imports, a.Map.AssociatedExternalType.CodeImports()...)
+		imports = append(imports, a.Map.CustomType.CodeImports()...)
+		imports = append(imports, a.Map.Validators.AllCustomValidators().CodeImports()...)
+		imports = append(imports, a.Map.ElementType.CodeImports()...)
+	case a.MapNested != nil:
+		imports = append(imports, a.MapNested.CustomType.CodeImports()...)
+		imports = append(imports, a.MapNested.Validators.AllCustomValidators().CodeImports()...)
+		imports = append(imports, a.MapNested.NestedObject.AssociatedExternalType.CodeImports()...)
+		imports = append(imports, a.MapNested.NestedObject.CustomType.CodeImports()...)
+		imports = append(imports, a.MapNested.NestedObject.Validators.CustomValidators().CodeImports()...)
+	case a.Number != nil:
+		imports = append(imports, a.Number.AssociatedExternalType.CodeImports()...)
+		imports = append(imports, a.Number.CustomType.CodeImports()...)
+		imports = append(imports, a.Number.Validators.CustomValidators().CodeImports()...)
+	case a.Object != nil:
+		imports = append(imports, a.Object.AssociatedExternalType.CodeImports()...)
+		imports = append(imports, a.Object.CustomType.CodeImports()...)
+		imports = append(imports, a.Object.Validators.CustomValidators().CodeImports()...)
+		imports = append(imports, a.Object.AttributeTypes.CodeImports()...)
+	case a.Set != nil:
+		imports = append(imports, a.Set.AssociatedExternalType.CodeImports()...)
+		imports = append(imports, a.Set.CustomType.CodeImports()...)
+		imports = append(imports, a.Set.Validators.CustomValidators().CodeImports()...)
+		imports = append(imports, a.Set.ElementType.CodeImports()...)
+	case a.SetNested != nil:
+		imports = append(imports, a.SetNested.CustomType.CodeImports()...)
+		imports = append(imports, a.SetNested.Validators.CustomValidators().CodeImports()...)
+		imports = append(imports, a.SetNested.NestedObject.AssociatedExternalType.CodeImports()...)
+		imports = append(imports, a.SetNested.NestedObject.CustomType.CodeImports()...)
+		imports = append(imports, a.SetNested.NestedObject.Validators.CustomValidators().CodeImports()...)
+	case a.SingleNested != nil:
+		imports = append(imports, a.SingleNested.AssociatedExternalType.CodeImports()...)
+		imports = append(imports, a.SingleNested.CustomType.CodeImports()...)
+		imports = append(imports, a.SingleNested.Validators.CustomValidators().CodeImports()...)
+	case a.String != nil:
+		imports = append(imports, a.String.AssociatedExternalType.CodeImports()...)
+		imports = append(imports, a.String.CustomType.CodeImports()...)
+		imports = append(imports, a.String.Validators.CustomValidators().CodeImports()...)
+	}
+
+	return imports
+}
+
+// codeImports returns each of the imports of the block type which is set,
+// excluding those of any nested attributes and blocks.
+func (b Block) codeImports() []code.Import {
+	var imports []code.Import
+
+	switch {
+	case b.ListNested != nil:
+		imports = append(imports, b.ListNested.CustomType.CodeImports()...)
+		imports = append(imports, b.ListNested.Validators.CustomValidators().CodeImports()...)
+		imports = append(imports, b.ListNested.NestedObject.AssociatedExternalType.CodeImports()...)
+		imports = append(imports, b.ListNested.NestedObject.CustomType.CodeImports()...)
+		imports = append(imports, b.ListNested.NestedObject.Validators.CustomValidators().CodeImports()...)
+	case b.SetNested != nil:
+		imports = append(imports, b.SetNested.CustomType.CodeImports()...)
+		imports = append(imports, b.SetNested.Validators.CustomValidators().CodeImports()...)
+		imports = append(imports, b.SetNested.NestedObject.AssociatedExternalType.CodeImports()...)
+		imports = append(imports, b.SetNested.NestedObject.CustomType.CodeImports()...)
+		imports = append(imports, b.SetNested.NestedObject.Validators.CustomValidators().CodeImports()...)
+	case b.SingleNested != nil:
+		imports = append(imports, b.SingleNested.AssociatedExternalType.CodeImports()...)
+		imports = append(imports, b.SingleNested.CustomType.CodeImports()...)
+		imports = append(imports, b.SingleNested.Validators.CustomValidators().CodeImports()...)
+	}
+
+	return imports
+}
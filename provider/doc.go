@@ -5,3 +5,5 @@
 // such as attributes and blocks, for the Go bindings of
 // the JSON schema specification.
 package provider
+
+//go:generate go run ../internal/cmd/typedattribute -package provider -computed-optional-required-field OptionalRequired
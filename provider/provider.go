@@ -5,13 +5,20 @@ package provider
 
 import (
 	"context"
-	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 )
 
 // ValidateRequest defines the Path of the provider that is
 // being validated.
 type ValidateRequest struct {
-	Path string
+	// Path defines the location of the provider, such as `provider "example"`.
+	Path schema.Path
+
+	// ReservedNames defines whether attributes and blocks at the root of the
+	// schema may use names which Terraform reserves, which is permitted by
+	// version 0.1 of the specification.
+	ReservedNames bool
 }
 
 // Provider defines an individual provider.
@@ -30,7 +37,8 @@ func (r Provider) Validate(ctx context.Context, req ValidateRequest) error {
 	}
 
 	schemaValidateRequest := SchemaValidateRequest{
-		Path: fmt.Sprintf("provider %q", r.Name),
+		Path:          req.Path.Schema(),
+		ReservedNames: req.ReservedNames,
 	}
 
 	return r.Schema.Validate(ctx, schemaValidateRequest)
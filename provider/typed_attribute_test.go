@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/provider"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+func TestAttribute_TypedAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute                        provider.Attribute
+		expectedKind                     schema.AttributeKind
+		expectedComputedOptionalRequired schema.ComputedOptionalRequired
+		expectedAssociatedExternalType   bool
+		expectedValidators               bool
+	}{
+		"map-nested": {
+			attribute: provider.Attribute{
+				Name: "example",
+				MapNested: &provider.MapNestedAttribute{
+					OptionalRequired: schema.Optional,
+				},
+			},
+			expectedKind:                     schema.AttributeKindMapNested,
+			expectedComputedOptionalRequired: schema.Optional,
+		},
+		"single-nested": {
+			attribute: provider.Attribute{
+				Name: "example",
+				SingleNested: &provider.SingleNestedAttribute{
+					AssociatedExternalType: &schema.AssociatedExternalType{
+						Type: "*api.Example",
+					},
+					OptionalRequired: schema.Required,
+				},
+			},
+			expectedKind:                     schema.AttributeKindSingleNested,
+			expectedComputedOptionalRequired: schema.Required,
+			expectedAssociatedExternalType:   true,
+		},
+		"number": {
+			attribute: provider.Attribute{
+				Name: "example",
+				Number: &provider.NumberAttribute{
+					OptionalRequired: schema.Required,
+					Validators: schema.NumberValidators{
+						{
+							Custom: &schema.CustomValidator{
+								SchemaDefinition: "my_validator.Validate()",
+							},
+						},
+					},
+				},
+			},
+			expectedKind:                     schema.AttributeKindNumber,
+			expectedComputedOptionalRequired: schema.Required,
+			expectedValidators:               true,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.TypedAttribute()
+
+			if got == nil {
+				t.Fatal("expected typed attribute, got nil")
+			}
+
+			if got.AttributeKind() != testCase.expectedKind {
+				t.Errorf("expected kind %q, got %q", testCase.expectedKind, got.AttributeKind())
+			}
+
+			if got.GetComputedOptionalRequired() != testCase.expectedComputedOptionalRequired {
+				t.Errorf("expected %q, got %q", testCase.expectedComputedOptionalRequired, got.GetComputedOptionalRequired())
+			}
+
+			if (got.GetAssociatedExternalType() != nil) != testCase.expectedAssociatedExternalType {
+				t.Errorf("expected associated external type %t, got %v", testCase.expectedAssociatedExternalType, got.GetAssociatedExternalType())
+			}
+
+			if (got.GetValidators() != nil) != testCase.expectedValidators {
+				t.Errorf("expected validators %t, got %v", testCase.expectedValidators, got.GetValidators())
+			}
+
+			if got.GetPlanModifiers() != nil {
+				t.Errorf("expected no plan modifiers, got %v", got.GetPlanModifiers())
+			}
+		})
+	}
+}
@@ -12,49 +12,137 @@ import (
 )
 
 // AttributeValidateRequest defines the Path of the attribute that is
-// being validated.
+// being validated, and the Blocks which are siblings of the attribute.
 type AttributeValidateRequest struct {
-	Path string
+	// Path defines the location of the object containing the attributes
+	// being validated, such as a schema or nested object.
+	Path schema.Path
+
+	// Blocks defines the blocks which are siblings of the attributes being
+	// validated, and is used to resolve path expressions.
+	Blocks Blocks
+
+	// Root defines whether the attributes being validated are at the root
+	// of the schema, where reserved names cannot be used
+	// unless ReservedNames is set.
+	Root bool
+
+	// ReservedNames defines whether attributes and blocks at the root of the
+	// schema may use names which Terraform reserves, which is permitted by
+	// version 0.1 of the specification.
+	ReservedNames bool
+
+	// OptionalDefaults defines whether attributes which are optional or
+	// required may have a default, which is permitted by version 0.1 of the
+	// specification.
+	OptionalDefaults bool
 }
 
 // Attributes type defines Attribute types.
 type Attributes []Attribute
 
-// Validate checks for duplicated attribute names. Validate is called recursively in
-// instances where an attribute contains nested attributes. Validate delegates to
-// ObjectAttributeTypes.Validate when the attribute is an ObjectAttribute.
+// Validate checks for duplicated attribute names, for reserved names at the
+// root of the schema, for invalid computed_optional_required values, for
+// defaults on attributes which are not computed unless OptionalDefaults is set,
+// for write-only attributes which are computed, have a default, or are nested
+// within a set, and that the paths of path-relationship validators resolve to
+// an attribute or block.
+// Validate is called recursively in instances where an attribute contains
+// nested attributes. Validate delegates to ObjectAttributeTypes.Validate when
+// the attribute is an ObjectAttribute, and to the Validate method of the
+// attribute validators and dynamic, list, map, object and set defaults.
 func (a Attributes) Validate(ctx context.Context, req AttributeValidateRequest) error {
 	attributeNames := make(map[string]struct{}, len(a))
 
 	var errs, nestedErrs []error
 
-	for _, attribute := range a {
+	for k, attribute := range a {
+		path := req.Path.Attribute(attribute.Name, k)
+
 		if _, ok := attributeNames[attribute.Name]; ok {
-			errs = append(errs, fmt.Errorf("%s attribute %q is duplicated", req.Path, attribute.Name))
+			errs = append(errs, path.ErrorDiagnostic("Duplicated attribute", "is duplicated"))
 		}
 
 		attributeNames[attribute.Name] = struct{}{}
 
+		if req.Root && !req.ReservedNames && isReservedName(attribute.Name) {
+			errs = append(errs, path.ErrorDiagnostic("Reserved attribute name", "is a reserved name"))
+		}
+
+		switch computedOptionalRequired := attribute.computedOptionalRequired(); computedOptionalRequired {
+		case "", schema.Computed, schema.ComputedOptional, schema.Optional, schema.Required:
+		default:
+			errs = append(errs, path.ErrorDiagnostic("Invalid computed_optional_required", fmt.Sprintf("computed_optional_required %q must be one of computed, computed_optional, optional or required", computedOptionalRequired)))
+		}
+
+		if attribute.hasDefault() && !req.OptionalDefaults {
+			switch computedOptionalRequired := attribute.computedOptionalRequired(); computedOptionalRequired {
+			case schema.Optional, schema.Required:
+				errs = append(errs, path.ErrorDiagnostic("Invalid default", fmt.Sprintf("has a default and must be computed or computed_optional, not %s", computedOptionalRequired)))
+			}
+		}
+
+		if attribute.writeOnly() {
+			switch computedOptionalRequired := attribute.computedOptionalRequired(); computedOptionalRequired {
+			case schema.Computed, schema.ComputedOptional:
+				errs = append(errs, path.ErrorDiagnostic("Invalid write_only", fmt.Sprintf("is write_only and must not be %s", computedOptionalRequired)))
+			}
+
+			if attribute.hasDefault() {
+				errs = append(errs, path.ErrorDiagnostic("Invalid write_only", "is write_only and must not have a default"))
+			}
+		}
+
+		if attribute.SetNested != nil {
+			errs = append(errs, attribute.SetNested.NestedObject.Attributes.setNestedWriteOnlyErrors(path.NestedObject("set_nested"))...)
+		}
+
+		validatorsErr := attribute.validateValidators(ctx, path)
+
+		if validatorsErr != nil {
+			errs = append(errs, validatorsErr)
+		}
+
+		defaultErr := attribute.validateDefault(ctx, path)
+
+		if defaultErr != nil {
+			errs = append(errs, defaultErr)
+		}
+
+		for _, relationship := range attribute.pathRelationships() {
+			if !resolvesPath(a, req.Blocks, relationship.Path.Steps()) {
+				errs = append(errs, path.ErrorDiagnostic("Unresolved validator path", fmt.Sprintf("%s validator path %q does not resolve to an attribute or block", relationship.Name, relationship.Path)))
+			}
+		}
+
 		var err error
 
 		attributeValidateRequest := AttributeValidateRequest{
-			Path: fmt.Sprintf("%s attribute %q", req.Path, attribute.Name),
+			OptionalDefaults: req.OptionalDefaults,
 		}
 
 		objectValidateRequest := schema.ObjectValidateRequest{
-			Path: fmt.Sprintf("%s attribute %q", req.Path, attribute.Name),
+			Path: path,
 		}
 
 		switch {
 		case attribute.ListNested != nil:
+			attributeValidateRequest.Path = path.NestedObject("list_nested")
+
 			err = attribute.ListNested.NestedObject.Attributes.Validate(ctx, attributeValidateRequest)
 		case attribute.MapNested != nil:
+			attributeValidateRequest.Path = path.NestedObject("map_nested")
+
 			err = attribute.MapNested.NestedObject.Attributes.Validate(ctx, attributeValidateRequest)
 		case attribute.Object != nil:
 			err = attribute.Object.AttributeTypes.Validate(ctx, objectValidateRequest)
 		case attribute.SetNested != nil:
+			attributeValidateRequest.Path = path.NestedObject("set_nested")
+
 			err = attribute.SetNested.NestedObject.Attributes.Validate(ctx, attributeValidateRequest)
 		case attribute.SingleNested != nil:
+			attributeValidateRequest.Path = path.NestedObject("single_nested")
+
 			err = attribute.SingleNested.Attributes.Validate(ctx, attributeValidateRequest)
 		}
 
@@ -91,6 +179,257 @@ type Attribute struct {
 	String       *StringAttribute       `json:"string,omitempty"`
 }
 
+// validateValidators delegates to the Validate method of the validators of the
+// attribute type which is set, at the given path, passing any default value.
+func (a Attribute) validateValidators(ctx context.Context, path schema.Path) error {
+	switch {
+	case a.Float64 != nil:
+		return a.Float64.Validators.Validate(ctx, schema.Float64ValidatorsValidateRequest{
+			Path:    path,
+			Default: a.Float64.Default,
+		})
+	case a.Int64 != nil:
+		return a.Int64.Validators.Validate(ctx, schema.Int64ValidatorsValidateRequest{
+			Path:    path,
+			Default: a.Int64.Default,
+		})
+	case a.Number != nil:
+		return a.Number.Validators.Validate(ctx, schema.NumberValidatorsValidateRequest{
+			Path: path,
+		})
+	}
+
+	return nil
+}
+
+// validateDefault delegates to the Validate method of the default of the
+// attribute type which is set, at the given path, passing any element or
+// attribute types.
+func (a Attribute) validateDefault(ctx context.Context, path schema.Path) error {
+	switch {
+	case a.Dynamic != nil:
+		return a.Dynamic.Default.Validate(ctx, schema.DynamicDefaultValidateRequest{
+			Path: path,
+		})
+	case a.List != nil:
+		return a.List.Default.Validate(ctx, schema.ListDefaultValidateRequest{
+			Path:        path,
+			ElementType: a.List.ElementType,
+		})
+	case a.Map != nil:
+		return a.Map.Default.Validate(ctx, schema.MapDefaultValidateRequest{
+			Path:        path,
+			ElementType: a.Map.ElementType,
+		})
+	case a.Object != nil:
+		return a.Object.Default.Validate(ctx, schema.ObjectDefaultValidateRequest{
+			Path:           path,
+			AttributeTypes: a.Object.AttributeTypes,
+		})
+	case a.Set != nil:
+		return a.Set.Default.Validate(ctx, schema.SetDefaultValidateRequest{
+			Path:        path,
+			ElementType: a.Set.ElementType,
+		})
+	}
+
+	return nil
+}
+
+// pathRelationships returns the path-relationship validators of the
+// attribute type which is set.
+func (a Attribute) pathRelationships() schema.PathRelationships {
+	switch {
+	case a.Bool != nil:
+		return a.Bool.Validators.PathRelationships()
+	case a.Dynamic != nil:
+		return a.Dynamic.Validators.PathRelationships()
+	case a.Float64 != nil:
+		return a.Float64.Validators.PathRelationships()
+	case a.Int64 != nil:
+		return a.Int64.Validators.PathRelationships()
+	case a.List != nil:
+		return a.List.Validators.PathRelationships()
+	case a.ListNested != nil:
+		return a.ListNested.Validators.PathRelationships()
+	case a.Map != nil:
+		return a.Map.Validators.PathRelationships()
+	case a.MapNested != nil:
+		return a.MapNested.Validators.PathRelationships()
+	case a.Number != nil:
+		return a.Number.Validators.PathRelationships()
+	case a.Object != nil:
+		return a.Object.Validators.PathRelationships()
+	case a.Set != nil:
+		return a.Set.Validators.PathRelationships()
+	case a.SetNested != nil:
+		return a.SetNested.Validators.PathRelationships()
+	case a.SingleNested != nil:
+		return a.SingleNested.Validators.PathRelationships()
+	case a.String != nil:
+		return a.String.Validators.PathRelationships()
+	}
+
+	return nil
+}
+
+// nestedAttributes returns the nested attributes of the attribute type
+// which is set, or nil if the attribute type does not have nested
+// attributes.
+func (a Attribute) nestedAttributes() Attributes {
+	switch {
+	case a.ListNested != nil:
+		return a.ListNested.NestedObject.Attributes
+	case a.MapNested != nil:
+		return a.MapNested.NestedObject.Attributes
+	case a.SetNested != nil:
+		return a.SetNested.NestedObject.Attributes
+	case a.SingleNested != nil:
+		return a.SingleNested.Attributes
+	}
+
+	return nil
+}
+
+// computedOptionalRequired returns the ComputedOptionalRequired of the
+// attribute type which is set.
+func (a Attribute) computedOptionalRequired() schema.ComputedOptionalRequired {
+	switch {
+	case a.Bool != nil:
+		return a.Bool.ComputedOptionalRequired
+	case a.Dynamic != nil:
+		return a.Dynamic.ComputedOptionalRequired
+	case a.Float64 != nil:
+		return a.Float64.ComputedOptionalRequired
+	case a.Int64 != nil:
+		return a.Int64.ComputedOptionalRequired
+	case a.List != nil:
+		return a.List.ComputedOptionalRequired
+	case a.ListNested != nil:
+		return a.ListNested.ComputedOptionalRequired
+	case a.Map != nil:
+		return a.Map.ComputedOptionalRequired
+	case a.MapNested != nil:
+		return a.MapNested.ComputedOptionalRequired
+	case a.Number != nil:
+		return a.Number.ComputedOptionalRequired
+	case a.Object != nil:
+		return a.Object.ComputedOptionalRequired
+	case a.Set != nil:
+		return a.Set.ComputedOptionalRequired
+	case a.SetNested != nil:
+		return a.SetNested.ComputedOptionalRequired
+	case a.SingleNested != nil:
+		return a.SingleNested.ComputedOptionalRequired
+	case a.String != nil:
+		return a.String.ComputedOptionalRequired
+	}
+
+	return ""
+}
+
+// hasDefault returns true if the attribute type which is set has a Default.
+func (a Attribute) hasDefault() bool {
+	switch {
+	case a.Bool != nil:
+		return a.Bool.Default != nil
+	case a.Dynamic != nil:
+		return a.Dynamic.Default != nil
+	case a.Float64 != nil:
+		return a.Float64.Default != nil
+	case a.Int64 != nil:
+		return a.Int64.Default != nil
+	case a.List != nil:
+		return a.List.Default != nil
+	case a.ListNested != nil:
+		return a.ListNested.Default != nil
+	case a.Map != nil:
+		return a.Map.Default != nil
+	case a.MapNested != nil:
+		return a.MapNested.Default != nil
+	case a.Number != nil:
+		return a.Number.Default != nil
+	case a.Object != nil:
+		return a.Object.Default != nil
+	case a.Set != nil:
+		return a.Set.Default != nil
+	case a.SetNested != nil:
+		return a.SetNested.Default != nil
+	case a.SingleNested != nil:
+		return a.SingleNested.Default != nil
+	case a.String != nil:
+		return a.String.Default != nil
+	}
+
+	return false
+}
+
+// writeOnly returns true if the attribute type which is set is write-only.
+func (a Attribute) writeOnly() bool {
+	var writeOnly *bool
+
+	switch {
+	case a.Bool != nil:
+		writeOnly = a.Bool.WriteOnly
+	case a.Dynamic != nil:
+		writeOnly = a.Dynamic.WriteOnly
+	case a.Float64 != nil:
+		writeOnly = a.Float64.WriteOnly
+	case a.Int64 != nil:
+		writeOnly = a.Int64.WriteOnly
+	case a.List != nil:
+		writeOnly = a.List.WriteOnly
+	case a.ListNested != nil:
+		writeOnly = a.ListNested.WriteOnly
+	case a.Map != nil:
+		writeOnly = a.Map.WriteOnly
+	case a.MapNested != nil:
+		writeOnly = a.MapNested.WriteOnly
+	case a.Number != nil:
+		writeOnly = a.Number.WriteOnly
+	case a.Object != nil:
+		writeOnly = a.Object.WriteOnly
+	case a.Set != nil:
+		writeOnly = a.Set.WriteOnly
+	case a.SetNested != nil:
+		writeOnly = a.SetNested.WriteOnly
+	case a.SingleNested != nil:
+		writeOnly = a.SingleNested.WriteOnly
+	case a.String != nil:
+		writeOnly = a.String.WriteOnly
+	}
+
+	return writeOnly != nil && *writeOnly
+}
+
+// setNestedWriteOnlyErrors returns an error for each write-only attribute, as
+// write-only attributes are not supported within a set nested attribute or block.
+// Nested attributes are checked recursively, except for those within a further
+// SetNestedAttribute, which are checked when that attribute is validated. The
+// given path is that of the object containing the attributes.
+func (a Attributes) setNestedWriteOnlyErrors(path schema.Path) []error {
+	var errs []error
+
+	for k, attribute := range a {
+		nestedPath := path.Attribute(attribute.Name, k)
+
+		if attribute.writeOnly() {
+			errs = append(errs, nestedPath.ErrorDiagnostic("Invalid write_only", "is write_only, which is not supported within a set nested attribute or block"))
+		}
+
+		switch {
+		case attribute.ListNested != nil:
+			errs = append(errs, attribute.ListNested.NestedObject.Attributes.setNestedWriteOnlyErrors(nestedPath.NestedObject("list_nested"))...)
+		case attribute.MapNested != nil:
+			errs = append(errs, attribute.MapNested.NestedObject.Attributes.setNestedWriteOnlyErrors(nestedPath.NestedObject("map_nested"))...)
+		case attribute.SingleNested != nil:
+			errs = append(errs, attribute.SingleNested.Attributes.setNestedWriteOnlyErrors(nestedPath.NestedObject("single_nested"))...)
+		}
+	}
+
+	return errs
+}
+
 // NestedAttributeObject is the underlying object defining the Attributes
 // for a ListNestedAttribute, MapNestedAttribute, or SetNestedAttribute.
 type NestedAttributeObject struct {
@@ -146,6 +485,10 @@ type BoolAttribute struct {
 	// Validators define types and functions that provide validation
 	// functionality for the block.
 	Validators schema.BoolValidators `json:"validators,omitempty"`
+
+	// WriteOnly indicates whether the value of the attribute is only
+	// available in configuration and is never persisted in plan or state.
+	WriteOnly *bool `json:"write_only,omitempty"`
 }
 
 // DynamicAttribute represents a Schema attribute that is dynamic.
@@ -182,6 +525,10 @@ type DynamicAttribute struct {
 	// Validators define types and functions that provide validation
 	// functionality for the block.
 	Validators schema.DynamicValidators `json:"validators,omitempty"`
+
+	// WriteOnly indicates whether the value of the attribute is only
+	// available in configuration and is never persisted in plan or state.
+	WriteOnly *bool `json:"write_only,omitempty"`
 }
 
 // Float64Attribute represents a Schema attribute that is a 64-bit
@@ -221,6 +568,10 @@ type Float64Attribute struct {
 	// Validators define types and functions that provide validation
 	// functionality for the block.
 	Validators schema.Float64Validators `json:"validators,omitempty"`
+
+	// WriteOnly indicates whether the value of the attribute is only
+	// available in configuration and is never persisted in plan or state.
+	WriteOnly *bool `json:"write_only,omitempty"`
 }
 
 // Int64Attribute represents a schema attribute that is a 64-bit
@@ -261,6 +612,10 @@ type Int64Attribute struct {
 	// Validators define types and functions that provide validation
 	// functionality for the block.
 	Validators schema.Int64Validators `json:"validators,omitempty"`
+
+	// WriteOnly indicates whether the value of the attribute is only
+	// available in configuration and is never persisted in plan or state.
+	WriteOnly *bool `json:"write_only,omitempty"`
 }
 
 // ListAttribute represents a Schema attribute that is a list with a single
@@ -301,6 +656,10 @@ type ListAttribute struct {
 	// Validators define types and functions that provide validation
 	// functionality for the block.
 	Validators schema.ListValidators `json:"validators,omitempty"`
+
+	// WriteOnly indicates whether the value of the attribute is only
+	// available in configuration and is never persisted in plan or state.
+	WriteOnly *bool `json:"write_only,omitempty"`
 }
 
 // ListNestedAttribute represents a Schema attribute that is a list of
@@ -338,6 +697,10 @@ type ListNestedAttribute struct {
 	// Validators define types and functions that provide validation
 	// functionality for the block.
 	Validators schema.ListValidators `json:"validators,omitempty"`
+
+	// WriteOnly indicates whether the value of the attribute is only
+	// available in configuration and is never persisted in plan or state.
+	WriteOnly *bool `json:"write_only,omitempty"`
 }
 
 // MapAttribute represents a Schema attribute that is a map with a single
@@ -378,6 +741,10 @@ type MapAttribute struct {
 	// Validators define types and functions that provide validation
 	// functionality for the block.
 	Validators schema.MapValidators `json:"validators,omitempty"`
+
+	// WriteOnly indicates whether the value of the attribute is only
+	// available in configuration and is never persisted in plan or state.
+	WriteOnly *bool `json:"write_only,omitempty"`
 }
 
 // MapNestedAttribute represents a Schema attribute that is a map of
@@ -415,6 +782,10 @@ type MapNestedAttribute struct {
 	// Validators define types and functions that provide validation
 	// functionality for the block.
 	Validators schema.MapValidators `json:"validators,omitempty"`
+
+	// WriteOnly indicates whether the value of the attribute is only
+	// available in configuration and is never persisted in plan or state.
+	WriteOnly *bool `json:"write_only,omitempty"`
 }
 
 // NumberAttribute represents a schema attribute that is a generic
@@ -455,6 +826,10 @@ type NumberAttribute struct {
 	// Validators define types and functions that provide validation
 	// functionality for the block.
 	Validators schema.NumberValidators `json:"validators,omitempty"`
+
+	// WriteOnly indicates whether the value of the attribute is only
+	// available in configuration and is never persisted in plan or state.
+	WriteOnly *bool `json:"write_only,omitempty"`
 }
 
 // ObjectAttribute represents a Schema attribute that is an object with only
@@ -495,6 +870,10 @@ type ObjectAttribute struct {
 	// Validators define types and functions that provide validation
 	// functionality for the block.
 	Validators schema.ObjectValidators `json:"validators,omitempty"`
+
+	// WriteOnly indicates whether the value of the attribute is only
+	// available in configuration and is never persisted in plan or state.
+	WriteOnly *bool `json:"write_only,omitempty"`
 }
 
 // SetAttribute represents a Schema attribute that is a set with a single
@@ -535,6 +914,10 @@ type SetAttribute struct {
 	// Validators define types and functions that provide validation
 	// functionality for the block.
 	Validators schema.SetValidators `json:"validators,omitempty"`
+
+	// WriteOnly indicates whether the value of the attribute is only
+	// available in configuration and is never persisted in plan or state.
+	WriteOnly *bool `json:"write_only,omitempty"`
 }
 
 // SetNestedAttribute represents a Schema attribute that is a list of
@@ -572,6 +955,10 @@ type SetNestedAttribute struct {
 	// Validators define types and functions that provide validation
 	// functionality for the block.
 	Validators schema.SetValidators `json:"validators,omitempty"`
+
+	// WriteOnly indicates whether the value of the attribute is only
+	// available in configuration and is never persisted in plan or state.
+	WriteOnly *bool `json:"write_only,omitempty"`
 }
 
 // SingleNestedAttribute represents a Schema attribute that is a single object where
@@ -612,6 +999,10 @@ type SingleNestedAttribute struct {
 	// Validators define types and functions that provide validation
 	// functionality for the block.
 	Validators schema.ObjectValidators `json:"validators,omitempty"`
+
+	// WriteOnly indicates whether the value of the attribute is only
+	// available in configuration and is never persisted in plan or state.
+	WriteOnly *bool `json:"write_only,omitempty"`
 }
 
 // StringAttribute represents a Schema attribute that is a string.
@@ -648,4 +1039,8 @@ type StringAttribute struct {
 	// Validators define types and functions that provide validation
 	// functionality for the block.
 	Validators schema.StringValidators `json:"validators,omitempty"`
+
+	// WriteOnly indicates whether the value of the attribute is only
+	// available in configuration and is never persisted in plan or state.
+	WriteOnly *bool `json:"write_only,omitempty"`
 }
@@ -32,7 +32,7 @@ func TestAttributes_Validate(t *testing.T) {
 				},
 			},
 			request: resource.AttributeValidateRequest{
-				Path: `resource "example"`,
+				Path: schema.Path{}.Resource("example", 0).Schema(),
 			},
 			expectedError: fmt.Errorf(`resource "example" attribute "attr_one" is duplicated`),
 		},
@@ -52,7 +52,7 @@ func TestAttributes_Validate(t *testing.T) {
 				},
 			},
 			request: resource.AttributeValidateRequest{
-				Path: `resource "example"`,
+				Path: schema.Path{}.Resource("example", 0).Schema(),
 			},
 			expectedError: fmt.Errorf(`resource "example" attribute "attr_one" is duplicated` + "\n" +
 				`resource "example" attribute "attr_one" is duplicated`),
@@ -69,7 +69,7 @@ func TestAttributes_Validate(t *testing.T) {
 				},
 			},
 			request: resource.AttributeValidateRequest{
-				Path: `resource "example"`,
+				Path: schema.Path{}.Resource("example", 0).Schema(),
 			},
 		},
 		"list-attribute-names-duplicated": {
@@ -91,7 +91,7 @@ func TestAttributes_Validate(t *testing.T) {
 				},
 			},
 			request: resource.AttributeValidateRequest{
-				Path: `resource "example"`,
+				Path: schema.Path{}.Resource("example", 0).Schema(),
 			},
 			expectedError: fmt.Errorf(`resource "example" attribute "attr_one" attribute "nested_attr_one" is duplicated`),
 		},
@@ -117,7 +117,7 @@ func TestAttributes_Validate(t *testing.T) {
 				},
 			},
 			request: resource.AttributeValidateRequest{
-				Path: `resource "example"`,
+				Path: schema.Path{}.Resource("example", 0).Schema(),
 			},
 			expectedError: fmt.Errorf(`resource "example" attribute "attr_one" attribute "nested_attr_one" is duplicated` + "\n" +
 				`resource "example" attribute "attr_one" attribute "nested_attr_one" is duplicated`),
@@ -141,7 +141,7 @@ func TestAttributes_Validate(t *testing.T) {
 				},
 			},
 			request: resource.AttributeValidateRequest{
-				Path: `resource "example"`,
+				Path: schema.Path{}.Resource("example", 0).Schema(),
 			},
 		},
 		"attribute-and-list-attribute-names-duplicated": {
@@ -178,7 +178,7 @@ func TestAttributes_Validate(t *testing.T) {
 				},
 			},
 			request: resource.AttributeValidateRequest{
-				Path: `resource "example"`,
+				Path: schema.Path{}.Resource("example", 0).Schema(),
 			},
 			expectedError: fmt.Errorf(`resource "example" attribute "attr_one" is duplicated` + "\n" +
 				`resource "example" attribute "attr_one" attribute "nested_attr_one" is duplicated` + "\n" +
@@ -201,7 +201,7 @@ func TestAttributes_Validate(t *testing.T) {
 				},
 			},
 			request: resource.AttributeValidateRequest{
-				Path: `resource "example"`,
+				Path: schema.Path{}.Resource("example", 0).Schema(),
 			},
 			expectedError: fmt.Errorf(`resource "example" attribute "attr_one" object attribute type "obj_attr_one" is duplicated`),
 		},
@@ -235,7 +235,7 @@ func TestAttributes_Validate(t *testing.T) {
 				},
 			},
 			request: resource.AttributeValidateRequest{
-				Path: `resource "example"`,
+				Path: schema.Path{}.Resource("example", 0).Schema(),
 			},
 			expectedError: fmt.Errorf(`resource "example" attribute "attr_one" is duplicated` + "\n" +
 				`resource "example" attribute "attr_one" object attribute type "obj_attr_one" is duplicated` + "\n" +
@@ -265,10 +265,508 @@ func TestAttributes_Validate(t *testing.T) {
 				},
 			},
 			request: resource.AttributeValidateRequest{
-				Path: `resource "example"`,
+				Path: schema.Path{}.Resource("example", 0).Schema(),
 			},
 			expectedError: fmt.Errorf(`resource "example" attribute "attr_one" object attribute type "obj_attr_one" object attribute type "nested_obj_attr_one" is duplicated`),
 		},
+		"write-only-required": {
+			attributes: resource.Attributes{
+				{
+					Name: "attr_one",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						WriteOnly:                pointer(true),
+					},
+				},
+			},
+			request: resource.AttributeValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema(),
+			},
+		},
+		"write-only-computed": {
+			attributes: resource.Attributes{
+				{
+					Name: "attr_one",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+						WriteOnly:                pointer(true),
+					},
+				},
+			},
+			request: resource.AttributeValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema(),
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr_one" is write_only and must not be computed`),
+		},
+		"write-only-computed-optional": {
+			attributes: resource.Attributes{
+				{
+					Name: "attr_one",
+					Int64: &resource.Int64Attribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						WriteOnly:                pointer(true),
+					},
+				},
+			},
+			request: resource.AttributeValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema(),
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr_one" is write_only and must not be computed_optional`),
+		},
+		"write-only-default": {
+			attributes: resource.Attributes{
+				{
+					Name: "attr_one",
+					Bool: &resource.BoolAttribute{
+						ComputedOptionalRequired: schema.Optional,
+						Default: &schema.BoolDefault{
+							Static: pointer(true),
+						},
+						WriteOnly: pointer(true),
+					},
+				},
+			},
+			request: resource.AttributeValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema(),
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr_one" has a default and must be computed or computed_optional, not optional` + "\n" +
+				`resource "example" attribute "attr_one" is write_only and must not have a default`),
+		},
+		"write-only-false-computed": {
+			attributes: resource.Attributes{
+				{
+					Name: "attr_one",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+						WriteOnly:                pointer(false),
+					},
+				},
+			},
+			request: resource.AttributeValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema(),
+			},
+		},
+		"write-only-list-nested-attribute-nested": {
+			attributes: resource.Attributes{
+				{
+					Name: "attr_one",
+					ListNested: &resource.ListNestedAttribute{
+						NestedObject: resource.NestedAttributeObject{
+							Attributes: resource.Attributes{
+								{
+									Name: "nested_attr_one",
+									String: &resource.StringAttribute{
+										ComputedOptionalRequired: schema.Optional,
+										WriteOnly:                pointer(true),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			request: resource.AttributeValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema(),
+			},
+		},
+		"write-only-set-nested-attribute-nested": {
+			attributes: resource.Attributes{
+				{
+					Name: "attr_one",
+					SetNested: &resource.SetNestedAttribute{
+						NestedObject: resource.NestedAttributeObject{
+							Attributes: resource.Attributes{
+								{
+									Name: "nested_attr_one",
+									SingleNested: &resource.SingleNestedAttribute{
+										Attributes: resource.Attributes{
+											{
+												Name: "nested_nested_attr_one",
+												String: &resource.StringAttribute{
+													ComputedOptionalRequired: schema.Optional,
+													WriteOnly:                pointer(true),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			request: resource.AttributeValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema(),
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr_one" attribute "nested_attr_one" attribute "nested_nested_attr_one" is write_only, which is not supported within a set nested attribute or block`),
+		},
+		"int64-validator-default-out-of-range": {
+			attributes: resource.Attributes{
+				{
+					Name: "attr_one",
+					Int64: &resource.Int64Attribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Default: &schema.Int64Default{
+							Static: pointer(int64(0)),
+						},
+						Validators: schema.Int64Validators{
+							{
+								AtLeast: &schema.Int64AtLeastValidator{
+									Min: 1,
+								},
+							},
+						},
+					},
+				},
+			},
+			request: resource.AttributeValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema(),
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr_one" default 0 is less than at_least validator min 1`),
+		},
+		"float64-validator-between-inverted": {
+			attributes: resource.Attributes{
+				{
+					Name: "attr_one",
+					Float64: &resource.Float64Attribute{
+						ComputedOptionalRequired: schema.Optional,
+						Validators: schema.Float64Validators{
+							{
+								Between: &schema.Float64BetweenValidator{
+									Min: 2.5,
+									Max: 1.5,
+								},
+							},
+						},
+					},
+				},
+			},
+			request: resource.AttributeValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema(),
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr_one" between validator min 2.5 is greater than max 1.5`),
+		},
+		"path-relationship-sibling-attribute": {
+			attributes: resource.Attributes{
+				{
+					Name: "attr_one",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Optional,
+						Validators: schema.StringValidators{
+							{
+								ConflictsWith: &schema.PathRelationshipValidator{
+									Paths: schema.PathExpressions{
+										"attr_two",
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "attr_two",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			},
+			request: resource.AttributeValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema(),
+			},
+		},
+		"path-relationship-sibling-block": {
+			attributes: resource.Attributes{
+				{
+					Name: "attr_one",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Optional,
+						Validators: schema.StringValidators{
+							{
+								ExactlyOneOf: &schema.PathRelationshipValidator{
+									Paths: schema.PathExpressions{
+										"block_one.attr_two",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			request: resource.AttributeValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema(),
+				Blocks: resource.Blocks{
+					{
+						Name: "block_one",
+						SingleNested: &resource.SingleNestedBlock{
+							Attributes: resource.Attributes{
+								{
+									Name: "attr_two",
+									Bool: &resource.BoolAttribute{
+										ComputedOptionalRequired: schema.Optional,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"path-relationship-nested-attribute": {
+			attributes: resource.Attributes{
+				{
+					Name: "attr_one",
+					Int64: &resource.Int64Attribute{
+						ComputedOptionalRequired: schema.Optional,
+						Validators: schema.Int64Validators{
+							{
+								AlsoRequires: &schema.PathRelationshipValidator{
+									Paths: schema.PathExpressions{
+										"attr_two.attr_three",
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "attr_two",
+					SingleNested: &resource.SingleNestedAttribute{
+						Attributes: resource.Attributes{
+							{
+								Name: "attr_three",
+								Bool: &resource.BoolAttribute{
+									ComputedOptionalRequired: schema.Optional,
+								},
+							},
+						},
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			},
+			request: resource.AttributeValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema(),
+			},
+		},
+		"path-relationship-unresolved": {
+			attributes: resource.Attributes{
+				{
+					Name: "attr_one",
+					Bool: &resource.BoolAttribute{
+						ComputedOptionalRequired: schema.Optional,
+						Validators: schema.BoolValidators{
+							{
+								AtLeastOneOf: &schema.PathRelationshipValidator{
+									Paths: schema.PathExpressions{
+										"attr_two.attr_three",
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "attr_two",
+					Bool: &resource.BoolAttribute{
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			},
+			request: resource.AttributeValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema(),
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr_one" at_least_one_of validator path "attr_two.attr_three" does not resolve to an attribute or block`),
+		},
+		"list-default-static-element-type-mismatch": {
+			attributes: resource.Attributes{
+				{
+					Name: "attr_one",
+					List: &resource.ListAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Default: &schema.ListDefault{
+							Static: pointer([]any{"one"}),
+						},
+						ElementType: schema.ElementType{
+							Int64: &schema.Int64Type{},
+						},
+					},
+				},
+			},
+			request: resource.AttributeValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema(),
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr_one" default static value element 0 is not an int64`),
+		},
+		"dynamic-default-static-type-mismatch": {
+			attributes: resource.Attributes{
+				{
+					Name: "attr_one",
+					Dynamic: &resource.DynamicAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Default: &schema.DynamicDefault{
+							Static: &schema.DynamicStaticDefault{
+								Type: schema.ElementType{
+									Int64: &schema.Int64Type{},
+								},
+								Value: float64(1.5),
+							},
+						},
+					},
+				},
+			},
+			request: resource.AttributeValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema(),
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr_one" default static value is not an int64`),
+		},
+		"default-required": {
+			attributes: resource.Attributes{
+				{
+					Name: "attr_one",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						Default: &schema.StringDefault{
+							Static: pointer("example"),
+						},
+					},
+				},
+			},
+			request: resource.AttributeValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema(),
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr_one" has a default and must be computed or computed_optional, not required`),
+		},
+		"default-nested-optional": {
+			attributes: resource.Attributes{
+				{
+					Name: "attr_one",
+					SingleNested: &resource.SingleNestedAttribute{
+						Attributes: resource.Attributes{
+							{
+								Name: "nested_attr_one",
+								Bool: &resource.BoolAttribute{
+									ComputedOptionalRequired: schema.Optional,
+									Default: &schema.BoolDefault{
+										Static: pointer(true),
+									},
+								},
+							},
+						},
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			},
+			request: resource.AttributeValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema(),
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr_one" attribute "nested_attr_one" has a default and must be computed or computed_optional, not optional`),
+		},
+		"default-nested-optional-defaults": {
+			attributes: resource.Attributes{
+				{
+					Name: "attr_one",
+					SingleNested: &resource.SingleNestedAttribute{
+						Attributes: resource.Attributes{
+							{
+								Name: "nested_attr_one",
+								Bool: &resource.BoolAttribute{
+									ComputedOptionalRequired: schema.Optional,
+									Default: &schema.BoolDefault{
+										Static: pointer(true),
+									},
+								},
+							},
+						},
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			},
+			request: resource.AttributeValidateRequest{
+				Path:             schema.Path{}.Resource("example", 0).Schema(),
+				OptionalDefaults: true,
+			},
+		},
+		"default-computed": {
+			attributes: resource.Attributes{
+				{
+					Name: "attr_one",
+					Int64: &resource.Int64Attribute{
+						ComputedOptionalRequired: schema.Computed,
+						Default: &schema.Int64Default{
+							Static: pointer(int64(1)),
+						},
+					},
+				},
+			},
+			request: resource.AttributeValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema(),
+			},
+		},
+		"computed-optional-required-invalid": {
+			attributes: resource.Attributes{
+				{
+					Name: "attr_one",
+					Bool: &resource.BoolAttribute{
+						ComputedOptionalRequired: "computed_required",
+					},
+				},
+			},
+			request: resource.AttributeValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema(),
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr_one" computed_optional_required "computed_required" must be one of computed, computed_optional, optional or required`),
+		},
+		"reserved-name-root": {
+			attributes: resource.Attributes{
+				{
+					Name: "provisioner",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			},
+			request: resource.AttributeValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema(),
+				Root: true,
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "provisioner" is a reserved name`),
+		},
+		"reserved-name-root-permitted": {
+			attributes: resource.Attributes{
+				{
+					Name: "provisioner",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			},
+			request: resource.AttributeValidateRequest{
+				Path:          schema.Path{}.Resource("example", 0).Schema(),
+				Root:          true,
+				ReservedNames: true,
+			},
+		},
+		"reserved-name-nested": {
+			attributes: resource.Attributes{
+				{
+					Name: "attr_one",
+					SingleNested: &resource.SingleNestedAttribute{
+						Attributes: resource.Attributes{
+							{
+								Name: "provisioner",
+								String: &resource.StringAttribute{
+									ComputedOptionalRequired: schema.Optional,
+								},
+							},
+						},
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			},
+			request: resource.AttributeValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema(),
+				Root: true,
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
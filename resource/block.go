@@ -12,47 +12,105 @@ import (
 )
 
 // BlockValidateRequest defines the Path of the block that is
-// being validated.
+// being validated, and the Attributes which are siblings of the block.
 type BlockValidateRequest struct {
-	Path string
+	// Path defines the location of the object containing the blocks
+	// being validated, such as a schema or nested object.
+	Path schema.Path
+
+	// Attributes defines the attributes which are siblings of the blocks
+	// being validated, and is used to resolve path expressions.
+	Attributes Attributes
+
+	// Root defines whether the blocks being validated are at the root of
+	// the schema, where reserved names cannot be used
+	// unless ReservedNames is set.
+	Root bool
+
+	// ReservedNames defines whether attributes and blocks at the root of the
+	// schema may use names which Terraform reserves, which is permitted by
+	// version 0.1 of the specification.
+	ReservedNames bool
+
+	// OptionalDefaults defines whether attributes which are optional or
+	// required may have a default, which is permitted by version 0.1 of the
+	// specification.
+	OptionalDefaults bool
 }
 
 // Blocks type defines Block types.
 type Blocks []Block
 
-// Validate checks for duplicated block names. Validate is called recursively in
-// instances where a block contains nested blocks. Validate delegates to
-// Attributes.Validate in instances where the block has attributes.
+// Validate checks for duplicated block names, for reserved names at the root of
+// the schema, for write-only attributes nested within a SetNestedBlock, and
+// that the paths of path-relationship validators resolve to an attribute or
+// block. Validate is called recursively in instances where a block contains
+// nested blocks. Validate delegates to Attributes.Validate in instances where
+// the block has attributes.
 func (b Blocks) Validate(ctx context.Context, req BlockValidateRequest) error {
 	blockNames := make(map[string]struct{}, len(b))
 
 	var errs, nestedErrs []error
 
-	for _, block := range b {
+	for k, block := range b {
+		path := req.Path.Block(block.Name, k)
+
 		if _, ok := blockNames[block.Name]; ok {
-			errs = append(errs, fmt.Errorf("%s block %q is duplicated", req.Path, block.Name))
+			errs = append(errs, path.ErrorDiagnostic("Duplicated block", "is duplicated"))
 		}
 
 		blockNames[block.Name] = struct{}{}
 
+		if req.Root && !req.ReservedNames && isReservedName(block.Name) {
+			errs = append(errs, path.ErrorDiagnostic("Reserved block name", "is a reserved name"))
+		}
+
+		for _, relationship := range block.pathRelationships() {
+			if !resolvesPath(req.Attributes, b, relationship.Path.Steps()) {
+				errs = append(errs, path.ErrorDiagnostic("Unresolved validator path", fmt.Sprintf("%s validator path %q does not resolve to an attribute or block", relationship.Name, relationship.Path)))
+			}
+		}
+
+		if block.SetNested != nil {
+			nestedPath := path.NestedObject("set_nested")
+
+			errs = append(errs, block.SetNested.NestedObject.Attributes.setNestedWriteOnlyErrors(nestedPath)...)
+			errs = append(errs, block.SetNested.NestedObject.Blocks.setNestedWriteOnlyErrors(nestedPath)...)
+		}
+
 		attributeValidateRequest := AttributeValidateRequest{
-			Path: fmt.Sprintf("%s block %q", req.Path, block.Name),
+			OptionalDefaults: req.OptionalDefaults,
 		}
 
 		blockValidateRequest := BlockValidateRequest{
-			Path: fmt.Sprintf("%s block %q", req.Path, block.Name),
+			OptionalDefaults: req.OptionalDefaults,
 		}
 
 		var attributeErr, blockErr error
 
 		switch {
 		case block.ListNested != nil:
+			attributeValidateRequest.Path = path.NestedObject("list_nested")
+			attributeValidateRequest.Blocks = block.ListNested.NestedObject.Blocks
+			blockValidateRequest.Path = path.NestedObject("list_nested")
+			blockValidateRequest.Attributes = block.ListNested.NestedObject.Attributes
+
 			attributeErr = block.ListNested.NestedObject.Attributes.Validate(ctx, attributeValidateRequest)
 			blockErr = block.ListNested.NestedObject.Blocks.Validate(ctx, blockValidateRequest)
 		case block.SetNested != nil:
+			attributeValidateRequest.Path = path.NestedObject("set_nested")
+			attributeValidateRequest.Blocks = block.SetNested.NestedObject.Blocks
+			blockValidateRequest.Path = path.NestedObject("set_nested")
+			blockValidateRequest.Attributes = block.SetNested.NestedObject.Attributes
+
 			attributeErr = block.SetNested.NestedObject.Attributes.Validate(ctx, attributeValidateRequest)
 			blockErr = block.SetNested.NestedObject.Blocks.Validate(ctx, blockValidateRequest)
 		case block.SingleNested != nil:
+			attributeValidateRequest.Path = path.NestedObject("single_nested")
+			attributeValidateRequest.Blocks = block.SingleNested.Blocks
+			blockValidateRequest.Path = path.NestedObject("single_nested")
+			blockValidateRequest.Attributes = block.SingleNested.Attributes
+
 			attributeErr = block.SingleNested.Attributes.Validate(ctx, attributeValidateRequest)
 			blockErr = block.SingleNested.Blocks.Validate(ctx, blockValidateRequest)
 		}
@@ -71,6 +129,34 @@ func (b Blocks) Validate(ctx context.Context, req BlockValidateRequest) error {
 	return errors.Join(e...)
 }
 
+// setNestedWriteOnlyErrors returns an error for each write-only attribute within
+// the blocks, as write-only attributes are not supported within a set nested
+// attribute or block. Nested blocks are checked recursively, except for those
+// within a further SetNestedBlock, which are checked when that block is validated.
+// The given path is that of the object containing the blocks.
+func (b Blocks) setNestedWriteOnlyErrors(path schema.Path) []error {
+	var errs []error
+
+	for k, block := range b {
+		blockPath := path.Block(block.Name, k)
+
+		switch {
+		case block.ListNested != nil:
+			nestedPath := blockPath.NestedObject("list_nested")
+
+			errs = append(errs, block.ListNested.NestedObject.Attributes.setNestedWriteOnlyErrors(nestedPath)...)
+			errs = append(errs, block.ListNested.NestedObject.Blocks.setNestedWriteOnlyErrors(nestedPath)...)
+		case block.SingleNested != nil:
+			nestedPath := blockPath.NestedObject("single_nested")
+
+			errs = append(errs, block.SingleNested.Attributes.setNestedWriteOnlyErrors(nestedPath)...)
+			errs = append(errs, block.SingleNested.Blocks.setNestedWriteOnlyErrors(nestedPath)...)
+		}
+	}
+
+	return errs
+}
+
 // Block defines a structural field inside a Schema. The block types
 // (e.g., ListNested, SetNested) are mutually exclusive, one and
 // only one must be specified.
@@ -82,6 +168,21 @@ type Block struct {
 	SingleNested *SingleNestedBlock `json:"single_nested,omitempty"`
 }
 
+// pathRelationships returns the path-relationship validators of the block
+// type which is set.
+func (b Block) pathRelationships() schema.PathRelationships {
+	switch {
+	case b.ListNested != nil:
+		return b.ListNested.Validators.PathRelationships()
+	case b.SetNested != nil:
+		return b.SetNested.Validators.PathRelationships()
+	case b.SingleNested != nil:
+		return b.SingleNested.Validators.PathRelationships()
+	}
+
+	return nil
+}
+
 // NestedBlockObject is the underlying object defining the Attributes
 // for a ListNestedBlock, or SetNestedBlock.
 type NestedBlockObject struct {
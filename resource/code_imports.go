@@ -0,0 +1,236 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import "github.com/hashicorp/terraform-plugin-codegen-spec/code"
+
+// CodeImports returns each of the imports within the Schema and the
+// IdentitySchema of the Resource, which may contain duplicates.
+func (r Resource) CodeImports() []code.Import {
+	var imports []code.Import
+
+	if r.Schema != nil {
+		imports = append(imports, r.Schema.CodeImports()...)
+	}
+
+	if r.Identity != nil {
+		imports = append(imports, r.Identity.CodeImports()...)
+	}
+
+	return imports
+}
+
+// CodeImports returns each of the imports within the Schema, including
+// those of the config validators and state upgraders, which may contain
+// duplicates.
+func (s Schema) CodeImports() []code.Import {
+	imports := s.ConfigValidators.CustomValidators().CodeImports()
+
+	imports = append(imports, codeImports(s.Attributes, s.Blocks)...)
+
+	for _, stateUpgrader := range s.StateUpgraders {
+		imports = append(imports, stateUpgrader.Imports...)
+
+		if stateUpgrader.PriorSchema != nil {
+			imports = append(imports, codeImports(stateUpgrader.PriorSchema.Attributes, stateUpgrader.PriorSchema.Blocks)...)
+		}
+	}
+
+	return imports
+}
+
+// CodeImports returns each of the imports of the custom types within the
+// IdentitySchema, which may contain duplicates.
+func (s IdentitySchema) CodeImports() []code.Import {
+	var imports []code.Import
+
+	for _, a := range s.Attributes {
+		switch {
+		case a.Bool != nil:
+			imports = append(imports, a.Bool.CustomType.CodeImports()...)
+		case a.Float64 != nil:
+			imports = append(imports, a.Float64.CustomType.CodeImports()...)
+		case a.Int64 != nil:
+			imports = append(imports, a.Int64.CustomType.CodeImports()...)
+		case a.List != nil:
+			imports = append(imports, a.List.CustomType.CodeImports()...)
+			imports = append(imports, a.List.ElementType.CodeImports()...)
+		case a.Number != nil:
+			imports = append(imports, a.Number.CustomType.CodeImports()...)
+		case a.String != nil:
+			imports = append(imports, a.String.CustomType.CodeImports()...)
+		}
+	}
+
+	return imports
+}
+
+// codeImports returns each of the imports within the given attributes and
+// blocks, and their nested attributes and blocks.
+func codeImports(attributes Attributes, blocks Blocks) []code.Import {
+	var imports []code.Import
+
+	for _, attribute := range attributes {
+		imports = append(imports, attribute.codeImports()...)
+		imports = append(imports, codeImports(attribute.nestedAttributes(), nil)...)
+	}
+
+	for _, block := range blocks {
+		imports = append(imports, block.codeImports()...)
+
+		switch {
+		case block.ListNested != nil:
+			imports = append(imports, codeImports(block.ListNested.NestedObject.Attributes, block.ListNested.NestedObject.Blocks)...)
+		case block.SetNested != nil:
+			imports = append(imports, codeImports(block.SetNested.NestedObject.Attributes, block.SetNested.NestedObject.Blocks)...)
+		case block.SingleNested != nil:
+			imports = append(imports, codeImports(block.SingleNested.Attributes, block.SingleNested.Blocks)...)
+		}
+	}
+
+	return imports
+}
+
+// codeImports returns each of the imports of the attribute type which is
+// set, excluding those of any nested attributes.
+func (a Attribute) codeImports() []code.Import {
+	var imports []code.Import
+
+	switch {
+	case a.Bool != nil:
+		imports = append(imports, a.Bool.AssociatedExternalType.CodeImports()...)
+		imports = append(imports, a.Bool.CustomType.CodeImports()...)
+		imports = append(imports, a.Bool.Default.CustomDefault().CodeImports()...)
+		imports = append(imports, a.Bool.PlanModifiers.CustomPlanModifiers().CodeImports()...)
+		imports = append(imports, a.Bool.Validators.CustomValidators().CodeImports()...)
+	case a.Dynamic != nil:
+		imports = append(imports, a.Dynamic.AssociatedExternalType.CodeImports()...)
+		imports = append(imports, a.Dynamic.CustomType.CodeImports()...)
+		imports = append(imports, a.Dynamic.Default.CustomDefault().CodeImports()...)
+		imports = append(imports, a.Dynamic.PlanModifiers.CustomPlanModifiers().CodeImports()...)
+		imports = append(imports, a.Dynamic.Validators.CustomValidators().CodeImports()...)
+	case a.Float64 != nil:
+		imports = append(imports, a.Float64.AssociatedExternalType.CodeImports()...)
+		imports = append(imports, a.Float64.CustomType.CodeImports()...)
+		imports = append(imports, a.Float64.Default.CustomDefault().CodeImports()...)
+		imports = append(imports, a.Float64.PlanModifiers.CustomPlanModifiers().CodeImports()...)
+		imports = append(imports, a.Float64.Validators.CustomValidators().CodeImports()...)
+	case a.Int64 != nil:
+		imports = append(imports, a.Int64.AssociatedExternalType.CodeImports()...)
+		imports = append(imports, a.Int64.CustomType.CodeImports()...)
+		imports = append(imports, a.Int64.Default.CustomDefault().CodeImports()...)
+		imports = append(imports, a.Int64.PlanModifiers.CustomPlanModifiers().CodeImports()...)
+		imports = append(imports, a.Int64.Validators.CustomValidators().CodeImports()...)
+	case a.List != nil:
+		imports = append(imports, a.List.AssociatedExternalType.CodeImports()...)
+		imports = append(imports, a.List.CustomType.CodeImports()...)
+		imports = append(imports, a.List.Default.CustomDefault().CodeImports()...)
+		imports = append(imports, a.List.PlanModifiers.CustomPlanModifiers().CodeImports()...)
+		imports = append(imports, a.List.Validators.CustomValidators().CodeImports()...)
+		imports = append(imports, a.List.ElementType.CodeImports()...)
+	case a.ListNested != nil:
+		imports = append(imports, a.ListNested.CustomType.CodeImports()...)
+		imports = append(imports, a.ListNested.Default.CustomDefault().CodeImports()...)
+		imports = append(imports, a.ListNested.PlanModifiers.CustomPlanModifiers().CodeImports()...)
+		imports = append(imports, a.ListNested.Validators.CustomValidators().CodeImports()...)
+		imports = append(imports, a.ListNested.NestedObject.AssociatedExternalType.CodeImports()...)
+		imports = append(imports, a.ListNested.NestedObject.CustomType.CodeImports()...)
+		imports = append(imports, a.ListNested.NestedObject.PlanModifiers.CustomPlanModifiers().CodeImports()...)
+		imports = append(imports, a.ListNested.NestedObject.Validators.CustomValidators().CodeImports()...)
+	case a.Map != nil:
+		imports = append(imports, a.Map.AssociatedExternalType.CodeImports()...)
+		imports = append(imports, a.Map.CustomType.CodeImports()...)
+		imports = append(imports, a.Map.Default.CustomDefault().CodeImports()...)
+		imports = append(imports, a.Map.PlanModifiers.CustomPlanModifiers().CodeImports()...)
+		imports = append(imports, a.Map.Validators.AllCustomValidators().CodeImports()...)
+		imports = append(imports, a.Map.ElementType.CodeImports()...)
+	case a.MapNested != nil:
+		imports = append(imports, a.MapNested.CustomType.CodeImports()...)
+		imports = append(imports, a.MapNested.Default.CustomDefault().CodeImports()...)
+		imports = append(imports, a.MapNested.PlanModifiers.CustomPlanModifiers().CodeImports()...)
+		imports = append(imports, a.MapNested.Validators.AllCustomValidators().CodeImports()...)
+		imports = append(imports, a.MapNested.NestedObject.AssociatedExternalType.CodeImports()...)
+		imports = append(imports, a.MapNested.NestedObject.CustomType.CodeImports()...)
+		imports = append(imports, a.MapNested.NestedObject.PlanModifiers.CustomPlanModifiers().CodeImports()...)
+		imports = append(imports, a.MapNested.NestedObject.Validators.CustomValidators().CodeImports()...)
+	case a.Number != nil:
+		imports = append(imports, a.Number.AssociatedExternalType.CodeImports()...)
+		imports = append(imports, a.Number.CustomType.CodeImports()...)
+		imports = append(imports, a.Number.Default.CustomDefault().CodeImports()...)
+		imports = append(imports, a.Number.PlanModifiers.CustomPlanModifiers().CodeImports()...)
+		imports = append(imports, a.Number.Validators.CustomValidators().CodeImports()...)
+	case a.Object != nil:
+		imports = append(imports, a.Object.AssociatedExternalType.CodeImports()...)
+		imports = append(imports, a.Object.CustomType.CodeImports()...)
+		imports = append(imports, a.Object.Default.CustomDefault().CodeImports()...)
+		imports = append(imports, a.Object.PlanModifiers.CustomPlanModifiers().CodeImports()...)
+		imports = append(imports, a.Object.Validators.CustomValidators().CodeImports()...)
+		imports = append(imports, a.Object.AttributeTypes.CodeImports()...)
+	case a.Set != nil:
+		imports = append(imports, a.Set.AssociatedExternalType.CodeImports()...)
+		imports = append(imports, a.Set.CustomType.CodeImports()...)
+		imports = append(imports, a.Set.Default.CustomDefault().CodeImports()...)
+		imports = append(imports, a.Set.PlanModifiers.CustomPlanModifiers().CodeImports()...)
+		imports = append(imports, a.Set.Validators.CustomValidators().CodeImports()...)
+		imports = append(imports, a.Set.ElementType.CodeImports()...)
+	case a.SetNested != nil:
+		imports = append(imports, a.SetNested.CustomType.CodeImports()...)
+		imports = append(imports, a.SetNested.Default.CustomDefault().CodeImports()...)
+		imports = append(imports, a.SetNested.PlanModifiers.CustomPlanModifiers().CodeImports()...)
+		imports = append(imports, a.SetNested.Validators.CustomValidators().CodeImports()...)
+		imports = append(imports, a.SetNested.NestedObject.AssociatedExternalType.CodeImports()...)
+		imports = append(imports, a.SetNested.NestedObject.CustomType.CodeImports()...)
+		imports = append(imports, a.SetNested.NestedObject.PlanModifiers.CustomPlanModifiers().CodeImports()...)
+		imports = append(imports, a.SetNested.NestedObject.Validators.CustomValidators().CodeImports()...)
+	case a.SingleNested != nil:
+		imports = append(imports, a.SingleNested.AssociatedExternalType.CodeImports()...)
+		imports = append(imports, a.SingleNested.CustomType.CodeImports()...)
+		imports = append(imports, a.SingleNested.Default.CustomDefault().CodeImports()...)
+		imports = append(imports, a.SingleNested.PlanModifiers.CustomPlanModifiers().CodeImports()...)
+		imports = append(imports, a.SingleNested.Validators.CustomValidators().CodeImports()...)
+	case a.String != nil:
+		imports = append(imports, a.String.AssociatedExternalType.CodeImports()...)
+		imports = append(imports, a.String.CustomType.CodeImports()...)
+		imports = append(imports, a.String.Default.CustomDefault().CodeImports()...)
+		imports = append(imports, a.String.PlanModifiers.CustomPlanModifiers().CodeImports()...)
+		imports = append(imports, a.String.Validators.CustomValidators().CodeImports()...)
+	}
+
+	return imports
+}
+
+// codeImports returns each of the imports of the block type which is set,
+// excluding those of any nested attributes and blocks.
+func (b Block) codeImports() []code.Import {
+	var imports []code.Import
+
+	switch {
+	case b.ListNested != nil:
+		imports = append(imports, b.ListNested.CustomType.CodeImports()...)
+		imports = append(imports, b.ListNested.Default.CustomDefault().CodeImports()...)
+		imports = append(imports, b.ListNested.PlanModifiers.CustomPlanModifiers().CodeImports()...)
+		imports = append(imports, b.ListNested.Validators.CustomValidators().CodeImports()...)
+		imports = append(imports, b.ListNested.NestedObject.AssociatedExternalType.CodeImports()...)
+		imports = append(imports, b.ListNested.NestedObject.CustomType.CodeImports()...)
+		imports = append(imports, b.ListNested.NestedObject.PlanModifiers.CustomPlanModifiers().CodeImports()...)
+		imports = append(imports, b.ListNested.NestedObject.Validators.CustomValidators().CodeImports()...)
+	case b.SetNested != nil:
+		imports = append(imports, b.SetNested.CustomType.CodeImports()...)
+		imports = append(imports, b.SetNested.Default.CustomDefault().CodeImports()...)
+		imports = append(imports, b.SetNested.PlanModifiers.CustomPlanModifiers().CodeImports()...)
+		imports = append(imports, b.SetNested.Validators.CustomValidators().CodeImports()...)
+		imports = append(imports, b.SetNested.NestedObject.AssociatedExternalType.CodeImports()...)
+		imports = append(imports, b.SetNested.NestedObject.CustomType.CodeImports()...)
+		imports = append(imports, b.SetNested.NestedObject.PlanModifiers.CustomPlanModifiers().CodeImports()...)
+		imports = append(imports, b.SetNested.NestedObject.Validators.CustomValidators().CodeImports()...)
+	case b.SingleNested != nil:
+		imports = append(imports, b.SingleNested.AssociatedExternalType.CodeImports()...)
+		imports = append(imports, b.SingleNested.CustomType.CodeImports()...)
+		imports = append(imports, b.SingleNested.Default.CustomDefault().CodeImports()...)
+		imports = append(imports, b.SingleNested.PlanModifiers.CustomPlanModifiers().CodeImports()...)
+		imports = append(imports, b.SingleNested.Validators.CustomValidators().CodeImports()...)
+	}
+
+	return imports
+}
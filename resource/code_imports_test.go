@@ -0,0 +1,204 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+func TestResource_CodeImports(t *testing.T) {
+	t.Parallel()
+
+	r := resource.Resource{
+		Name: "example",
+		Schema: &resource.Schema{
+			Attributes: resource.Attributes{
+				{
+					Name: "attr_one",
+					String: &resource.StringAttribute{
+						AssociatedExternalType: &schema.AssociatedExternalType{
+							Import: &code.Import{
+								Path: "example.com/apisdk",
+							},
+							Type: "*apisdk.String",
+						},
+						Default: &schema.StringDefault{
+							Custom: &schema.CustomDefault{
+								Imports: []code.Import{
+									{
+										Path: "example.com/stringdefault",
+									},
+								},
+								SchemaDefinition: "stringdefault.Default()",
+							},
+						},
+					},
+				},
+				{
+					Name: "attr_map",
+					Map: &resource.MapAttribute{
+						ElementType: schema.ElementType{
+							String: &schema.StringType{},
+						},
+						Validators: schema.MapValidators{
+							{
+								KeysMatch: &schema.MapKeysMatchValidator{
+									Validators: schema.StringValidators{
+										{
+											Custom: &schema.CustomValidator{
+												Imports: []code.Import{
+													{
+														Path: "example.com/keyvalidator",
+													},
+												},
+												SchemaDefinition: "keyvalidator.Validate()",
+											},
+										},
+									},
+								},
+								ValuesMatch: &schema.MapValuesMatchValidator{
+									String: schema.StringValidators{
+										{
+											Custom: &schema.CustomValidator{
+												Imports: []code.Import{
+													{
+														Path: "example.com/valuevalidator",
+													},
+												},
+												SchemaDefinition: "valuevalidator.Validate()",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Blocks: resource.Blocks{
+				{
+					Name: "block_one",
+					ListNested: &resource.ListNestedBlock{
+						NestedObject: resource.NestedBlockObject{
+							Attributes: resource.Attributes{
+								{
+									Name: "attr_two",
+									List: &resource.ListAttribute{
+										ElementType: schema.ElementType{
+											String: &schema.StringType{
+												CustomType: &schema.CustomType{
+													Import: &code.Import{
+														Alias: pointer("fwtype"),
+														Path:  "github.com/hashicorp/terraform-plugin-framework/types/basetypes",
+													},
+													Type:      "fwtype.StringType",
+													ValueType: "fwtype.StringValue",
+												},
+											},
+										},
+									},
+								},
+							},
+							Validators: schema.ObjectValidators{
+								{
+									Custom: &schema.CustomValidator{
+										Imports: []code.Import{
+											{
+												Path: "example.com/objectvalidator",
+											},
+										},
+										SchemaDefinition: "objectvalidator.Validate()",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			ConfigValidators: schema.ConfigValidators{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "example.com/resourcevalidator",
+							},
+						},
+						SchemaDefinition: "resourcevalidator.Validate()",
+					},
+				},
+			},
+			Version: pointer(int64(1)),
+			StateUpgraders: resource.StateUpgraders{
+				{
+					PriorVersion: 0,
+					Imports: []code.Import{
+						{
+							Path: "example.com/stateupgrader",
+						},
+					},
+					SchemaDefinition: "stateupgrader.Upgrade()",
+				},
+			},
+		},
+		Identity: &resource.IdentitySchema{
+			Attributes: resource.IdentityAttributes{
+				{
+					Name: "id",
+					String: &resource.IdentityStringAttribute{
+						CustomType: &schema.CustomType{
+							Import: &code.Import{
+								Path: "example.com/identitytypes",
+							},
+							Type:      "identitytypes.StringType",
+							ValueType: "identitytypes.StringValue",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expected := []code.Import{
+		{
+			Path: "example.com/resourcevalidator",
+		},
+		{
+			Path: "example.com/apisdk",
+		},
+		{
+			Path: "example.com/stringdefault",
+		},
+		{
+			Path: "example.com/keyvalidator",
+		},
+		{
+			Path: "example.com/valuevalidator",
+		},
+		{
+			Path: "example.com/objectvalidator",
+		},
+		{
+			Alias: pointer("fwtype"),
+			Path:  "github.com/hashicorp/terraform-plugin-framework/types/basetypes",
+		},
+		{
+			Path: "example.com/stateupgrader",
+		},
+		{
+			Path: "example.com/identitytypes",
+		},
+	}
+
+	got := r.CodeImports()
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,311 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+func TestSchema_ValidateGoIdentifiers(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema        resource.Schema
+		expectedError error
+	}{
+		"unique": {
+			schema: resource.Schema{
+				Attributes: resource.Attributes{
+					{
+						Name: "foo_bar",
+						Bool: &resource.BoolAttribute{},
+					},
+				},
+				Blocks: resource.Blocks{
+					{
+						Name:         "foo_baz",
+						SingleNested: &resource.SingleNestedBlock{},
+					},
+				},
+			},
+		},
+		"attribute-attribute-collision": {
+			schema: resource.Schema{
+				Attributes: resource.Attributes{
+					{
+						Name: "foo_bar",
+						Bool: &resource.BoolAttribute{},
+					},
+					{
+						Name: "foobar_",
+						Bool: &resource.BoolAttribute{},
+					},
+					{
+						Name: "foo__bar",
+						Bool: &resource.BoolAttribute{},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "foo_bar" and attribute "foobar_" generate Go identifiers "FooBar" and "Foobar", which differ only in case` + "\n" +
+				`resource "example" attribute "foo_bar" and attribute "foo__bar" both generate Go identifier "FooBar"`),
+		},
+		"attribute-block-collision": {
+			schema: resource.Schema{
+				Attributes: resource.Attributes{
+					{
+						Name: "foo_bar",
+						Bool: &resource.BoolAttribute{},
+					},
+				},
+				Blocks: resource.Blocks{
+					{
+						Name:         "foo_bar_",
+						SingleNested: &resource.SingleNestedBlock{},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "foo_bar" and block "foo_bar_" both generate Go identifier "FooBar"`),
+		},
+		"nested-attribute-collision": {
+			schema: resource.Schema{
+				Attributes: resource.Attributes{
+					{
+						Name: "nested",
+						ListNested: &resource.ListNestedAttribute{
+							NestedObject: resource.NestedAttributeObject{
+								Attributes: resource.Attributes{
+									{
+										Name: "foo_1",
+										Bool: &resource.BoolAttribute{},
+									},
+									{
+										Name: "foo1",
+										Bool: &resource.BoolAttribute{},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "nested" nested object attribute "foo_1" and attribute "foo1" both generate Go identifier "Foo1"`),
+		},
+		"nested-block-collision": {
+			schema: resource.Schema{
+				Blocks: resource.Blocks{
+					{
+						Name: "nested",
+						SingleNested: &resource.SingleNestedBlock{
+							Blocks: resource.Blocks{
+								{
+									Name:         "a_b",
+									SingleNested: &resource.SingleNestedBlock{},
+								},
+								{
+									Name:         "a__b",
+									SingleNested: &resource.SingleNestedBlock{},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" block "nested" nested object block "a_b" and block "a__b" both generate Go identifier "AB"`),
+		},
+		"object-attribute-type-collision": {
+			schema: resource.Schema{
+				Attributes: resource.Attributes{
+					{
+						Name: "obj",
+						Object: &resource.ObjectAttribute{
+							AttributeTypes: schema.ObjectAttributeTypes{
+								{
+									Name:   "foo_bar",
+									String: &schema.StringType{},
+								},
+								{
+									Name:   "foo_bar_",
+									String: &schema.StringType{},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "obj" object attribute type "foo_bar" and object attribute type "foo_bar_" both generate Go identifier "FooBar"`),
+		},
+		"element-type-object-attribute-type-collision": {
+			schema: resource.Schema{
+				Attributes: resource.Attributes{
+					{
+						Name: "list",
+						List: &resource.ListAttribute{
+							ElementType: schema.ElementType{
+								Map: &schema.MapType{
+									ElementType: schema.ElementType{
+										Object: &schema.ObjectType{
+											AttributeTypes: schema.ObjectAttributeTypes{
+												{
+													Name:   "a_b",
+													String: &schema.StringType{},
+												},
+												{
+													Name:   "ab",
+													String: &schema.StringType{},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "list" element type element type object attribute type "a_b" and object attribute type "ab" generate Go identifiers "AB" and "Ab", which differ only in case`),
+		},
+		"state-upgrader-prior-schema-collision": {
+			schema: resource.Schema{
+				Attributes: resource.Attributes{
+					{
+						Name: "foo_bar",
+						Bool: &resource.BoolAttribute{},
+					},
+				},
+				StateUpgraders: resource.StateUpgraders{
+					{
+						PriorVersion: 0,
+						PriorSchema: &resource.PriorSchema{
+							Attributes: resource.Attributes{
+								{
+									Name: "foo_bar",
+									Bool: &resource.BoolAttribute{},
+								},
+							},
+							Blocks: resource.Blocks{
+								{
+									Name:         "foo__bar",
+									SingleNested: &resource.SingleNestedBlock{},
+								},
+							},
+						},
+					},
+				},
+				Version: pointer(int64(1)),
+			},
+			expectedError: fmt.Errorf(`resource "example" state upgrader prior version 0 prior schema attribute "foo_bar" and block "foo__bar" both generate Go identifier "FooBar"`),
+		},
+		"scopes-independent": {
+			schema: resource.Schema{
+				Attributes: resource.Attributes{
+					{
+						Name: "foo_bar",
+						SingleNested: &resource.SingleNestedAttribute{
+							Attributes: resource.Attributes{
+								{
+									Name: "foobar_",
+									Bool: &resource.BoolAttribute{},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.schema.ValidateGoIdentifiers(context.Background(), resource.SchemaValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema(),
+			})
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError.Error() {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestIdentitySchema_ValidateGoIdentifiers(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		identitySchema resource.IdentitySchema
+		expectedError  error
+	}{
+		"unique": {
+			identitySchema: resource.IdentitySchema{
+				Attributes: resource.IdentityAttributes{
+					{
+						Name:   "foo_bar",
+						String: &resource.IdentityStringAttribute{},
+					},
+					{
+						Name:   "foo_baz",
+						String: &resource.IdentityStringAttribute{},
+					},
+				},
+			},
+		},
+		"attribute-collision": {
+			identitySchema: resource.IdentitySchema{
+				Attributes: resource.IdentityAttributes{
+					{
+						Name:   "foo_bar",
+						String: &resource.IdentityStringAttribute{},
+					},
+					{
+						Name:   "foo__bar",
+						String: &resource.IdentityStringAttribute{},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" identity attribute "foo_bar" and attribute "foo__bar" both generate Go identifier "FooBar"`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.identitySchema.ValidateGoIdentifiers(context.Background(), resource.IdentitySchemaValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Identity(),
+			})
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError.Error() {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+func TestSchema_ValidateGoSyntax(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema        resource.Schema
+		expectedError error
+	}{
+		"valid": {
+			schema: resource.Schema{
+				Attributes: resource.Attributes{
+					{
+						Name: "attr_one",
+						String: &resource.StringAttribute{
+							CustomType: &schema.CustomType{
+								Import: &code.Import{
+									Path: "github.com/hashicorp/terraform-plugin-framework/types/basetypes",
+								},
+								Type:      "basetypes.StringType",
+								ValueType: "basetypes.StringValue",
+							},
+							PlanModifiers: schema.StringPlanModifiers{
+								{
+									Custom: &schema.CustomPlanModifier{
+										Imports: []code.Import{
+											{
+												Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier",
+											},
+										},
+										SchemaDefinition: "stringplanmodifier.RequiresReplace()",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"attribute-snippets": {
+			schema: resource.Schema{
+				Attributes: resource.Attributes{
+					{
+						Name: "attr_one",
+						Bool: &resource.BoolAttribute{
+							AssociatedExternalType: &schema.AssociatedExternalType{
+								Type: "*api.Bool",
+							},
+							Default: &schema.BoolDefault{
+								Custom: &schema.CustomDefault{
+									SchemaDefinition: "booldefault.StaticBool(",
+								},
+							},
+							Validators: schema.BoolValidators{
+								{
+									Custom: &schema.CustomValidator{
+										SchemaDefinition: "myValidator()",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr_one" associated external type type "*api.Bool" references package "api" which is not imported` + "\n" +
+				`resource "example" attribute "attr_one" custom default schema definition "booldefault.StaticBool(" is not a valid Go expression: 1:24: expected ')', found 'EOF'`),
+		},
+		"nested-attribute-snippets": {
+			schema: resource.Schema{
+				Blocks: resource.Blocks{
+					{
+						Name: "block_one",
+						ListNested: &resource.ListNestedBlock{
+							NestedObject: resource.NestedBlockObject{
+								Attributes: resource.Attributes{
+									{
+										Name: "attr_one",
+										List: &resource.ListAttribute{
+											ElementType: schema.ElementType{
+												String: &schema.StringType{
+													CustomType: &schema.CustomType{
+														Type:      "myType",
+														ValueType: "myValue{}",
+													},
+												},
+											},
+										},
+									},
+								},
+								CustomType: &schema.CustomType{
+									Type:      "basetypes.ObjectType",
+									ValueType: "basetypes.ObjectValue",
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" block "block_one" nested object custom type type "basetypes.ObjectType" references package "basetypes" which is not imported` + "\n" +
+				`resource "example" block "block_one" nested object custom type value type "basetypes.ObjectValue" references package "basetypes" which is not imported` + "\n" +
+				`resource "example" block "block_one" attribute "attr_one" element type custom type value type "myValue{}" is not a valid Go type expression`),
+		},
+		"config-validator-snippets": {
+			schema: resource.Schema{
+				ConfigValidators: schema.ConfigValidators{
+					{
+						Custom: &schema.CustomValidator{
+							SchemaDefinition: "resourcevalidator.Conflicting()",
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" config validator custom validator schema definition "resourcevalidator.Conflicting()" references package "resourcevalidator" which is not imported`),
+		},
+		"map-validator-nested-snippets": {
+			schema: resource.Schema{
+				Attributes: resource.Attributes{
+					{
+						Name: "attr_map",
+						Map: &resource.MapAttribute{
+							ElementType: schema.ElementType{
+								String: &schema.StringType{},
+							},
+							Validators: schema.MapValidators{
+								{
+									KeysMatch: &schema.MapKeysMatchValidator{
+										Validators: schema.StringValidators{
+											{
+												Custom: &schema.CustomValidator{
+													SchemaDefinition: "keyvalidator.Validate()",
+												},
+											},
+										},
+									},
+									ValuesMatch: &schema.MapValuesMatchValidator{
+										String: schema.StringValidators{
+											{
+												Custom: &schema.CustomValidator{
+													SchemaDefinition: "valuevalidator.Validate()",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr_map" custom validator schema definition "keyvalidator.Validate()" references package "keyvalidator" which is not imported` + "\n" +
+				`resource "example" attribute "attr_map" custom validator schema definition "valuevalidator.Validate()" references package "valuevalidator" which is not imported`),
+		},
+		"state-upgrader-snippets": {
+			schema: resource.Schema{
+				Version: pointer(int64(1)),
+				StateUpgraders: resource.StateUpgraders{
+					{
+						PriorVersion: 0,
+						PriorSchema: &resource.PriorSchema{
+							Attributes: resource.Attributes{
+								{
+									Name: "attr_one",
+									String: &resource.StringAttribute{
+										CustomType: &schema.CustomType{
+											Type:      "basetypes.StringType",
+											ValueType: "*basetypes.StringValue",
+										},
+									},
+								},
+							},
+						},
+						SchemaDefinition: "stateupgrader.Upgrade()",
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" state upgrader prior version 0 schema definition "stateupgrader.Upgrade()" references package "stateupgrader" which is not imported` + "\n" +
+				`resource "example" state upgrader prior version 0 prior schema attribute "attr_one" custom type type "basetypes.StringType" references package "basetypes" which is not imported` + "\n" +
+				`resource "example" state upgrader prior version 0 prior schema attribute "attr_one" custom type value type "*basetypes.StringValue" references package "basetypes" which is not imported`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.schema.ValidateGoSyntax(context.Background(), resource.SchemaValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema(),
+			})
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError.Error() {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+		})
+	}
+}
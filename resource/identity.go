@@ -0,0 +1,253 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/diag"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+// IdentitySchema defines the IdentityAttributes which uniquely identify
+// a remote object managed by a Resource, for example, when importing by
+// identity or returning list results.
+type IdentitySchema struct {
+	// Attributes defines the IdentityAttribute types for the IdentitySchema.
+	Attributes IdentityAttributes `json:"attributes"`
+
+	// Version defines the version of the IdentitySchema, which should be
+	// incremented when the identity data changes in an incompatible way.
+	Version *int64 `json:"version,omitempty"`
+}
+
+// IdentitySchemaValidateRequest specifies the resource identity being validated.
+type IdentitySchemaValidateRequest struct {
+	// Path defines the location of the identity schema, such as the
+	// identity of `resource "example"`.
+	Path schema.Path
+}
+
+// Validate checks that the Version is not negative and delegates to
+// IdentityAttributes.Validate.
+func (s IdentitySchema) Validate(ctx context.Context, req IdentitySchemaValidateRequest) error {
+	var errs []error
+
+	if s.Version != nil && *s.Version < 0 {
+		errs = append(errs, diag.NewErrorDiagnostic(req.Path.String(), diag.Pointer(req.Path.JSONPointer(), "version"), "Invalid version", "version must not be negative"))
+	}
+
+	identityAttributeValidateRequest := IdentityAttributeValidateRequest(req)
+
+	err := s.Attributes.Validate(ctx, identityAttributeValidateRequest)
+
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// IdentityAttributeValidateRequest defines the Path of the identity attribute
+// that is being validated.
+type IdentityAttributeValidateRequest struct {
+	// Path defines the location of the identity schema containing the
+	// identity attributes being validated.
+	Path schema.Path
+}
+
+// IdentityAttributes type defines IdentityAttribute types.
+type IdentityAttributes []IdentityAttribute
+
+// Validate checks for duplicated identity attribute names, that each identity
+// attribute is either required or optional for import, and that list element
+// types do not contain nested types.
+func (a IdentityAttributes) Validate(ctx context.Context, req IdentityAttributeValidateRequest) error {
+	attributeNames := make(map[string]struct{}, len(a))
+
+	var errs []error
+
+	for k, attribute := range a {
+		path := req.Path.Attribute(attribute.Name, k)
+
+		if _, ok := attributeNames[attribute.Name]; ok {
+			errs = append(errs, path.ErrorDiagnostic("Duplicated attribute", "is duplicated"))
+		}
+
+		attributeNames[attribute.Name] = struct{}{}
+
+		optionalForImport, requiredForImport := attribute.importFlags()
+
+		switch {
+		case optionalForImport && requiredForImport:
+			errs = append(errs, path.ErrorDiagnostic("Invalid import flags", "must not set both optional_for_import and required_for_import"))
+		case !optionalForImport && !requiredForImport:
+			errs = append(errs, path.ErrorDiagnostic("Invalid import flags", "must set one of optional_for_import or required_for_import"))
+		}
+
+		if attribute.List == nil {
+			continue
+		}
+
+		elementType := attribute.List.ElementType
+
+		if elementType.List != nil || elementType.Map != nil || elementType.Object != nil || elementType.Set != nil {
+			errs = append(errs, diag.NewErrorDiagnostic(path.String(), path.ElementType("list").JSONPointer(), "Invalid element type", "element type must be bool, float64, int64, number, or string"))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// IdentityAttribute defines a value field inside an IdentitySchema. The
+// identity attribute types (e.g., Bool, Float64) are mutually exclusive,
+// one and only one must be specified.
+type IdentityAttribute struct {
+	// Name defines the identity attribute name.
+	Name string `json:"name"`
+
+	Bool    *IdentityBoolAttribute    `json:"bool,omitempty"`
+	Float64 *IdentityFloat64Attribute `json:"float64,omitempty"`
+	Int64   *IdentityInt64Attribute   `json:"int64,omitempty"`
+	List    *IdentityListAttribute    `json:"list,omitempty"`
+	Number  *IdentityNumberAttribute  `json:"number,omitempty"`
+	String  *IdentityStringAttribute  `json:"string,omitempty"`
+}
+
+// importFlags returns whether the active identity attribute type is optional
+// for import, and whether it is required for import.
+func (a IdentityAttribute) importFlags() (bool, bool) {
+	var optionalForImport, requiredForImport *bool
+
+	switch {
+	case a.Bool != nil:
+		optionalForImport, requiredForImport = a.Bool.OptionalForImport, a.Bool.RequiredForImport
+	case a.Float64 != nil:
+		optionalForImport, requiredForImport = a.Float64.OptionalForImport, a.Float64.RequiredForImport
+	case a.Int64 != nil:
+		optionalForImport, requiredForImport = a.Int64.OptionalForImport, a.Int64.RequiredForImport
+	case a.List != nil:
+		optionalForImport, requiredForImport = a.List.OptionalForImport, a.List.RequiredForImport
+	case a.Number != nil:
+		optionalForImport, requiredForImport = a.Number.OptionalForImport, a.Number.RequiredForImport
+	case a.String != nil:
+		optionalForImport, requiredForImport = a.String.OptionalForImport, a.String.RequiredForImport
+	default:
+		// The absence of a type is reported by the spec JSON schema.
+		return false, true
+	}
+
+	return optionalForImport != nil && *optionalForImport, requiredForImport != nil && *requiredForImport
+}
+
+// IdentityBoolAttribute represents an IdentitySchema attribute that is a boolean.
+type IdentityBoolAttribute struct {
+	// CustomType defines a custom type and value for the identity attribute.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// Description defines the purpose and usage of the identity attribute.
+	Description *string `json:"description,omitempty"`
+
+	// OptionalForImport indicates whether the identity attribute may be
+	// omitted when importing by identity.
+	OptionalForImport *bool `json:"optional_for_import,omitempty"`
+
+	// RequiredForImport indicates whether the identity attribute must be
+	// specified when importing by identity.
+	RequiredForImport *bool `json:"required_for_import,omitempty"`
+}
+
+// IdentityFloat64Attribute represents an IdentitySchema attribute that is a
+// 64-bit floating point number.
+type IdentityFloat64Attribute struct {
+	// CustomType defines a custom type and value for the identity attribute.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// Description defines the purpose and usage of the identity attribute.
+	Description *string `json:"description,omitempty"`
+
+	// OptionalForImport indicates whether the identity attribute may be
+	// omitted when importing by identity.
+	OptionalForImport *bool `json:"optional_for_import,omitempty"`
+
+	// RequiredForImport indicates whether the identity attribute must be
+	// specified when importing by identity.
+	RequiredForImport *bool `json:"required_for_import,omitempty"`
+}
+
+// IdentityInt64Attribute represents an IdentitySchema attribute that is a
+// 64-bit integer.
+type IdentityInt64Attribute struct {
+	// CustomType defines a custom type and value for the identity attribute.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// Description defines the purpose and usage of the identity attribute.
+	Description *string `json:"description,omitempty"`
+
+	// OptionalForImport indicates whether the identity attribute may be
+	// omitted when importing by identity.
+	OptionalForImport *bool `json:"optional_for_import,omitempty"`
+
+	// RequiredForImport indicates whether the identity attribute must be
+	// specified when importing by identity.
+	RequiredForImport *bool `json:"required_for_import,omitempty"`
+}
+
+// IdentityListAttribute represents an IdentitySchema attribute that is a list
+// with a single primitive element type.
+type IdentityListAttribute struct {
+	// CustomType defines a custom type and value for the identity attribute.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// Description defines the purpose and usage of the identity attribute.
+	Description *string `json:"description,omitempty"`
+
+	// ElementType is the type for all elements of the list. Only bool,
+	// float64, int64, number, and string element types are permitted.
+	ElementType schema.ElementType `json:"element_type"`
+
+	// OptionalForImport indicates whether the identity attribute may be
+	// omitted when importing by identity.
+	OptionalForImport *bool `json:"optional_for_import,omitempty"`
+
+	// RequiredForImport indicates whether the identity attribute must be
+	// specified when importing by identity.
+	RequiredForImport *bool `json:"required_for_import,omitempty"`
+}
+
+// IdentityNumberAttribute represents an IdentitySchema attribute that is a
+// generic number with up to 512 bits of floating point or integer precision.
+type IdentityNumberAttribute struct {
+	// CustomType defines a custom type and value for the identity attribute.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// Description defines the purpose and usage of the identity attribute.
+	Description *string `json:"description,omitempty"`
+
+	// OptionalForImport indicates whether the identity attribute may be
+	// omitted when importing by identity.
+	OptionalForImport *bool `json:"optional_for_import,omitempty"`
+
+	// RequiredForImport indicates whether the identity attribute must be
+	// specified when importing by identity.
+	RequiredForImport *bool `json:"required_for_import,omitempty"`
+}
+
+// IdentityStringAttribute represents an IdentitySchema attribute that is a string.
+type IdentityStringAttribute struct {
+	// CustomType defines a custom type and value for the identity attribute.
+	CustomType *schema.CustomType `json:"custom_type,omitempty"`
+
+	// Description defines the purpose and usage of the identity attribute.
+	Description *string `json:"description,omitempty"`
+
+	// OptionalForImport indicates whether the identity attribute may be
+	// omitted when importing by identity.
+	OptionalForImport *bool `json:"optional_for_import,omitempty"`
+
+	// RequiredForImport indicates whether the identity attribute must be
+	// specified when importing by identity.
+	RequiredForImport *bool `json:"required_for_import,omitempty"`
+}
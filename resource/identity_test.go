@@ -0,0 +1,170 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+func TestIdentitySchema_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		identity      resource.IdentitySchema
+		request       resource.IdentitySchemaValidateRequest
+		expectedError error
+	}{
+		"attribute-names-duplicated": {
+			identity: resource.IdentitySchema{
+				Attributes: resource.IdentityAttributes{
+					{
+						Name: "attr_one",
+						String: &resource.IdentityStringAttribute{
+							RequiredForImport: pointer(true),
+						},
+					},
+					{
+						Name: "attr_one",
+						String: &resource.IdentityStringAttribute{
+							RequiredForImport: pointer(true),
+						},
+					},
+				},
+			},
+			request: resource.IdentitySchemaValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Identity(),
+			},
+			expectedError: fmt.Errorf(`resource "example" identity attribute "attr_one" is duplicated`),
+		},
+		"attribute-names-unique": {
+			identity: resource.IdentitySchema{
+				Attributes: resource.IdentityAttributes{
+					{
+						Name: "attr_one",
+						String: &resource.IdentityStringAttribute{
+							RequiredForImport: pointer(true),
+						},
+					},
+					{
+						Name: "attr_two",
+						Int64: &resource.IdentityInt64Attribute{
+							OptionalForImport: pointer(true),
+						},
+					},
+				},
+				Version: pointer(int64(1)),
+			},
+			request: resource.IdentitySchemaValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Identity(),
+			},
+		},
+		"attribute-optional-and-required-for-import": {
+			identity: resource.IdentitySchema{
+				Attributes: resource.IdentityAttributes{
+					{
+						Name: "attr_one",
+						Bool: &resource.IdentityBoolAttribute{
+							OptionalForImport: pointer(true),
+							RequiredForImport: pointer(true),
+						},
+					},
+				},
+			},
+			request: resource.IdentitySchemaValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Identity(),
+			},
+			expectedError: fmt.Errorf(`resource "example" identity attribute "attr_one" must not set both optional_for_import and required_for_import`),
+		},
+		"attribute-neither-optional-nor-required-for-import": {
+			identity: resource.IdentitySchema{
+				Attributes: resource.IdentityAttributes{
+					{
+						Name: "attr_one",
+						Number: &resource.IdentityNumberAttribute{
+							RequiredForImport: pointer(false),
+						},
+					},
+				},
+			},
+			request: resource.IdentitySchemaValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Identity(),
+			},
+			expectedError: fmt.Errorf(`resource "example" identity attribute "attr_one" must set one of optional_for_import or required_for_import`),
+		},
+		"list-attribute-primitive-element-type": {
+			identity: resource.IdentitySchema{
+				Attributes: resource.IdentityAttributes{
+					{
+						Name: "attr_one",
+						List: &resource.IdentityListAttribute{
+							ElementType: schema.ElementType{
+								String: &schema.StringType{},
+							},
+							OptionalForImport: pointer(true),
+						},
+					},
+				},
+			},
+			request: resource.IdentitySchemaValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Identity(),
+			},
+		},
+		"list-attribute-nested-element-type": {
+			identity: resource.IdentitySchema{
+				Attributes: resource.IdentityAttributes{
+					{
+						Name: "attr_one",
+						List: &resource.IdentityListAttribute{
+							ElementType: schema.ElementType{
+								Object: &schema.ObjectType{},
+							},
+							OptionalForImport: pointer(true),
+						},
+					},
+				},
+			},
+			request: resource.IdentitySchemaValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Identity(),
+			},
+			expectedError: fmt.Errorf(`resource "example" identity attribute "attr_one" element type must be bool, float64, int64, number, or string`),
+		},
+		"version-negative": {
+			identity: resource.IdentitySchema{
+				Version: pointer(int64(-1)),
+			},
+			request: resource.IdentitySchemaValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Identity(),
+			},
+			expectedError: fmt.Errorf(`resource "example" identity version must not be negative`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.identity.Validate(context.Background(), testCase.request)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError.Error() {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+		})
+	}
+}
@@ -6,13 +6,25 @@ package resource
 import (
 	"context"
 	"errors"
-	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 )
 
 // ValidateRequest defines the Path of the resource that is
 // being validated.
 type ValidateRequest struct {
-	Path string
+	// Path defines the location of the resource, such as `resource "example"`.
+	Path schema.Path
+
+	// OptionalDefaults defines whether attributes which are optional or
+	// required may have a default, which is permitted by version 0.1 of the
+	// specification.
+	OptionalDefaults bool
+
+	// ReservedNames defines whether attributes and blocks at the root of the
+	// schema may use names which Terraform reserves, which is permitted by
+	// version 0.1 of the specification.
+	ReservedNames bool
 }
 
 // Resource defines an individual resource.
@@ -22,21 +34,57 @@ type Resource struct {
 
 	// Schema defines the Attributes and Blocks for the data source.
 	Schema *Schema `json:"schema,omitempty"`
+
+	// Identity defines the IdentityAttributes and Version of the resource
+	// identity.
+	Identity *IdentitySchema `json:"identity,omitempty"`
 }
 
-// Validate delegates to Schema.Validate.
+// Validate delegates to Schema.Validate and IdentitySchema.Validate.
 func (r Resource) Validate(ctx context.Context, req ValidateRequest) error {
-	if r.Schema == nil {
-		return nil
+	var errs []error
+
+	if r.Schema != nil {
+		schemaValidateRequest := SchemaValidateRequest{
+			Path:             req.Path.Schema(),
+			OptionalDefaults: req.OptionalDefaults,
+			ReservedNames:    req.ReservedNames,
+		}
+
+		err := r.Schema.Validate(ctx, schemaValidateRequest)
+
+		if err != nil {
+			errs = append(errs, err)
+		}
 	}
 
-	schemaValidateRequest := SchemaValidateRequest(req)
+	if r.Identity != nil {
+		identitySchemaValidateRequest := IdentitySchemaValidateRequest{
+			Path: req.Path.Identity(),
+		}
+
+		err := r.Identity.Validate(ctx, identitySchemaValidateRequest)
+
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
 
-	return r.Schema.Validate(ctx, schemaValidateRequest)
+	return errors.Join(errs...)
 }
 
 // ResourcesValidateRequest defines the request sent during validation of Resources.
-type ResourcesValidateRequest struct{}
+type ResourcesValidateRequest struct {
+	// OptionalDefaults defines whether attributes which are optional or
+	// required may have a default, which is permitted by version 0.1 of the
+	// specification.
+	OptionalDefaults bool
+
+	// ReservedNames defines whether attributes and blocks at the root of the
+	// schema may use names which Terraform reserves, which is permitted by
+	// version 0.1 of the specification.
+	ReservedNames bool
+}
 
 // Resources type defines Resource types.
 type Resources []Resource
@@ -48,15 +96,19 @@ func (rs Resources) Validate(ctx context.Context, req ResourcesValidateRequest)
 
 	var errs, nestedErrs []error
 
-	for _, r := range rs {
+	for k, r := range rs {
+		path := schema.Path{}.Resource(r.Name, k)
+
 		if _, ok := resourceNames[r.Name]; ok {
-			errs = append(errs, fmt.Errorf("resource %q is duplicated", r.Name))
+			errs = append(errs, path.ErrorDiagnostic("Duplicated resource", "is duplicated"))
 		}
 
 		resourceNames[r.Name] = struct{}{}
 
 		validateRequest := ValidateRequest{
-			Path: fmt.Sprintf("resource %q", r.Name),
+			Path:             path,
+			OptionalDefaults: req.OptionalDefaults,
+			ReservedNames:    req.ReservedNames,
 		}
 
 		err := r.Validate(ctx, validateRequest)
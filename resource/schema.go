@@ -6,6 +6,10 @@ package resource
 import (
 	"context"
 	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/diag"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 )
 
 // Schema defines the Attributes and Blocks associated with a Resource.
@@ -16,6 +20,10 @@ type Schema struct {
 	// Blocks defines the Block types for the Schema.
 	Blocks Blocks `json:"blocks,omitempty"`
 
+	// ConfigValidators defines validators which apply to the whole of the
+	// Schema, rather than to an individual attribute or block.
+	ConfigValidators schema.ConfigValidators `json:"config_validators,omitempty"`
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this resource is,
 	// what it's for, and how it should be used. It should be written as
@@ -41,18 +49,57 @@ type Schema struct {
 	//    will be removed in the next major version of the provider."
 	//
 	DeprecationMessage *string `json:"deprecation_message,omitempty"`
+
+	// Version defines the version of the Schema, which should be incremented
+	// when the resource state changes in an incompatible way. A nil Version
+	// is equivalent to version 0.
+	Version *int64 `json:"version,omitempty"`
+
+	// StateUpgraders defines the upgrade of resource state from each prior
+	// Version of the Schema to the current Version.
+	StateUpgraders StateUpgraders `json:"state_upgraders,omitempty"`
 }
 
 // SchemaValidateRequest specifies the resource being validated.
 type SchemaValidateRequest struct {
-	Path string
+	// Path defines the location of the schema, such as the schema of
+	// `resource "example"`.
+	Path schema.Path
+
+	// OptionalDefaults defines whether attributes which are optional or
+	// required may have a default, which is permitted by version 0.1 of the
+	// specification.
+	OptionalDefaults bool
+
+	// ReservedNames defines whether attributes and blocks at the root of the
+	// schema may use names which Terraform reserves, which is permitted by
+	// version 0.1 of the specification.
+	ReservedNames bool
 }
 
-// Validate delegates to Attributes.Validate and Blocks.Validate.
+// Validate checks that the Version is not negative, and that the paths of
+// ConfigValidators resolve to an attribute or block, and delegates to
+// Attributes.Validate, Blocks.Validate and StateUpgraders.Validate.
 func (s Schema) Validate(ctx context.Context, req SchemaValidateRequest) error {
 	var errs []error
 
-	attributeValidateRequest := AttributeValidateRequest(req)
+	var version int64
+
+	if s.Version != nil {
+		version = *s.Version
+	}
+
+	if version < 0 {
+		errs = append(errs, diag.NewErrorDiagnostic(req.Path.String(), diag.Pointer(req.Path.JSONPointer(), "version"), "Invalid version", "version must not be negative"))
+	}
+
+	attributeValidateRequest := AttributeValidateRequest{
+		Path:             req.Path,
+		Blocks:           s.Blocks,
+		Root:             true,
+		ReservedNames:    req.ReservedNames,
+		OptionalDefaults: req.OptionalDefaults,
+	}
 
 	err := s.Attributes.Validate(ctx, attributeValidateRequest)
 
@@ -60,7 +107,13 @@ func (s Schema) Validate(ctx context.Context, req SchemaValidateRequest) error {
 		errs = append(errs, err)
 	}
 
-	blockValidateRequest := BlockValidateRequest(req)
+	blockValidateRequest := BlockValidateRequest{
+		Path:             req.Path,
+		Attributes:       s.Attributes,
+		Root:             true,
+		ReservedNames:    req.ReservedNames,
+		OptionalDefaults: req.OptionalDefaults,
+	}
 
 	err = s.Blocks.Validate(ctx, blockValidateRequest)
 
@@ -68,5 +121,24 @@ func (s Schema) Validate(ctx context.Context, req SchemaValidateRequest) error {
 		errs = append(errs, err)
 	}
 
+	for k, validator := range s.ConfigValidators {
+		for _, relationship := range validator.PathRelationships() {
+			if !resolvesPath(s.Attributes, s.Blocks, relationship.Path.Steps()) {
+				errs = append(errs, diag.NewErrorDiagnostic(req.Path.String(), diag.Pointer(req.Path.JSONPointer(), "config_validators", k, relationship.Name), "Unresolved validator path", fmt.Sprintf("%s config validator path %q does not resolve to an attribute or block", relationship.Name, relationship.Path)))
+			}
+		}
+	}
+
+	stateUpgraderValidateRequest := StateUpgraderValidateRequest{
+		Path:    req.Path,
+		Version: version,
+	}
+
+	err = s.StateUpgraders.Validate(ctx, stateUpgraderValidateRequest)
+
+	if err != nil {
+		errs = append(errs, err)
+	}
+
 	return errors.Join(errs...)
 }
@@ -0,0 +1,162 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/diag"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+// StateUpgraderValidateRequest defines the Path of the resource schema, and the
+// current schema Version, for the state upgraders being validated.
+type StateUpgraderValidateRequest struct {
+	// Path defines the location of the resource schema, such as the schema
+	// of `resource "example"`.
+	Path schema.Path
+
+	Version int64
+}
+
+// StateUpgraders type defines StateUpgrader types.
+type StateUpgraders []StateUpgrader
+
+// Validate checks that the prior versions of the state upgraders are unique,
+// less than the current schema version, and contiguous up to the current schema
+// version. Validate delegates to PriorSchema.Validate for each state upgrader
+// which defines a prior schema.
+func (s StateUpgraders) Validate(ctx context.Context, req StateUpgraderValidateRequest) error {
+	priorVersions := make(map[int64]struct{}, len(s))
+
+	var errs, nestedErrs []error
+
+	for k, stateUpgrader := range s {
+		path := req.Path.StateUpgrader(stateUpgrader.PriorVersion, k)
+
+		if _, ok := priorVersions[stateUpgrader.PriorVersion]; ok {
+			errs = append(errs, path.ErrorDiagnostic("Duplicated state upgrader", "is duplicated"))
+		}
+
+		priorVersions[stateUpgrader.PriorVersion] = struct{}{}
+
+		switch {
+		case stateUpgrader.PriorVersion < 0:
+			errs = append(errs, diag.NewErrorDiagnostic(path.String(), diag.Pointer(path.JSONPointer(), "prior_version"), "Invalid prior version", "must not be negative"))
+		case stateUpgrader.PriorVersion >= req.Version:
+			errs = append(errs, diag.NewErrorDiagnostic(path.String(), diag.Pointer(path.JSONPointer(), "prior_version"), "Invalid prior version", fmt.Sprintf("must be less than schema version %d", req.Version)))
+		}
+
+		if stateUpgrader.PriorSchema == nil {
+			continue
+		}
+
+		priorSchemaValidateRequest := PriorSchemaValidateRequest{
+			Path: path.PriorSchema(),
+		}
+
+		err := stateUpgrader.PriorSchema.Validate(ctx, priorSchemaValidateRequest)
+
+		if err != nil {
+			nestedErrs = append(nestedErrs, err)
+		}
+	}
+
+	if len(priorVersions) > 0 {
+		versions := make([]int64, 0, len(priorVersions))
+
+		for version := range priorVersions {
+			versions = append(versions, version)
+		}
+
+		sort.Slice(versions, func(i, j int) bool {
+			return versions[i] < versions[j]
+		})
+
+		for version := max(versions[0], 0); version < req.Version; version++ {
+			if _, ok := priorVersions[version]; !ok {
+				errs = append(errs, diag.NewErrorDiagnostic(fmt.Sprintf("%s state upgrader prior version %d", req.Path, version), diag.Pointer(req.Path.JSONPointer(), "state_upgraders"), "Missing state upgrader", "is missing"))
+			}
+		}
+	}
+
+	e := append(errs, nestedErrs...)
+
+	return errors.Join(e...)
+}
+
+// StateUpgrader defines the upgrade of resource state from a prior schema
+// version to the current schema version.
+type StateUpgrader struct {
+	// PriorVersion defines the schema version of the resource state which
+	// is upgraded.
+	PriorVersion int64 `json:"prior_version"`
+
+	// PriorSchema defines the Attributes and Blocks of the resource schema
+	// at the PriorVersion, which are used to decode the prior resource state.
+	PriorSchema *PriorSchema `json:"prior_schema,omitempty"`
+
+	// Imports defines paths, and optional aliases for imported code.
+	Imports []code.Import `json:"imports,omitempty"`
+
+	// SchemaDefinition defines the state upgrader for use in the schema.
+	SchemaDefinition string `json:"schema_definition"`
+}
+
+// HasImport returns true if the StateUpgrader has defined imports.
+func (s StateUpgrader) HasImport() bool {
+	return len(s.Imports) > 0
+}
+
+// PriorSchema defines the Attributes and Blocks of a Resource schema at a
+// prior version.
+type PriorSchema struct {
+	// Attributes defines the Attribute types for the PriorSchema.
+	Attributes Attributes `json:"attributes,omitempty"`
+
+	// Blocks defines the Block types for the PriorSchema.
+	Blocks Blocks `json:"blocks,omitempty"`
+}
+
+// PriorSchemaValidateRequest specifies the prior schema being validated.
+type PriorSchemaValidateRequest struct {
+	// Path defines the location of the prior schema, such as the prior
+	// schema of `resource "example" state upgrader prior version 0`.
+	Path schema.Path
+}
+
+// Validate delegates to Attributes.Validate and Blocks.Validate.
+func (s PriorSchema) Validate(ctx context.Context, req PriorSchemaValidateRequest) error {
+	var errs []error
+
+	attributeValidateRequest := AttributeValidateRequest{
+		Path:   req.Path,
+		Blocks: s.Blocks,
+		Root:   true,
+	}
+
+	err := s.Attributes.Validate(ctx, attributeValidateRequest)
+
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	blockValidateRequest := BlockValidateRequest{
+		Path:       req.Path,
+		Attributes: s.Attributes,
+		Root:       true,
+	}
+
+	err = s.Blocks.Validate(ctx, blockValidateRequest)
+
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+func TestStateUpgraders_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		stateUpgraders resource.StateUpgraders
+		request        resource.StateUpgraderValidateRequest
+		expectedError  error
+	}{
+		"none": {
+			request: resource.StateUpgraderValidateRequest{
+				Path:    schema.Path{}.Resource("example", 0).Schema(),
+				Version: 2,
+			},
+		},
+		"contiguous": {
+			stateUpgraders: resource.StateUpgraders{
+				{
+					PriorVersion: 1,
+				},
+				{
+					PriorVersion: 0,
+				},
+			},
+			request: resource.StateUpgraderValidateRequest{
+				Path:    schema.Path{}.Resource("example", 0).Schema(),
+				Version: 2,
+			},
+		},
+		"contiguous-from-non-zero": {
+			stateUpgraders: resource.StateUpgraders{
+				{
+					PriorVersion: 2,
+				},
+				{
+					PriorVersion: 3,
+				},
+			},
+			request: resource.StateUpgraderValidateRequest{
+				Path:    schema.Path{}.Resource("example", 0).Schema(),
+				Version: 4,
+			},
+		},
+		"prior-version-duplicated": {
+			stateUpgraders: resource.StateUpgraders{
+				{
+					PriorVersion: 0,
+				},
+				{
+					PriorVersion: 0,
+				},
+			},
+			request: resource.StateUpgraderValidateRequest{
+				Path:    schema.Path{}.Resource("example", 0).Schema(),
+				Version: 1,
+			},
+			expectedError: fmt.Errorf(`resource "example" state upgrader prior version 0 is duplicated`),
+		},
+		"prior-version-not-less-than-version": {
+			stateUpgraders: resource.StateUpgraders{
+				{
+					PriorVersion: 0,
+				},
+				{
+					PriorVersion: 1,
+				},
+			},
+			request: resource.StateUpgraderValidateRequest{
+				Path:    schema.Path{}.Resource("example", 0).Schema(),
+				Version: 1,
+			},
+			expectedError: fmt.Errorf(`resource "example" state upgrader prior version 1 must be less than schema version 1`),
+		},
+		"prior-version-negative": {
+			stateUpgraders: resource.StateUpgraders{
+				{
+					PriorVersion: -1,
+				},
+			},
+			request: resource.StateUpgraderValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema(),
+			},
+			expectedError: fmt.Errorf(`resource "example" state upgrader prior version -1 must not be negative`),
+		},
+		"prior-version-missing": {
+			stateUpgraders: resource.StateUpgraders{
+				{
+					PriorVersion: 0,
+				},
+				{
+					PriorVersion: 2,
+				},
+			},
+			request: resource.StateUpgraderValidateRequest{
+				Path:    schema.Path{}.Resource("example", 0).Schema(),
+				Version: 4,
+			},
+			expectedError: fmt.Errorf(`resource "example" state upgrader prior version 1 is missing` + "\n" +
+				`resource "example" state upgrader prior version 3 is missing`),
+		},
+		"prior-schema-attribute-names-duplicated": {
+			stateUpgraders: resource.StateUpgraders{
+				{
+					PriorVersion: 0,
+					PriorSchema: &resource.PriorSchema{
+						Attributes: resource.Attributes{
+							{
+								Name: "attr_one",
+							},
+							{
+								Name: "attr_one",
+							},
+						},
+					},
+				},
+			},
+			request: resource.StateUpgraderValidateRequest{
+				Path:    schema.Path{}.Resource("example", 0).Schema(),
+				Version: 1,
+			},
+			expectedError: fmt.Errorf(`resource "example" state upgrader prior version 0 prior schema attribute "attr_one" is duplicated`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.stateUpgraders.Validate(context.Background(), testCase.request)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError.Error() {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+		})
+	}
+}
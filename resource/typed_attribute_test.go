@@ -0,0 +1,181 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+func TestAttribute_TypedAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute resource.Attribute
+		expected  schema.TypedAttribute
+	}{
+		"none": {
+			attribute: resource.Attribute{
+				Name: "example",
+			},
+		},
+		"bool": {
+			attribute: resource.Attribute{
+				Name: "example",
+				Bool: &resource.BoolAttribute{},
+			},
+			expected: &resource.BoolAttribute{},
+		},
+		"list-nested": {
+			attribute: resource.Attribute{
+				Name: "example",
+				ListNested: &resource.ListNestedAttribute{
+					ComputedOptionalRequired: schema.Optional,
+				},
+			},
+			expected: &resource.ListNestedAttribute{
+				ComputedOptionalRequired: schema.Optional,
+			},
+		},
+		"string": {
+			attribute: resource.Attribute{
+				Name: "example",
+				String: &resource.StringAttribute{
+					ComputedOptionalRequired: schema.Required,
+				},
+			},
+			expected: &resource.StringAttribute{
+				ComputedOptionalRequired: schema.Required,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var namedAttribute schema.NamedAttribute = testCase.attribute
+
+			if got := namedAttribute.GetName(); got != "example" {
+				t.Errorf("expected name %q, got %q", "example", got)
+			}
+
+			got := namedAttribute.TypedAttribute()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringAttribute_TypedAttribute(t *testing.T) {
+	t.Parallel()
+
+	custom := &schema.CustomValidator{
+		SchemaDefinition: "my_validator.Validate()",
+	}
+
+	customPlanModifier := &schema.CustomPlanModifier{
+		SchemaDefinition: "my_plan_modifier.Modify()",
+	}
+
+	alsoRequires := &schema.PathRelationshipValidator{
+		Paths: schema.PathExpressions{"other"},
+	}
+
+	var got schema.TypedAttribute = resource.StringAttribute{
+		AssociatedExternalType: &schema.AssociatedExternalType{
+			Type: "*api.Example",
+		},
+		ComputedOptionalRequired: schema.ComputedOptional,
+		CustomType: &schema.CustomType{
+			Type: "my_type",
+		},
+		DeprecationMessage: pointer("deprecated"),
+		Description:        pointer("description"),
+		PlanModifiers: schema.StringPlanModifiers{
+			{
+				Custom: customPlanModifier,
+			},
+		},
+		Sensitive: pointer(true),
+		Validators: schema.StringValidators{
+			{
+				Custom: custom,
+			},
+			{
+				AlsoRequires: alsoRequires,
+			},
+		},
+	}
+
+	if got.AttributeKind() != schema.AttributeKindString {
+		t.Errorf("expected kind %q, got %q", schema.AttributeKindString, got.AttributeKind())
+	}
+
+	if diff := cmp.Diff(got.GetAssociatedExternalType(), &schema.AssociatedExternalType{Type: "*api.Example"}); diff != "" {
+		t.Errorf("unexpected associated external type difference: %s", diff)
+	}
+
+	if got.GetComputedOptionalRequired() != schema.ComputedOptional {
+		t.Errorf("expected %q, got %q", schema.ComputedOptional, got.GetComputedOptionalRequired())
+	}
+
+	if diff := cmp.Diff(got.GetCustomType(), &schema.CustomType{Type: "my_type"}); diff != "" {
+		t.Errorf("unexpected custom type difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(got.GetDeprecationMessage(), pointer("deprecated")); diff != "" {
+		t.Errorf("unexpected deprecation message difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(got.GetDescription(), pointer("description")); diff != "" {
+		t.Errorf("unexpected description difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(got.GetSensitive(), pointer(true)); diff != "" {
+		t.Errorf("unexpected sensitive difference: %s", diff)
+	}
+
+	var expectedValidators schema.Validators = schema.StringValidators{
+		{
+			Custom: custom,
+		},
+		{
+			AlsoRequires: alsoRequires,
+		},
+	}
+
+	if diff := cmp.Diff(got.GetValidators(), expectedValidators); diff != "" {
+		t.Errorf("unexpected validators difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(got.GetValidators().CustomValidators(), schema.CustomValidators{custom}); diff != "" {
+		t.Errorf("unexpected custom validators difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(got.GetValidators().PathRelationships(), schema.PathRelationships{{Name: "also_requires", Path: "other"}}); diff != "" {
+		t.Errorf("unexpected path relationships difference: %s", diff)
+	}
+
+	var expectedPlanModifiers schema.PlanModifiers = schema.StringPlanModifiers{
+		{
+			Custom: customPlanModifier,
+		},
+	}
+
+	if diff := cmp.Diff(got.GetPlanModifiers(), expectedPlanModifiers); diff != "" {
+		t.Errorf("unexpected plan modifiers difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(got.GetPlanModifiers().CustomPlanModifiers(), schema.CustomPlanModifiers{customPlanModifier}); diff != "" {
+		t.Errorf("unexpected custom plan modifiers difference: %s", diff)
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+func TestSchema_Walk(t *testing.T) {
+	t.Parallel()
+
+	input := resource.Schema{
+		Attributes: resource.Attributes{
+			{
+				Name: "list",
+				List: &resource.ListAttribute{
+					ElementType: schema.ElementType{
+						Object: &schema.ObjectType{
+							AttributeTypes: schema.ObjectAttributeTypes{
+								{
+									Name:   "str",
+									String: &schema.StringType{},
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				Name: "list_nested",
+				ListNested: &resource.ListNestedAttribute{
+					NestedObject: resource.NestedAttributeObject{
+						Attributes: resource.Attributes{
+							{
+								Name: "bool",
+								Bool: &resource.BoolAttribute{},
+							},
+						},
+					},
+				},
+			},
+		},
+		Blocks: resource.Blocks{
+			{
+				Name: "single_nested",
+				SingleNested: &resource.SingleNestedBlock{
+					Blocks: resource.Blocks{
+						{
+							Name: "set_nested",
+							SetNested: &resource.SetNestedBlock{
+								NestedObject: resource.NestedBlockObject{
+									Attributes: resource.Attributes{
+										{
+											Name:  "int64",
+											Int64: &resource.Int64Attribute{},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		enter         func(node schema.WalkNode) error
+		expected      []string
+		expectedError error
+	}{
+		"all": {
+			expected: []string{
+				`enter attribute /attributes/0 attribute "list"`,
+				`enter element type /attributes/0/list/element_type attribute "list" element type`,
+				`enter object attribute type /attributes/0/list/element_type/object/attribute_types/0 attribute "list" element type object attribute type "str"`,
+				`leave object attribute type /attributes/0/list/element_type/object/attribute_types/0 attribute "list" element type object attribute type "str"`,
+				`leave element type /attributes/0/list/element_type attribute "list" element type`,
+				`leave attribute /attributes/0 attribute "list"`,
+				`enter attribute /attributes/1 attribute "list_nested"`,
+				`enter nested object /attributes/1/list_nested/nested_object attribute "list_nested" nested object`,
+				`enter attribute /attributes/1/list_nested/nested_object/attributes/0 attribute "list_nested" attribute "bool"`,
+				`leave attribute /attributes/1/list_nested/nested_object/attributes/0 attribute "list_nested" attribute "bool"`,
+				`leave nested object /attributes/1/list_nested/nested_object attribute "list_nested" nested object`,
+				`leave attribute /attributes/1 attribute "list_nested"`,
+				`enter block /blocks/0 block "single_nested"`,
+				`enter nested object /blocks/0/single_nested block "single_nested" nested object`,
+				`enter block /blocks/0/single_nested/blocks/0 block "single_nested" block "set_nested"`,
+				`enter nested object /blocks/0/single_nested/blocks/0/set_nested/nested_object block "single_nested" block "set_nested" nested object`,
+				`enter attribute /blocks/0/single_nested/blocks/0/set_nested/nested_object/attributes/0 block "single_nested" block "set_nested" attribute "int64"`,
+				`leave attribute /blocks/0/single_nested/blocks/0/set_nested/nested_object/attributes/0 block "single_nested" block "set_nested" attribute "int64"`,
+				`leave nested object /blocks/0/single_nested/blocks/0/set_nested/nested_object block "single_nested" block "set_nested" nested object`,
+				`leave block /blocks/0/single_nested/blocks/0 block "single_nested" block "set_nested"`,
+				`leave nested object /blocks/0/single_nested block "single_nested" nested object`,
+				`leave block /blocks/0 block "single_nested"`,
+			},
+		},
+		"skip-children": {
+			enter: func(node schema.WalkNode) error {
+				if node.Kind == schema.WalkNodeKindNestedObject || node.Kind == schema.WalkNodeKindElementType {
+					return schema.SkipChildren
+				}
+
+				return nil
+			},
+			expected: []string{
+				`enter attribute /attributes/0 attribute "list"`,
+				`enter element type /attributes/0/list/element_type attribute "list" element type`,
+				`leave element type /attributes/0/list/element_type attribute "list" element type`,
+				`leave attribute /attributes/0 attribute "list"`,
+				`enter attribute /attributes/1 attribute "list_nested"`,
+				`enter nested object /attributes/1/list_nested/nested_object attribute "list_nested" nested object`,
+				`leave nested object /attributes/1/list_nested/nested_object attribute "list_nested" nested object`,
+				`leave attribute /attributes/1 attribute "list_nested"`,
+				`enter block /blocks/0 block "single_nested"`,
+				`enter nested object /blocks/0/single_nested block "single_nested" nested object`,
+				`leave nested object /blocks/0/single_nested block "single_nested" nested object`,
+				`leave block /blocks/0 block "single_nested"`,
+			},
+		},
+		"error": {
+			enter: func(node schema.WalkNode) error {
+				if node.Name == "bool" {
+					return fmt.Errorf("%s is not supported", node.Path)
+				}
+
+				return nil
+			},
+			expected: []string{
+				`enter attribute /attributes/0 attribute "list"`,
+				`enter element type /attributes/0/list/element_type attribute "list" element type`,
+				`enter object attribute type /attributes/0/list/element_type/object/attribute_types/0 attribute "list" element type object attribute type "str"`,
+				`leave object attribute type /attributes/0/list/element_type/object/attribute_types/0 attribute "list" element type object attribute type "str"`,
+				`leave element type /attributes/0/list/element_type attribute "list" element type`,
+				`leave attribute /attributes/0 attribute "list"`,
+				`enter attribute /attributes/1 attribute "list_nested"`,
+				`enter nested object /attributes/1/list_nested/nested_object attribute "list_nested" nested object`,
+				`enter attribute /attributes/1/list_nested/nested_object/attributes/0 attribute "list_nested" attribute "bool"`,
+			},
+			expectedError: errors.New(`attribute "list_nested" attribute "bool" is not supported`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+
+			visitor := schema.VisitorFuncs{
+				EnterFunc: func(node schema.WalkNode) error {
+					got = append(got, fmt.Sprintf("enter %s %s %s", node.Kind, node.Path.JSONPointer(), node.Path))
+
+					if testCase.enter == nil {
+						return nil
+					}
+
+					return testCase.enter(node)
+				},
+				LeaveFunc: func(node schema.WalkNode) error {
+					got = append(got, fmt.Sprintf("leave %s %s %s", node.Kind, node.Path.JSONPointer(), node.Path))
+
+					return nil
+				},
+			}
+
+			var walker schema.Walker = input
+
+			err := walker.Walk(visitor)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError.Error() {
+					t.Errorf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
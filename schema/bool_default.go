@@ -12,6 +12,15 @@ type BoolDefault struct {
 	Static *bool `json:"static,omitempty"`
 }
 
+// CustomDefault returns *CustomDefault.
+func (d *BoolDefault) CustomDefault() *CustomDefault {
+	if d == nil {
+		return nil
+	}
+
+	return d.Custom
+}
+
 // Equal returns true if all fields of the given BoolDefault are equal.
 func (d *BoolDefault) Equal(other *BoolDefault) bool {
 	if d == nil && other == nil {
@@ -121,6 +121,38 @@ func TestBoolPlanModifiers_Equal(t *testing.T) {
 			},
 			expected: true,
 		},
+		"plan_modifiers_built_in_different_order": {
+			planModifiers: schema.BoolPlanModifiers{
+				{
+					RequiresReplace: &schema.RequiresReplacePlanModifier{},
+				},
+				{
+					UseStateForUnknown: &schema.UseStateForUnknownPlanModifier{},
+				},
+			},
+			other: schema.BoolPlanModifiers{
+				{
+					UseStateForUnknown: &schema.UseStateForUnknownPlanModifier{},
+				},
+				{
+					RequiresReplace: &schema.RequiresReplacePlanModifier{},
+				},
+			},
+			expected: true,
+		},
+		"plan_modifiers_built_in_different": {
+			planModifiers: schema.BoolPlanModifiers{
+				{
+					RequiresReplace: &schema.RequiresReplacePlanModifier{},
+				},
+			},
+			other: schema.BoolPlanModifiers{
+				{
+					RequiresReplaceIfConfigured: &schema.RequiresReplaceIfConfiguredPlanModifier{},
+				},
+			},
+			expected: false,
+		},
 	}
 
 	for name, testCase := range testCases {
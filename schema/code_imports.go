@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import "github.com/hashicorp/terraform-plugin-codegen-spec/code"
+
+// CodeImports returns the Import, if the CustomType has a non-empty import
+// path.
+func (c *CustomType) CodeImports() []code.Import {
+	if c == nil || !c.HasImport() {
+		return nil
+	}
+
+	return []code.Import{*c.Import}
+}
+
+// CodeImports returns the Import, if the AssociatedExternalType has a
+// non-empty import path.
+func (a *AssociatedExternalType) CodeImports() []code.Import {
+	if a == nil || !a.HasImport() {
+		return nil
+	}
+
+	return []code.Import{*a.Import}
+}
+
+// CodeImports returns the Imports of the CustomDefault.
+func (c *CustomDefault) CodeImports() []code.Import {
+	if c == nil {
+		return nil
+	}
+
+	return c.Imports
+}
+
+// CodeImports returns the Imports of each CustomPlanModifier.
+func (c CustomPlanModifiers) CodeImports() []code.Import {
+	var imports []code.Import
+
+	for _, customPlanModifier := range c {
+		imports = append(imports, customPlanModifier.CodeImports()...)
+	}
+
+	return imports
+}
+
+// CodeImports returns the Imports of the CustomPlanModifier.
+func (c *CustomPlanModifier) CodeImports() []code.Import {
+	if c == nil {
+		return nil
+	}
+
+	return c.Imports
+}
+
+// CodeImports returns the Imports of each CustomValidator.
+func (c CustomValidators) CodeImports() []code.Import {
+	var imports []code.Import
+
+	for _, customValidator := range c {
+		imports = append(imports, customValidator.CodeImports()...)
+	}
+
+	return imports
+}
+
+// CodeImports returns the Imports of the CustomValidator.
+func (c *CustomValidator) CodeImports() []code.Import {
+	if c == nil {
+		return nil
+	}
+
+	return c.Imports
+}
+
+// CodeImports returns the imports of the custom type of the type which is
+// set, and of any nested element or object attribute types.
+func (e ElementType) CodeImports() []code.Import {
+	switch {
+	case e.Bool != nil:
+		return e.Bool.CustomType.CodeImports()
+	case e.Float64 != nil:
+		return e.Float64.CustomType.CodeImports()
+	case e.Int64 != nil:
+		return e.Int64.CustomType.CodeImports()
+	case e.List != nil:
+		return append(e.List.CustomType.CodeImports(), e.List.ElementType.CodeImports()...)
+	case e.Map != nil:
+		return append(e.Map.CustomType.CodeImports(), e.Map.ElementType.CodeImports()...)
+	case e.Number != nil:
+		return e.Number.CustomType.CodeImports()
+	case e.Object != nil:
+		return append(e.Object.CustomType.CodeImports(), e.Object.AttributeTypes.CodeImports()...)
+	case e.Set != nil:
+		return append(e.Set.CustomType.CodeImports(), e.Set.ElementType.CodeImports()...)
+	case e.String != nil:
+		return e.String.CustomType.CodeImports()
+	}
+
+	return nil
+}
+
+// CodeImports returns the imports of the custom type of the type which is
+// set on each ObjectAttributeType, and of any nested element or object
+// attribute types.
+func (o ObjectAttributeTypes) CodeImports() []code.Import {
+	var imports []code.Import
+
+	for _, attributeType := range o {
+		switch {
+		case attributeType.Bool != nil:
+			imports = append(imports, attributeType.Bool.CustomType.CodeImports()...)
+		case attributeType.Dynamic != nil:
+			imports = append(imports, attributeType.Dynamic.CustomType.CodeImports()...)
+		case attributeType.Float64 != nil:
+			imports = append(imports, attributeType.Float64.CustomType.CodeImports()...)
+		case attributeType.Int64 != nil:
+			imports = append(imports, attributeType.Int64.CustomType.CodeImports()...)
+		case attributeType.List != nil:
+			imports = append(imports, attributeType.List.CustomType.CodeImports()...)
+			imports = append(imports, attributeType.List.ElementType.CodeImports()...)
+		case attributeType.Map != nil:
+			imports = append(imports, attributeType.Map.CustomType.CodeImports()...)
+			imports = append(imports, attributeType.Map.ElementType.CodeImports()...)
+		case attributeType.Number != nil:
+			imports = append(imports, attributeType.Number.CustomType.CodeImports()...)
+		case attributeType.Object != nil:
+			imports = append(imports, attributeType.Object.CustomType.CodeImports()...)
+			imports = append(imports, attributeType.Object.AttributeTypes.CodeImports()...)
+		case attributeType.Set != nil:
+			imports = append(imports, attributeType.Set.CustomType.CodeImports()...)
+			imports = append(imports, attributeType.Set.ElementType.CodeImports()...)
+		case attributeType.String != nil:
+			imports = append(imports, attributeType.String.CustomType.CodeImports()...)
+		}
+	}
+
+	return imports
+}
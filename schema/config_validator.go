@@ -0,0 +1,145 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+// ConfigValidators type defines ConfigValidator types
+type ConfigValidators []ConfigValidator
+
+// CustomValidators returns CustomValidator for each ConfigValidator.
+func (v ConfigValidators) CustomValidators() CustomValidators {
+	var customValidators CustomValidators
+
+	for _, validator := range v {
+		customValidator := validator.Custom
+
+		if customValidator == nil {
+			continue
+		}
+
+		customValidators = append(customValidators, customValidator)
+	}
+
+	return customValidators
+}
+
+// PathRelationships returns a PathRelationship for each of the paths of the
+// Conflicting, ExactlyOneOf and RequiredTogether validators of each
+// ConfigValidator.
+func (v ConfigValidators) PathRelationships() PathRelationships {
+	var relationships PathRelationships
+
+	for _, validator := range v {
+		relationships = append(relationships, validator.PathRelationships()...)
+	}
+
+	return relationships
+}
+
+// Equal returns true if the given ConfigValidators is the same
+// length, and each of the ConfigValidator entries is equal.
+func (v ConfigValidators) Equal(other ConfigValidators) bool {
+	if v == nil && other == nil {
+		return true
+	}
+
+	if v == nil || other == nil {
+		return false
+	}
+
+	if len(v) != len(other) {
+		return false
+	}
+
+	// Validators are unordered, so each validator is matched against
+	// any equal, and not already matched, validator in other.
+	matched := make([]bool, len(other))
+
+	for _, validator := range v {
+		found := false
+
+		for k, otherValidator := range other {
+			if matched[k] || !validator.Equal(otherValidator) {
+				continue
+			}
+
+			matched[k] = true
+			found = true
+
+			break
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ConfigValidator type defines type and function that provides validation
+// functionality across the whole of a schema. Paths are relative to the
+// root of the schema.
+type ConfigValidator struct {
+	// Conflicting validates that at most one of the attributes or blocks at
+	// each of the Paths is configured.
+	Conflicting *PathRelationshipValidator `json:"conflicting,omitempty"`
+
+	// Custom defines a schema definition, and optional imports.
+	Custom *CustomValidator `json:"custom,omitempty"`
+
+	// ExactlyOneOf validates that exactly one of the attributes or blocks at
+	// each of the Paths is configured.
+	ExactlyOneOf *PathRelationshipValidator `json:"exactly_one_of,omitempty"`
+
+	// RequiredTogether validates that either all, or none, of the attributes
+	// or blocks at each of the Paths are configured.
+	RequiredTogether *PathRelationshipValidator `json:"required_together,omitempty"`
+}
+
+// PathRelationships returns a PathRelationship for each of the paths of the
+// Conflicting, ExactlyOneOf and RequiredTogether validators.
+func (v ConfigValidator) PathRelationships() PathRelationships {
+	var relationships PathRelationships
+
+	validators := []struct {
+		name      string
+		validator *PathRelationshipValidator
+	}{
+		{"conflicting", v.Conflicting},
+		{"exactly_one_of", v.ExactlyOneOf},
+		{"required_together", v.RequiredTogether},
+	}
+
+	for _, pathValidator := range validators {
+		if pathValidator.validator == nil {
+			continue
+		}
+
+		for _, path := range pathValidator.validator.Paths {
+			relationships = append(relationships, PathRelationship{
+				Name: pathValidator.name,
+				Path: path,
+			})
+		}
+	}
+
+	return relationships
+}
+
+// Equal returns true if the fields of the given ConfigValidator equal.
+func (v ConfigValidator) Equal(other ConfigValidator) bool {
+	if !v.Conflicting.Equal(other.Conflicting) {
+		return false
+	}
+
+	if !v.Custom.Equal(other.Custom) {
+		return false
+	}
+
+	if !v.ExactlyOneOf.Equal(other.ExactlyOneOf) {
+		return false
+	}
+
+	return v.RequiredTogether.Equal(other.RequiredTogether)
+}
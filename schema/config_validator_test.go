@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+func TestConfigValidators_Equal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validators schema.ConfigValidators
+		other      schema.ConfigValidators
+		expected   bool
+	}{
+		"validators_both_nil": {
+			expected: true,
+		},
+		"validators_nil_other_not_nil": {
+			other: schema.ConfigValidators{
+				schema.ConfigValidator{},
+			},
+			expected: false,
+		},
+		"validators_different_order": {
+			validators: schema.ConfigValidators{
+				{
+					Conflicting: &schema.PathRelationshipValidator{
+						Paths: schema.PathExpressions{"attr_one", "attr_two"},
+					},
+				},
+				{
+					Custom: &schema.CustomValidator{
+						SchemaDefinition: "my_validator.Validate()",
+					},
+				},
+			},
+			other: schema.ConfigValidators{
+				{
+					Custom: &schema.CustomValidator{
+						SchemaDefinition: "my_validator.Validate()",
+					},
+				},
+				{
+					Conflicting: &schema.PathRelationshipValidator{
+						Paths: schema.PathExpressions{"attr_two", "attr_one"},
+					},
+				},
+			},
+			expected: true,
+		},
+		"validators_conflicting_required_together": {
+			validators: schema.ConfigValidators{
+				{
+					Conflicting: &schema.PathRelationshipValidator{
+						Paths: schema.PathExpressions{"attr_one", "attr_two"},
+					},
+				},
+			},
+			other: schema.ConfigValidators{
+				{
+					RequiredTogether: &schema.PathRelationshipValidator{
+						Paths: schema.PathExpressions{"attr_one", "attr_two"},
+					},
+				},
+			},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.validators.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestConfigValidators_PathRelationships(t *testing.T) {
+	t.Parallel()
+
+	validators := schema.ConfigValidators{
+		{
+			ExactlyOneOf: &schema.PathRelationshipValidator{
+				Paths: schema.PathExpressions{"attr_one", "block_one.attr_two"},
+			},
+		},
+		{
+			Custom: &schema.CustomValidator{
+				SchemaDefinition: "my_validator.Validate()",
+			},
+		},
+	}
+
+	expected := schema.PathRelationships{
+		{
+			Name: "exactly_one_of",
+			Path: "attr_one",
+		},
+		{
+			Name: "exactly_one_of",
+			Path: "block_one.attr_two",
+		},
+	}
+
+	got := validators.PathRelationships()
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
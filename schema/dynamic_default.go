@@ -3,10 +3,29 @@
 
 package schema
 
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/diag"
+)
+
 // DynamicDefault defines a default dynamic value.
 type DynamicDefault struct {
 	// Custom defines a schema definition, and optional imports.
 	Custom *CustomDefault `json:"custom,omitempty"`
+
+	// Static defines a static default value, and its type.
+	Static *DynamicStaticDefault `json:"static,omitempty"`
+}
+
+// CustomDefault returns *CustomDefault.
+func (d *DynamicDefault) CustomDefault() *CustomDefault {
+	if d == nil {
+		return nil
+	}
+
+	return d.Custom
 }
 
 // Equal returns true if all fields of the given DynamicDefault are equal.
@@ -19,5 +38,63 @@ func (d *DynamicDefault) Equal(other *DynamicDefault) bool {
 		return false
 	}
 
-	return d.Custom.Equal(other.Custom)
+	if !d.Custom.Equal(other.Custom) {
+		return false
+	}
+
+	return d.Static.Equal(other.Static)
+}
+
+// DynamicDefaultValidateRequest defines the Path of the attribute for the
+// DynamicDefault being validated.
+type DynamicDefaultValidateRequest struct {
+	// Path defines the location of the attribute, such as
+	// `resource "example" attribute "dynamic"`.
+	Path Path
+}
+
+// Validate checks that a Static default value conforms to its Type.
+func (d *DynamicDefault) Validate(ctx context.Context, req DynamicDefaultValidateRequest) error {
+	if d == nil || d.Static == nil {
+		return nil
+	}
+
+	return errors.Join(staticValueErrors(req.Path, diag.Pointer(req.Path.JSONPointer(), "dynamic", "default", "static", "value"), "default static value", elementStaticValueType(d.Static.Type), d.Static.Value)...)
+}
+
+// DynamicStaticDefault defines a static default dynamic value. As the value
+// of a dynamic attribute can be of any type, the Type of the Value is
+// declared explicitly.
+type DynamicStaticDefault struct {
+	// Type defines the type of the Value.
+	Type ElementType `json:"type"`
+
+	// Value defines the value, which must conform to the Type. Numbers
+	// decoded from JSON are json.Number.
+	Value any `json:"value"`
+}
+
+// Equal returns true if all fields of the given DynamicStaticDefault are equal.
+func (d *DynamicStaticDefault) Equal(other *DynamicStaticDefault) bool {
+	if d == nil && other == nil {
+		return true
+	}
+
+	if d == nil || other == nil {
+		return false
+	}
+
+	if !d.Type.Equal(other.Type) {
+		return false
+	}
+
+	return staticValueEqual(d.Value, other.Value)
+}
+
+// UnmarshalJSON decodes the DynamicStaticDefault, decoding numbers within the
+// static value as json.Number to preserve their precision.
+func (d *DynamicStaticDefault) UnmarshalJSON(data []byte) error {
+	type dynamicStaticDefault DynamicStaticDefault
+
+	return unmarshalStaticJSON(data, (*dynamicStaticDefault)(d))
 }
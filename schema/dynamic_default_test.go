@@ -4,6 +4,8 @@
 package schema_test
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -26,6 +28,63 @@ func TestDynamicDefault_Equal(t *testing.T) {
 			other:    &schema.DynamicDefault{},
 			expected: false,
 		},
+		"static_equal": {
+			DynamicDefault: &schema.DynamicDefault{
+				Static: &schema.DynamicStaticDefault{
+					Type: schema.ElementType{
+						String: &schema.StringType{},
+					},
+					Value: "example",
+				},
+			},
+			other: &schema.DynamicDefault{
+				Static: &schema.DynamicStaticDefault{
+					Type: schema.ElementType{
+						String: &schema.StringType{},
+					},
+					Value: "example",
+				},
+			},
+			expected: true,
+		},
+		"static_type_different": {
+			DynamicDefault: &schema.DynamicDefault{
+				Static: &schema.DynamicStaticDefault{
+					Type: schema.ElementType{
+						Float64: &schema.Float64Type{},
+					},
+					Value: float64(1),
+				},
+			},
+			other: &schema.DynamicDefault{
+				Static: &schema.DynamicStaticDefault{
+					Type: schema.ElementType{
+						Int64: &schema.Int64Type{},
+					},
+					Value: float64(1),
+				},
+			},
+			expected: false,
+		},
+		"static_value_different": {
+			DynamicDefault: &schema.DynamicDefault{
+				Static: &schema.DynamicStaticDefault{
+					Type: schema.ElementType{
+						String: &schema.StringType{},
+					},
+					Value: "example",
+				},
+			},
+			other: &schema.DynamicDefault{
+				Static: &schema.DynamicStaticDefault{
+					Type: schema.ElementType{
+						String: &schema.StringType{},
+					},
+					Value: "other",
+				},
+			},
+			expected: false,
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -41,3 +100,114 @@ func TestDynamicDefault_Equal(t *testing.T) {
 		})
 	}
 }
+
+func TestDynamicDefault_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		dynamicDefault *schema.DynamicDefault
+		expectedError  error
+	}{
+		"nil": {},
+		"custom": {
+			dynamicDefault: &schema.DynamicDefault{
+				Custom: &schema.CustomDefault{
+					SchemaDefinition: "my_default.Default()",
+				},
+			},
+		},
+		"static_string": {
+			dynamicDefault: &schema.DynamicDefault{
+				Static: &schema.DynamicStaticDefault{
+					Type: schema.ElementType{
+						String: &schema.StringType{},
+					},
+					Value: "example",
+				},
+			},
+		},
+		"static_object": {
+			dynamicDefault: &schema.DynamicDefault{
+				Static: &schema.DynamicStaticDefault{
+					Type: schema.ElementType{
+						Object: &schema.ObjectType{
+							AttributeTypes: schema.ObjectAttributeTypes{
+								{
+									Name: "list",
+									List: &schema.ListType{
+										ElementType: schema.ElementType{
+											Bool: &schema.BoolType{},
+										},
+									},
+								},
+							},
+						},
+					},
+					Value: map[string]any{
+						"list": []any{true, false},
+					},
+				},
+			},
+		},
+		"static_string_not_conforming": {
+			dynamicDefault: &schema.DynamicDefault{
+				Static: &schema.DynamicStaticDefault{
+					Type: schema.ElementType{
+						String: &schema.StringType{},
+					},
+					Value: float64(1),
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr" default static value is not a string`),
+		},
+		"static_object_not_conforming": {
+			dynamicDefault: &schema.DynamicDefault{
+				Static: &schema.DynamicStaticDefault{
+					Type: schema.ElementType{
+						Object: &schema.ObjectType{
+							AttributeTypes: schema.ObjectAttributeTypes{
+								{
+									Name: "list",
+									List: &schema.ListType{
+										ElementType: schema.ElementType{
+											Bool: &schema.BoolType{},
+										},
+									},
+								},
+							},
+						},
+					},
+					Value: map[string]any{
+						"list": []any{true, "false"},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr" default static value attribute type "list" element 1 is not a bool`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.dynamicDefault.Validate(context.Background(), schema.DynamicDefaultValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+			})
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError.Error() {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+		})
+	}
+}
@@ -23,6 +23,18 @@ func (v DynamicValidators) CustomValidators() CustomValidators {
 	return customValidators
 }
 
+// PathRelationships returns a PathRelationship for each of the paths of the
+// path-relationship validators of each DynamicValidator.
+func (v DynamicValidators) PathRelationships() PathRelationships {
+	var relationships PathRelationships
+
+	for _, validator := range v {
+		relationships = append(relationships, pathRelationships(validator.AlsoRequires, validator.AtLeastOneOf, validator.ConflictsWith, validator.ExactlyOneOf)...)
+	}
+
+	return relationships
+}
+
 // Equal returns true if the given DynamicValidators is the same
 // length, and each of the DynamicValidator entries is equal.
 func (v DynamicValidators) Equal(other DynamicValidators) bool {
@@ -38,20 +50,25 @@ func (v DynamicValidators) Equal(other DynamicValidators) bool {
 		return false
 	}
 
-	validators := v.CustomValidators()
+	// Validators are unordered, so each validator is matched against
+	// any equal, and not already matched, validator in other.
+	matched := make([]bool, len(other))
 
-	otherValidators := other.CustomValidators()
+	for _, validator := range v {
+		found := false
 
-	if len(validators) != len(otherValidators) {
-		return false
-	}
+		for k, otherValidator := range other {
+			if matched[k] || !validator.Equal(otherValidator) {
+				continue
+			}
 
-	validators.Sort()
+			matched[k] = true
+			found = true
 
-	otherValidators.Sort()
+			break
+		}
 
-	for k, validator := range validators {
-		if !validator.Equal(otherValidators[k]) {
+		if !found {
 			return false
 		}
 	}
@@ -62,11 +79,43 @@ func (v DynamicValidators) Equal(other DynamicValidators) bool {
 // DynamicValidator type defines type and function that provides validation
 // functionality.
 type DynamicValidator struct {
+	// AlsoRequires validates that the attributes or blocks at each of the
+	// Paths are also configured when this value is configured.
+	AlsoRequires *PathRelationshipValidator `json:"also_requires,omitempty"`
+
+	// AtLeastOneOf validates that at least one of this value and the
+	// attributes or blocks at each of the Paths are configured.
+	AtLeastOneOf *PathRelationshipValidator `json:"at_least_one_of,omitempty"`
+
+	// ConflictsWith validates that none of the attributes or blocks at each
+	// of the Paths are configured when this value is configured.
+	ConflictsWith *PathRelationshipValidator `json:"conflicts_with,omitempty"`
+
 	// Custom defines a schema definition, and optional imports.
 	Custom *CustomValidator `json:"custom,omitempty"`
+
+	// ExactlyOneOf validates that exactly one of this value and the
+	// attributes or blocks at each of the Paths are configured.
+	ExactlyOneOf *PathRelationshipValidator `json:"exactly_one_of,omitempty"`
 }
 
 // Equal returns true if the fields of the given DynamicValidator equal.
 func (v DynamicValidator) Equal(other DynamicValidator) bool {
+	if !v.AlsoRequires.Equal(other.AlsoRequires) {
+		return false
+	}
+
+	if !v.AtLeastOneOf.Equal(other.AtLeastOneOf) {
+		return false
+	}
+
+	if !v.ConflictsWith.Equal(other.ConflictsWith) {
+		return false
+	}
+
+	if !v.ExactlyOneOf.Equal(other.ExactlyOneOf) {
+		return false
+	}
+
 	return v.Custom.Equal(other.Custom)
 }
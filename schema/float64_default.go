@@ -12,6 +12,15 @@ type Float64Default struct {
 	Static *float64 `json:"static,omitempty"`
 }
 
+// CustomDefault returns *CustomDefault.
+func (d *Float64Default) CustomDefault() *CustomDefault {
+	if d == nil {
+		return nil
+	}
+
+	return d.Custom
+}
+
 // Equal returns true if all fields of the given Float64Default are equal.
 func (d *Float64Default) Equal(other *Float64Default) bool {
 	if d == nil && other == nil {
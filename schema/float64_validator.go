@@ -3,6 +3,15 @@
 
 package schema
 
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/diag"
+)
+
 // Float64Validators type defines Float64Validator types
 type Float64Validators []Float64Validator
 
@@ -23,6 +32,18 @@ func (v Float64Validators) CustomValidators() CustomValidators {
 	return customValidators
 }
 
+// PathRelationships returns a PathRelationship for each of the paths of the
+// path-relationship validators of each Float64Validator.
+func (v Float64Validators) PathRelationships() PathRelationships {
+	var relationships PathRelationships
+
+	for _, validator := range v {
+		relationships = append(relationships, pathRelationships(validator.AlsoRequires, validator.AtLeastOneOf, validator.ConflictsWith, validator.ExactlyOneOf)...)
+	}
+
+	return relationships
+}
+
 // Equal returns true if the given Float64Validators is the same
 // length, and each of the Float64Validator entries is equal.
 func (v Float64Validators) Equal(other Float64Validators) bool {
@@ -38,20 +59,25 @@ func (v Float64Validators) Equal(other Float64Validators) bool {
 		return false
 	}
 
-	validators := v.CustomValidators()
+	// Validators are unordered, so each validator is matched against
+	// any equal, and not already matched, validator in other.
+	matched := make([]bool, len(other))
 
-	otherValidators := other.CustomValidators()
+	for _, validator := range v {
+		found := false
 
-	if len(validators) != len(otherValidators) {
-		return false
-	}
+		for k, otherValidator := range other {
+			if matched[k] || !validator.Equal(otherValidator) {
+				continue
+			}
 
-	validators.Sort()
+			matched[k] = true
+			found = true
 
-	otherValidators.Sort()
+			break
+		}
 
-	for k, validator := range validators {
-		if !validator.Equal(otherValidators[k]) {
+		if !found {
 			return false
 		}
 	}
@@ -59,13 +85,222 @@ func (v Float64Validators) Equal(other Float64Validators) bool {
 	return true
 }
 
+// Float64ValidatorsValidateRequest defines the Path of the attribute, and the
+// optional Default, for the Float64Validators being validated.
+type Float64ValidatorsValidateRequest struct {
+	// Path defines the location of the attribute, such as
+	// `resource "example" attribute "float64"`.
+	Path Path
+
+	Default *Float64Default
+}
+
+// Validate checks that the bounds of the AtLeast, AtMost and Between validators
+// are not inverted, and that a Static default value satisfies each of the
+// AtLeast, AtMost, Between and OneOf validators.
+func (v Float64Validators) Validate(ctx context.Context, req Float64ValidatorsValidateRequest) error {
+	var errs []error
+
+	for k, validator := range v {
+		pointer := diag.Pointer(req.Path.JSONPointer(), "float64", "validators", k)
+
+		if validator.Between != nil && validator.Between.Min > validator.Between.Max {
+			errs = append(errs, diag.NewErrorDiagnostic(req.Path.String(), pointer, "Invalid validator bounds", fmt.Sprintf("between validator min %g is greater than max %g", validator.Between.Min, validator.Between.Max)))
+		}
+
+		if validator.AtLeast == nil {
+			continue
+		}
+
+		for _, otherValidator := range v {
+			if otherValidator.AtMost != nil && validator.AtLeast.Min > otherValidator.AtMost.Max {
+				errs = append(errs, diag.NewErrorDiagnostic(req.Path.String(), pointer, "Invalid validator bounds", fmt.Sprintf("at_least validator min %g is greater than at_most validator max %g", validator.AtLeast.Min, otherValidator.AtMost.Max)))
+			}
+		}
+	}
+
+	if req.Default == nil || req.Default.Static == nil {
+		return errors.Join(errs...)
+	}
+
+	value := *req.Default.Static
+
+	for k, validator := range v {
+		pointer := diag.Pointer(req.Path.JSONPointer(), "float64", "validators", k)
+
+		if validator.AtLeast != nil && value < validator.AtLeast.Min {
+			errs = append(errs, diag.NewErrorDiagnostic(req.Path.String(), pointer, "Default does not satisfy validator", fmt.Sprintf("default %g is less than at_least validator min %g", value, validator.AtLeast.Min)))
+		}
+
+		if validator.AtMost != nil && value > validator.AtMost.Max {
+			errs = append(errs, diag.NewErrorDiagnostic(req.Path.String(), pointer, "Default does not satisfy validator", fmt.Sprintf("default %g is greater than at_most validator max %g", value, validator.AtMost.Max)))
+		}
+
+		if validator.Between != nil && value < validator.Between.Min {
+			errs = append(errs, diag.NewErrorDiagnostic(req.Path.String(), pointer, "Default does not satisfy validator", fmt.Sprintf("default %g is less than between validator min %g", value, validator.Between.Min)))
+		}
+
+		if validator.Between != nil && value > validator.Between.Max {
+			errs = append(errs, diag.NewErrorDiagnostic(req.Path.String(), pointer, "Default does not satisfy validator", fmt.Sprintf("default %g is greater than between validator max %g", value, validator.Between.Max)))
+		}
+
+		if validator.OneOf != nil && !slices.Contains(validator.OneOf.Values, value) {
+			errs = append(errs, diag.NewErrorDiagnostic(req.Path.String(), pointer, "Default does not satisfy validator", fmt.Sprintf("default %g is not one of validator values %v", value, validator.OneOf.Values)))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 // Float64Validator type defines type and function that provides validation
 // functionality.
 type Float64Validator struct {
+	// AlsoRequires validates that the attributes or blocks at each of the
+	// Paths are also configured when this value is configured.
+	AlsoRequires *PathRelationshipValidator `json:"also_requires,omitempty"`
+
+	// AtLeast validates that the value is at least Min.
+	AtLeast *Float64AtLeastValidator `json:"at_least,omitempty"`
+
+	// AtLeastOneOf validates that at least one of this value and the
+	// attributes or blocks at each of the Paths are configured.
+	AtLeastOneOf *PathRelationshipValidator `json:"at_least_one_of,omitempty"`
+
+	// AtMost validates that the value is at most Max.
+	AtMost *Float64AtMostValidator `json:"at_most,omitempty"`
+
+	// Between validates that the value is between Min and Max, inclusive.
+	Between *Float64BetweenValidator `json:"between,omitempty"`
+
+	// ConflictsWith validates that none of the attributes or blocks at each
+	// of the Paths are configured when this value is configured.
+	ConflictsWith *PathRelationshipValidator `json:"conflicts_with,omitempty"`
+
 	Custom *CustomValidator `json:"custom,omitempty"`
+
+	// ExactlyOneOf validates that exactly one of this value and the
+	// attributes or blocks at each of the Paths are configured.
+	ExactlyOneOf *PathRelationshipValidator `json:"exactly_one_of,omitempty"`
+
+	// OneOf validates that the value matches one of the Values.
+	OneOf *Float64OneOfValidator `json:"one_of,omitempty"`
 }
 
 // Equal returns true if the fields of the given Float64Validator equal.
 func (v Float64Validator) Equal(other Float64Validator) bool {
-	return v.Custom.Equal(other.Custom)
+	if !v.AlsoRequires.Equal(other.AlsoRequires) {
+		return false
+	}
+
+	if !v.AtLeastOneOf.Equal(other.AtLeastOneOf) {
+		return false
+	}
+
+	if !v.ConflictsWith.Equal(other.ConflictsWith) {
+		return false
+	}
+
+	if !v.ExactlyOneOf.Equal(other.ExactlyOneOf) {
+		return false
+	}
+
+	if !v.AtLeast.Equal(other.AtLeast) {
+		return false
+	}
+
+	if !v.AtMost.Equal(other.AtMost) {
+		return false
+	}
+
+	if !v.Between.Equal(other.Between) {
+		return false
+	}
+
+	if !v.Custom.Equal(other.Custom) {
+		return false
+	}
+
+	return v.OneOf.Equal(other.OneOf)
+}
+
+// Float64AtLeastValidator defines a validator for a minimum 64-bit floating point value.
+type Float64AtLeastValidator struct {
+	// Min defines the minimum value.
+	Min float64 `json:"min"`
+}
+
+// Equal returns true if all fields of the given Float64AtLeastValidator are equal.
+func (v *Float64AtLeastValidator) Equal(other *Float64AtLeastValidator) bool {
+	if v == nil && other == nil {
+		return true
+	}
+
+	if v == nil || other == nil {
+		return false
+	}
+
+	return v.Min == other.Min
+}
+
+// Float64AtMostValidator defines a validator for a maximum 64-bit floating point value.
+type Float64AtMostValidator struct {
+	// Max defines the maximum value.
+	Max float64 `json:"max"`
+}
+
+// Equal returns true if all fields of the given Float64AtMostValidator are equal.
+func (v *Float64AtMostValidator) Equal(other *Float64AtMostValidator) bool {
+	if v == nil && other == nil {
+		return true
+	}
+
+	if v == nil || other == nil {
+		return false
+	}
+
+	return v.Max == other.Max
+}
+
+// Float64BetweenValidator defines a validator for a minimum and maximum
+// 64-bit floating point value.
+type Float64BetweenValidator struct {
+	// Max defines the maximum value.
+	Max float64 `json:"max"`
+
+	// Min defines the minimum value.
+	Min float64 `json:"min"`
+}
+
+// Equal returns true if all fields of the given Float64BetweenValidator are equal.
+func (v *Float64BetweenValidator) Equal(other *Float64BetweenValidator) bool {
+	if v == nil && other == nil {
+		return true
+	}
+
+	if v == nil || other == nil {
+		return false
+	}
+
+	return v.Max == other.Max && v.Min == other.Min
+}
+
+// Float64OneOfValidator defines a validator for 64-bit floating point values which are
+// permitted.
+type Float64OneOfValidator struct {
+	// Values defines the values which are permitted.
+	Values []float64 `json:"values"`
+}
+
+// Equal returns true if the given Float64OneOfValidator has the same
+// Values, irrespective of order.
+func (v *Float64OneOfValidator) Equal(other *Float64OneOfValidator) bool {
+	if v == nil && other == nil {
+		return true
+	}
+
+	if v == nil || other == nil {
+		return false
+	}
+
+	return valuesEqual(v.Values, other.Values)
 }
@@ -4,6 +4,8 @@
 package schema_test
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -136,3 +138,304 @@ func TestFloat64Validators_Equal(t *testing.T) {
 		})
 	}
 }
+
+func TestFloat64Validator_Equal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator schema.Float64Validator
+		other     schema.Float64Validator
+		expected  bool
+	}{
+		"empty": {
+			expected: true,
+		},
+		"at_least_match": {
+			validator: schema.Float64Validator{
+				AtLeast: &schema.Float64AtLeastValidator{
+					Min: 1.5,
+				},
+			},
+			other: schema.Float64Validator{
+				AtLeast: &schema.Float64AtLeastValidator{
+					Min: 1.5,
+				},
+			},
+			expected: true,
+		},
+		"at_least_at_most": {
+			validator: schema.Float64Validator{
+				AtLeast: &schema.Float64AtLeastValidator{
+					Min: 1.5,
+				},
+			},
+			other: schema.Float64Validator{
+				AtMost: &schema.Float64AtMostValidator{
+					Max: 1.5,
+				},
+			},
+			expected: false,
+		},
+		"between_min_different": {
+			validator: schema.Float64Validator{
+				Between: &schema.Float64BetweenValidator{
+					Min: 1.5,
+					Max: 10.5,
+				},
+			},
+			other: schema.Float64Validator{
+				Between: &schema.Float64BetweenValidator{
+					Min: 2.5,
+					Max: 10.5,
+				},
+			},
+			expected: false,
+		},
+		"one_of_values_different_order": {
+			validator: schema.Float64Validator{
+				OneOf: &schema.Float64OneOfValidator{
+					Values: []float64{1.5, 2.5},
+				},
+			},
+			other: schema.Float64Validator{
+				OneOf: &schema.Float64OneOfValidator{
+					Values: []float64{2.5, 1.5},
+				},
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.validator.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFloat64Validators_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validators    schema.Float64Validators
+		request       schema.Float64ValidatorsValidateRequest
+		expectedError error
+	}{
+		"empty": {
+			request: schema.Float64ValidatorsValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+			},
+		},
+		"between": {
+			validators: schema.Float64Validators{
+				{
+					Between: &schema.Float64BetweenValidator{
+						Min: 1.5,
+						Max: 10.5,
+					},
+				},
+			},
+			request: schema.Float64ValidatorsValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+			},
+		},
+		"between_inverted": {
+			validators: schema.Float64Validators{
+				{
+					Between: &schema.Float64BetweenValidator{
+						Min: 10.5,
+						Max: 1.5,
+					},
+				},
+			},
+			request: schema.Float64ValidatorsValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr" between validator min 10.5 is greater than max 1.5`),
+		},
+		"at_least_at_most_inverted": {
+			validators: schema.Float64Validators{
+				{
+					AtMost: &schema.Float64AtMostValidator{
+						Max: 1.5,
+					},
+				},
+				{
+					AtLeast: &schema.Float64AtLeastValidator{
+						Min: 10.5,
+					},
+				},
+			},
+			request: schema.Float64ValidatorsValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr" at_least validator min 10.5 is greater than at_most validator max 1.5`),
+		},
+		"default_within_range": {
+			validators: schema.Float64Validators{
+				{
+					AtLeast: &schema.Float64AtLeastValidator{
+						Min: 1.5,
+					},
+				},
+				{
+					Between: &schema.Float64BetweenValidator{
+						Min: 1.5,
+						Max: 10.5,
+					},
+				},
+				{
+					OneOf: &schema.Float64OneOfValidator{
+						Values: []float64{1.5, 2.5},
+					},
+				},
+			},
+			request: schema.Float64ValidatorsValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+				Default: &schema.Float64Default{
+					Static: pointer(float64(2.5)),
+				},
+			},
+		},
+		"default_custom": {
+			validators: schema.Float64Validators{
+				{
+					AtLeast: &schema.Float64AtLeastValidator{
+						Min: 10.5,
+					},
+				},
+			},
+			request: schema.Float64ValidatorsValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+				Default: &schema.Float64Default{
+					Custom: &schema.CustomDefault{
+						SchemaDefinition: "my_default.Default()",
+					},
+				},
+			},
+		},
+		"default_less_than_at_least": {
+			validators: schema.Float64Validators{
+				{
+					AtLeast: &schema.Float64AtLeastValidator{
+						Min: 2.5,
+					},
+				},
+			},
+			request: schema.Float64ValidatorsValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+				Default: &schema.Float64Default{
+					Static: pointer(float64(1.5)),
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr" default 1.5 is less than at_least validator min 2.5`),
+		},
+		"default_greater_than_at_most": {
+			validators: schema.Float64Validators{
+				{
+					AtMost: &schema.Float64AtMostValidator{
+						Max: 2.5,
+					},
+				},
+			},
+			request: schema.Float64ValidatorsValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+				Default: &schema.Float64Default{
+					Static: pointer(float64(10.5)),
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr" default 10.5 is greater than at_most validator max 2.5`),
+		},
+		"default_not_between": {
+			validators: schema.Float64Validators{
+				{
+					Between: &schema.Float64BetweenValidator{
+						Min: 2.5,
+						Max: 10.5,
+					},
+				},
+			},
+			request: schema.Float64ValidatorsValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+				Default: &schema.Float64Default{
+					Static: pointer(float64(1.5)),
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr" default 1.5 is less than between validator min 2.5`),
+		},
+		"default_multiple_bounds": {
+			validators: schema.Float64Validators{
+				{
+					AtLeast: &schema.Float64AtLeastValidator{
+						Min: 5.5,
+					},
+					AtMost: &schema.Float64AtMostValidator{
+						Max: 7.5,
+					},
+					Between: &schema.Float64BetweenValidator{
+						Min: 5.5,
+						Max: 7.5,
+					},
+					OneOf: &schema.Float64OneOfValidator{
+						Values: []float64{1.5, 2.5},
+					},
+				},
+			},
+			request: schema.Float64ValidatorsValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+				Default: &schema.Float64Default{
+					Static: pointer(20.5),
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr" default 20.5 is greater than at_most validator max 7.5` + "\n" +
+				`resource "example" attribute "attr" default 20.5 is greater than between validator max 7.5` + "\n" +
+				`resource "example" attribute "attr" default 20.5 is not one of validator values [1.5 2.5]`),
+		},
+		"default_not_one_of": {
+			validators: schema.Float64Validators{
+				{
+					OneOf: &schema.Float64OneOfValidator{
+						Values: []float64{1.5, 2.5},
+					},
+				},
+			},
+			request: schema.Float64ValidatorsValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+				Default: &schema.Float64Default{
+					Static: pointer(float64(10.5)),
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr" default 10.5 is not one of validator values [1.5 2.5]`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.validators.Validate(context.Background(), testCase.request)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError.Error() {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+		})
+	}
+}
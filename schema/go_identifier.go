@@ -0,0 +1,152 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// GoIdentifier returns the Go identifier which downstream generators derive
+// from the given snake_case name. The name is split on underscores, empty
+// parts are dropped, and the first letter of each remaining part is upper
+// cased before the parts are joined. For example, "foo_bar", "foo__bar" and
+// "foo_bar_" all generate "FooBar".
+func GoIdentifier(name string) string {
+	var b strings.Builder
+
+	for _, part := range strings.Split(name, "_") {
+		if part == "" {
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(part)
+
+		b.WriteRune(unicode.ToUpper(r))
+		b.WriteString(part[size:])
+	}
+
+	return b.String()
+}
+
+// GoIdentifiers records the names, within a single scope, from which Go
+// identifiers are generated, so that collisions between different names
+// which generate the same Go identifier can be detected. Go identifiers which
+// differ only in case also collide, as downstream generators differ in how
+// they case each part of a name, for example "a_b" may generate "AB" or "Ab",
+// so "foo_bar" and "foobar_" are reported as a collision.
+type GoIdentifiers struct {
+	// Path is prefixed to errors returned from Add.
+	Path Path
+
+	identifiers map[string]goIdentifierSource
+}
+
+// goIdentifierSource defines the kind, for example "attribute" or "block",
+// and name from which a Go identifier was first generated, along with the
+// generated Go identifier.
+type goIdentifierSource struct {
+	kind       string
+	name       string
+	identifier string
+}
+
+// Add records the Go identifier generated from the given name, and returns
+// an error if a different name within the same scope has already generated
+// the same Go identifier. Duplicated names are not reported, as these are
+// already reported during validation.
+func (g *GoIdentifiers) Add(kind, name string) error {
+	if g.identifiers == nil {
+		g.identifiers = make(map[string]goIdentifierSource)
+	}
+
+	identifier := GoIdentifier(name)
+	key := strings.ToLower(identifier)
+
+	source, ok := g.identifiers[key]
+
+	if !ok {
+		g.identifiers[key] = goIdentifierSource{
+			kind:       kind,
+			name:       name,
+			identifier: identifier,
+		}
+
+		return nil
+	}
+
+	if source.name == name {
+		return nil
+	}
+
+	if source.identifier != identifier {
+		return g.Path.ErrorDiagnostic("Go identifier collision", fmt.Sprintf("%s %q and %s %q generate Go identifiers %q and %q, which differ only in case", source.kind, source.name, kind, name, source.identifier, identifier))
+	}
+
+	return g.Path.ErrorDiagnostic("Go identifier collision", fmt.Sprintf("%s %q and %s %q both generate Go identifier %q", source.kind, source.name, kind, name, identifier))
+}
+
+// ValidateGoIdentifiers delegates to ObjectAttributeTypes.ValidateGoIdentifiers
+// for the object type, including an object type nested within list, map or set
+// element types. The req.Path is the location of the element type, such as
+// `resource "example" attribute "list" element type`.
+func (e ElementType) ValidateGoIdentifiers(ctx context.Context, req ObjectValidateRequest) error {
+	switch {
+	case e.List != nil:
+		return e.List.ElementType.ValidateGoIdentifiers(ctx, ObjectValidateRequest{Path: req.Path.ElementType("list")})
+	case e.Map != nil:
+		return e.Map.ElementType.ValidateGoIdentifiers(ctx, ObjectValidateRequest{Path: req.Path.ElementType("map")})
+	case e.Object != nil:
+		return e.Object.AttributeTypes.ValidateGoIdentifiers(ctx, req)
+	case e.Set != nil:
+		return e.Set.ElementType.ValidateGoIdentifiers(ctx, ObjectValidateRequest{Path: req.Path.ElementType("set")})
+	}
+
+	return nil
+}
+
+// ValidateGoIdentifiers returns an error for each object attribute type name
+// which generates the same Go identifier as another object attribute type
+// name within the same object, and delegates to ValidateGoIdentifiers for
+// each nested object and element type.
+func (o ObjectAttributeTypes) ValidateGoIdentifiers(ctx context.Context, req ObjectValidateRequest) error {
+	identifiers := GoIdentifiers{
+		Path: req.Path,
+	}
+
+	var errs, nestedErrs []error
+
+	for k, attributeType := range o {
+		err := identifiers.Add("object attribute type", attributeType.Name)
+
+		if err != nil {
+			errs = append(errs, err)
+		}
+
+		path := req.Path.ObjectAttributeType(attributeType.Name, k)
+
+		switch {
+		case attributeType.List != nil:
+			err = attributeType.List.ElementType.ValidateGoIdentifiers(ctx, ObjectValidateRequest{Path: path.ElementType("list")})
+		case attributeType.Map != nil:
+			err = attributeType.Map.ElementType.ValidateGoIdentifiers(ctx, ObjectValidateRequest{Path: path.ElementType("map")})
+		case attributeType.Object != nil:
+			err = attributeType.Object.AttributeTypes.ValidateGoIdentifiers(ctx, ObjectValidateRequest{Path: path})
+		case attributeType.Set != nil:
+			err = attributeType.Set.ElementType.ValidateGoIdentifiers(ctx, ObjectValidateRequest{Path: path.ElementType("set")})
+		default:
+			err = nil
+		}
+
+		if err != nil {
+			nestedErrs = append(nestedErrs, err)
+		}
+	}
+
+	return errors.Join(append(errs, nestedErrs...)...)
+}
@@ -0,0 +1,215 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+func TestGoIdentifier(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name     string
+		expected string
+	}{
+		"single": {
+			name:     "foo",
+			expected: "Foo",
+		},
+		"snake_case": {
+			name:     "foo_bar",
+			expected: "FooBar",
+		},
+		"trailing_underscore": {
+			name:     "foobar_",
+			expected: "Foobar",
+		},
+		"consecutive_underscores": {
+			name:     "foo__bar",
+			expected: "FooBar",
+		},
+		"digit": {
+			name:     "foo_1",
+			expected: "Foo1",
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := schema.GoIdentifier(testCase.name)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestObjectAttributeTypes_ValidateGoIdentifiers(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attributeTypes schema.ObjectAttributeTypes
+		expectedError  error
+	}{
+		"unique": {
+			attributeTypes: schema.ObjectAttributeTypes{
+				{
+					Name:   "foo_bar",
+					String: &schema.StringType{},
+				},
+				{
+					Name:   "foo_baz",
+					String: &schema.StringType{},
+				},
+			},
+		},
+		"duplicated": {
+			attributeTypes: schema.ObjectAttributeTypes{
+				{
+					Name:   "foo_bar",
+					String: &schema.StringType{},
+				},
+				{
+					Name:   "foo_bar",
+					String: &schema.StringType{},
+				},
+			},
+		},
+		"collision": {
+			attributeTypes: schema.ObjectAttributeTypes{
+				{
+					Name:   "foo_bar",
+					String: &schema.StringType{},
+				},
+				{
+					Name:   "foo__bar",
+					String: &schema.StringType{},
+				},
+				{
+					Name:   "foo_bar_",
+					String: &schema.StringType{},
+				},
+			},
+			expectedError: fmt.Errorf(`attribute "example" object attribute type "foo_bar" and object attribute type "foo__bar" both generate Go identifier "FooBar"` + "\n" +
+				`attribute "example" object attribute type "foo_bar" and object attribute type "foo_bar_" both generate Go identifier "FooBar"`),
+		},
+		"nested-collision": {
+			attributeTypes: schema.ObjectAttributeTypes{
+				{
+					Name: "obj",
+					Object: &schema.ObjectType{
+						AttributeTypes: schema.ObjectAttributeTypes{
+							{
+								Name:  "foo_1",
+								Int64: &schema.Int64Type{},
+							},
+							{
+								Name:  "foo1",
+								Int64: &schema.Int64Type{},
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`attribute "example" object attribute type "obj" object attribute type "foo_1" and object attribute type "foo1" both generate Go identifier "Foo1"`),
+		},
+		"case-collision": {
+			attributeTypes: schema.ObjectAttributeTypes{
+				{
+					Name:   "foo_bar",
+					String: &schema.StringType{},
+				},
+				{
+					Name:   "foobar_",
+					String: &schema.StringType{},
+				},
+			},
+			expectedError: fmt.Errorf(`attribute "example" object attribute type "foo_bar" and object attribute type "foobar_" generate Go identifiers "FooBar" and "Foobar", which differ only in case`),
+		},
+		"element-type-collision": {
+			attributeTypes: schema.ObjectAttributeTypes{
+				{
+					Name: "list",
+					List: &schema.ListType{
+						ElementType: schema.ElementType{
+							Object: &schema.ObjectType{
+								AttributeTypes: schema.ObjectAttributeTypes{
+									{
+										Name:   "a_b",
+										String: &schema.StringType{},
+									},
+									{
+										Name:   "a__b",
+										String: &schema.StringType{},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "set",
+					Set: &schema.SetType{
+						ElementType: schema.ElementType{
+							Map: &schema.MapType{
+								ElementType: schema.ElementType{
+									Object: &schema.ObjectType{
+										AttributeTypes: schema.ObjectAttributeTypes{
+											{
+												Name:   "foo_bar",
+												String: &schema.StringType{},
+											},
+											{
+												Name:   "foobar",
+												String: &schema.StringType{},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`attribute "example" object attribute type "list" element type object attribute type "a_b" and object attribute type "a__b" both generate Go identifier "AB"` + "\n" +
+				`attribute "example" object attribute type "set" element type element type object attribute type "foo_bar" and object attribute type "foobar" generate Go identifiers "FooBar" and "Foobar", which differ only in case`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.attributeTypes.ValidateGoIdentifiers(context.Background(), schema.ObjectValidateRequest{
+				Path: schema.Path{}.Attribute("example", 0),
+			})
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedError.Error()); diff != "" {
+					t.Errorf("unexpected difference: %s", diff)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+		})
+	}
+}
@@ -0,0 +1,378 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+)
+
+// GoSyntaxValidateRequest defines the Path of the Go code snippet which is
+// being validated.
+type GoSyntaxValidateRequest struct {
+	Path Path
+}
+
+// ValidateGoSyntax returns an error if either the Type or the ValueType is
+// not a valid Go type expression, or references a package which is not
+// declared by the Import.
+func (c *CustomType) ValidateGoSyntax(ctx context.Context, req GoSyntaxValidateRequest) error {
+	if c == nil {
+		return nil
+	}
+
+	imports := optionalImport(c.Import)
+
+	errs := goSnippetErrors(req.Path, "custom type type", c.Type, imports, true)
+
+	errs = append(errs, goSnippetErrors(req.Path, "custom type value type", c.ValueType, imports, true)...)
+
+	return errors.Join(errs...)
+}
+
+// ValidateGoSyntax returns an error if the Type is not a valid Go type
+// expression, or references a package which is not declared by the Import.
+func (a *AssociatedExternalType) ValidateGoSyntax(ctx context.Context, req GoSyntaxValidateRequest) error {
+	if a == nil {
+		return nil
+	}
+
+	return errors.Join(goSnippetErrors(req.Path, "associated external type type", a.Type, optionalImport(a.Import), true)...)
+}
+
+// ValidateGoSyntax returns an error if the SchemaDefinition is not a valid
+// Go expression, or references a package which is not declared by the
+// Imports.
+func (c *CustomDefault) ValidateGoSyntax(ctx context.Context, req GoSyntaxValidateRequest) error {
+	if c == nil {
+		return nil
+	}
+
+	return errors.Join(goSnippetErrors(req.Path, "custom default schema definition", c.SchemaDefinition, c.Imports, false)...)
+}
+
+// ValidateGoExpression returns an error if the given expression is not a
+// valid Go expression, or references a package which is not declared by the
+// imports. The description, such as "schema definition", identifies the expression
+// within errors.
+func ValidateGoExpression(ctx context.Context, req GoSyntaxValidateRequest, description, expression string, imports []code.Import) error {
+	return errors.Join(goSnippetErrors(req.Path, description, expression, imports, false)...)
+}
+
+// ValidateGoSyntax delegates to CustomPlanModifier.ValidateGoSyntax for each
+// CustomPlanModifier.
+func (c CustomPlanModifiers) ValidateGoSyntax(ctx context.Context, req GoSyntaxValidateRequest) error {
+	var errs []error
+
+	for _, customPlanModifier := range c {
+		err := customPlanModifier.ValidateGoSyntax(ctx, req)
+
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ValidateGoSyntax returns an error if the SchemaDefinition is not a valid
+// Go expression, or references a package which is not declared by the
+// Imports.
+func (c *CustomPlanModifier) ValidateGoSyntax(ctx context.Context, req GoSyntaxValidateRequest) error {
+	if c == nil {
+		return nil
+	}
+
+	return errors.Join(goSnippetErrors(req.Path, "custom plan modifier schema definition", c.SchemaDefinition, c.Imports, false)...)
+}
+
+// ValidateGoSyntax delegates to CustomValidator.ValidateGoSyntax for each
+// CustomValidator.
+func (c CustomValidators) ValidateGoSyntax(ctx context.Context, req GoSyntaxValidateRequest) error {
+	var errs []error
+
+	for _, customValidator := range c {
+		err := customValidator.ValidateGoSyntax(ctx, req)
+
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ValidateGoSyntax returns an error if the SchemaDefinition is not a valid
+// Go expression, or references a package which is not declared by the
+// Imports.
+func (c *CustomValidator) ValidateGoSyntax(ctx context.Context, req GoSyntaxValidateRequest) error {
+	if c == nil {
+		return nil
+	}
+
+	return errors.Join(goSnippetErrors(req.Path, "custom validator schema definition", c.SchemaDefinition, c.Imports, false)...)
+}
+
+// ValidateGoSyntax delegates to CustomType.ValidateGoSyntax for the type
+// which is set, and for any nested element or object attribute types. The
+// Path of the request is the path of the element type, such as that returned
+// by Path.ElementType.
+func (e ElementType) ValidateGoSyntax(ctx context.Context, req GoSyntaxValidateRequest) error {
+	switch {
+	case e.Bool != nil:
+		return e.Bool.CustomType.ValidateGoSyntax(ctx, req)
+	case e.Float64 != nil:
+		return e.Float64.CustomType.ValidateGoSyntax(ctx, req)
+	case e.Int64 != nil:
+		return e.Int64.CustomType.ValidateGoSyntax(ctx, req)
+	case e.List != nil:
+		return errors.Join(
+			e.List.CustomType.ValidateGoSyntax(ctx, req),
+			e.List.ElementType.ValidateGoSyntax(ctx, GoSyntaxValidateRequest{Path: req.Path.ElementType("list")}),
+		)
+	case e.Map != nil:
+		return errors.Join(
+			e.Map.CustomType.ValidateGoSyntax(ctx, req),
+			e.Map.ElementType.ValidateGoSyntax(ctx, GoSyntaxValidateRequest{Path: req.Path.ElementType("map")}),
+		)
+	case e.Number != nil:
+		return e.Number.CustomType.ValidateGoSyntax(ctx, req)
+	case e.Object != nil:
+		return errors.Join(
+			e.Object.CustomType.ValidateGoSyntax(ctx, req),
+			e.Object.AttributeTypes.ValidateGoSyntax(ctx, req),
+		)
+	case e.Set != nil:
+		return errors.Join(
+			e.Set.CustomType.ValidateGoSyntax(ctx, req),
+			e.Set.ElementType.ValidateGoSyntax(ctx, GoSyntaxValidateRequest{Path: req.Path.ElementType("set")}),
+		)
+	case e.String != nil:
+		return e.String.CustomType.ValidateGoSyntax(ctx, req)
+	}
+
+	return nil
+}
+
+// ValidateGoSyntax delegates to CustomType.ValidateGoSyntax for the type
+// which is set on each ObjectAttributeType, and for any nested element or
+// object attribute types.
+func (o ObjectAttributeTypes) ValidateGoSyntax(ctx context.Context, req GoSyntaxValidateRequest) error {
+	var errs []error
+
+	for k, attributeType := range o {
+		nestedReq := GoSyntaxValidateRequest{
+			Path: req.Path.ObjectAttributeType(attributeType.Name, k),
+		}
+
+		var err error
+
+		switch {
+		case attributeType.Bool != nil:
+			err = attributeType.Bool.CustomType.ValidateGoSyntax(ctx, nestedReq)
+		case attributeType.Dynamic != nil:
+			err = attributeType.Dynamic.CustomType.ValidateGoSyntax(ctx, nestedReq)
+		case attributeType.Float64 != nil:
+			err = attributeType.Float64.CustomType.ValidateGoSyntax(ctx, nestedReq)
+		case attributeType.Int64 != nil:
+			err = attributeType.Int64.CustomType.ValidateGoSyntax(ctx, nestedReq)
+		case attributeType.List != nil:
+			err = errors.Join(
+				attributeType.List.CustomType.ValidateGoSyntax(ctx, nestedReq),
+				attributeType.List.ElementType.ValidateGoSyntax(ctx, GoSyntaxValidateRequest{Path: nestedReq.Path.ElementType("list")}),
+			)
+		case attributeType.Map != nil:
+			err = errors.Join(
+				attributeType.Map.CustomType.ValidateGoSyntax(ctx, nestedReq),
+				attributeType.Map.ElementType.ValidateGoSyntax(ctx, GoSyntaxValidateRequest{Path: nestedReq.Path.ElementType("map")}),
+			)
+		case attributeType.Number != nil:
+			err = attributeType.Number.CustomType.ValidateGoSyntax(ctx, nestedReq)
+		case attributeType.Object != nil:
+			err = errors.Join(
+				attributeType.Object.CustomType.ValidateGoSyntax(ctx, nestedReq),
+				attributeType.Object.AttributeTypes.ValidateGoSyntax(ctx, nestedReq),
+			)
+		case attributeType.Set != nil:
+			err = errors.Join(
+				attributeType.Set.CustomType.ValidateGoSyntax(ctx, nestedReq),
+				attributeType.Set.ElementType.ValidateGoSyntax(ctx, GoSyntaxValidateRequest{Path: nestedReq.Path.ElementType("set")}),
+			)
+		case attributeType.String != nil:
+			err = attributeType.String.CustomType.ValidateGoSyntax(ctx, nestedReq)
+		}
+
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// optionalImport returns a slice containing the given import, if it is not nil.
+func optionalImport(i *code.Import) []code.Import {
+	if i == nil {
+		return nil
+	}
+
+	return []code.Import{*i}
+}
+
+// goSnippetErrors returns an error if the given snippet cannot be parsed as
+// a Go expression or, when isType is true, as a Go type expression, and an
+// error for each package qualifier within the snippet which does not match
+// the alias, or last path element, of one of the given imports.
+func goSnippetErrors(path Path, description, snippet string, imports []code.Import, isType bool) []error {
+	kind := "expression"
+
+	if isType {
+		kind = "type expression"
+	}
+
+	expr, err := parser.ParseExpr(snippet)
+
+	if err != nil {
+		return []error{path.ErrorDiagnostic("Invalid Go syntax", fmt.Sprintf("%s %q is not a valid Go %s: %s", description, snippet, kind, err))}
+	}
+
+	if isType && !isGoTypeExpr(expr) {
+		return []error{path.ErrorDiagnostic("Invalid Go syntax", fmt.Sprintf("%s %q is not a valid Go %s", description, snippet, kind))}
+	}
+
+	packageNames := make(map[string]struct{}, len(imports))
+
+	for _, i := range imports {
+		packageNames[i.Name()] = struct{}{}
+	}
+
+	declaredNames := goDeclaredNames(expr)
+
+	var errs []error
+
+	reported := make(map[string]struct{})
+
+	ast.Inspect(expr, func(n ast.Node) bool {
+		selector, ok := n.(*ast.SelectorExpr)
+
+		if !ok {
+			return true
+		}
+
+		ident, ok := selector.X.(*ast.Ident)
+
+		if !ok {
+			return true
+		}
+
+		// Names declared within the snippet, such as function literal
+		// parameters, are not package qualifiers.
+		if _, ok := declaredNames[ident.Name]; ok {
+			return true
+		}
+
+		if _, ok := packageNames[ident.Name]; ok {
+			return true
+		}
+
+		if _, ok := reported[ident.Name]; ok {
+			return true
+		}
+
+		reported[ident.Name] = struct{}{}
+
+		errs = append(errs, path.ErrorDiagnostic("Missing Go import", fmt.Sprintf("%s %q references package %q which is not imported", description, snippet, ident.Name)))
+
+		return true
+	})
+
+	return errs
+}
+
+// goDeclaredNames returns the names declared within the given parsed
+// expression, by function literal parameters and results, variable
+// declarations and short variable declarations.
+func goDeclaredNames(expr ast.Expr) map[string]struct{} {
+	names := make(map[string]struct{})
+
+	ast.Inspect(expr, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.Field:
+			for _, name := range node.Names {
+				names[name.Name] = struct{}{}
+			}
+		case *ast.ValueSpec:
+			for _, name := range node.Names {
+				names[name.Name] = struct{}{}
+			}
+		case *ast.AssignStmt:
+			if node.Tok != token.DEFINE {
+				return true
+			}
+
+			for _, lhs := range node.Lhs {
+				if ident, ok := lhs.(*ast.Ident); ok {
+					names[ident.Name] = struct{}{}
+				}
+			}
+		case *ast.RangeStmt:
+			if node.Tok != token.DEFINE {
+				return true
+			}
+
+			for _, e := range []ast.Expr{node.Key, node.Value} {
+				if ident, ok := e.(*ast.Ident); ok {
+					names[ident.Name] = struct{}{}
+				}
+			}
+		}
+
+		return true
+	})
+
+	return names
+}
+
+// isGoTypeExpr returns true if the given parsed expression is a Go type
+// expression.
+func isGoTypeExpr(expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return true
+	case *ast.SelectorExpr:
+		_, ok := e.X.(*ast.Ident)
+
+		return ok
+	case *ast.ParenExpr:
+		return isGoTypeExpr(e.X)
+	case *ast.StarExpr:
+		return isGoTypeExpr(e.X)
+	case *ast.ArrayType:
+		return isGoTypeExpr(e.Elt)
+	case *ast.MapType:
+		return isGoTypeExpr(e.Key) && isGoTypeExpr(e.Value)
+	case *ast.ChanType:
+		return isGoTypeExpr(e.Value)
+	case *ast.FuncType, *ast.InterfaceType, *ast.StructType:
+		return true
+	case *ast.IndexExpr:
+		return isGoTypeExpr(e.X) && isGoTypeExpr(e.Index)
+	case *ast.IndexListExpr:
+		for _, index := range e.Indices {
+			if !isGoTypeExpr(index) {
+				return false
+			}
+		}
+
+		return isGoTypeExpr(e.X)
+	}
+
+	return false
+}
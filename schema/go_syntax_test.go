@@ -0,0 +1,245 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/diag"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+func TestCustomType_ValidateGoSyntax(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		customType    *schema.CustomType
+		expectedError error
+	}{
+		"nil": {},
+		"valid": {
+			customType: &schema.CustomType{
+				Import: &code.Import{
+					Path: "github.com/hashicorp/terraform-plugin-framework/types/basetypes",
+				},
+				Type:      "basetypes.StringType",
+				ValueType: "basetypes.StringValue",
+			},
+		},
+		"valid-alias": {
+			customType: &schema.CustomType{
+				Import: &code.Import{
+					Alias: pointer("fwtype"),
+					Path:  "github.com/hashicorp/terraform-plugin-framework/types/basetypes",
+				},
+				Type:      "fwtype.StringType",
+				ValueType: "*fwtype.StringValue",
+			},
+		},
+		"valid-unqualified": {
+			customType: &schema.CustomType{
+				Type:      "myType",
+				ValueType: "map[string][]myValue",
+			},
+		},
+		"invalid-syntax": {
+			customType: &schema.CustomType{
+				Type:      "my.Type)",
+				ValueType: "myValue",
+			},
+			expectedError: fmt.Errorf(`attribute "example" custom type type "my.Type)" is not a valid Go type expression: 1:8: expected 'EOF', found ')'`),
+		},
+		"not-type-expression": {
+			customType: &schema.CustomType{
+				Type:      "myType",
+				ValueType: "myValue()",
+			},
+			expectedError: fmt.Errorf(`attribute "example" custom type value type "myValue()" is not a valid Go type expression`),
+		},
+		"import-alias-mismatch": {
+			customType: &schema.CustomType{
+				Import: &code.Import{
+					Alias: pointer("fwtype"),
+					Path:  "github.com/hashicorp/terraform-plugin-framework/types/basetypes",
+				},
+				Type:      "basetypes.StringType",
+				ValueType: "fwtype.StringValue",
+			},
+			expectedError: fmt.Errorf(`attribute "example" custom type type "basetypes.StringType" references package "basetypes" which is not imported`),
+		},
+		"import-missing": {
+			customType: &schema.CustomType{
+				Type:      "basetypes.StringType",
+				ValueType: "basetypes.StringValue",
+			},
+			expectedError: fmt.Errorf(`attribute "example" custom type type "basetypes.StringType" references package "basetypes" which is not imported` + "\n" +
+				`attribute "example" custom type value type "basetypes.StringValue" references package "basetypes" which is not imported`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.customType.ValidateGoSyntax(context.Background(), schema.GoSyntaxValidateRequest{
+				Path: schema.Path{}.Attribute("example", 0),
+			})
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedError.Error()); diff != "" {
+					t.Errorf("unexpected difference: %s", diff)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestCustomValidator_ValidateGoSyntax(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		customValidator *schema.CustomValidator
+		expectedError   error
+	}{
+		"nil": {},
+		"valid": {
+			customValidator: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator",
+					},
+					{
+						Path: "regexp",
+					},
+				},
+				SchemaDefinition: `stringvalidator.RegexMatches(regexp.MustCompile("^[a-z]+$"), "")`,
+			},
+		},
+		"valid-function-literal": {
+			customValidator: &schema.CustomValidator{
+				SchemaDefinition: "myValidator(func(v myValue) bool { return v.IsNull() })",
+			},
+		},
+		"invalid-syntax": {
+			customValidator: &schema.CustomValidator{
+				SchemaDefinition: "myValidator(",
+			},
+			expectedError: fmt.Errorf(`attribute "example" custom validator schema definition "myValidator(" is not a valid Go expression: 1:13: expected ')', found 'EOF'`),
+		},
+		"import-missing": {
+			customValidator: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "regexp",
+					},
+				},
+				SchemaDefinition: `stringvalidator.RegexMatches(regexp.MustCompile("^[a-z]+$"), "")`,
+			},
+			expectedError: fmt.Errorf(`attribute "example" custom validator schema definition "stringvalidator.RegexMatches(regexp.MustCompile(\"^[a-z]+$\"), \"\")" references package "stringvalidator" which is not imported`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.customValidator.ValidateGoSyntax(context.Background(), schema.GoSyntaxValidateRequest{
+				Path: schema.Path{}.Attribute("example", 0),
+			})
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedError.Error()); diff != "" {
+					t.Errorf("unexpected difference: %s", diff)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestElementType_ValidateGoSyntax(t *testing.T) {
+	t.Parallel()
+
+	elementType := schema.ElementType{
+		List: &schema.ListType{
+			ElementType: schema.ElementType{
+				Object: &schema.ObjectType{
+					AttributeTypes: schema.ObjectAttributeTypes{
+						{
+							Name: "obj_string",
+							String: &schema.StringType{
+								CustomType: &schema.CustomType{
+									Type:      "basetypes.StringType",
+									ValueType: "myValue",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expectedError := fmt.Errorf(`attribute "example" element type element type object attribute type "obj_string" custom type type "basetypes.StringType" references package "basetypes" which is not imported`)
+
+	err := elementType.ValidateGoSyntax(context.Background(), schema.GoSyntaxValidateRequest{
+		Path: schema.Path{}.Attribute("example", 0).ElementType("list"),
+	})
+
+	if err == nil {
+		t.Fatalf("got no error, expected: %s", expectedError)
+	}
+
+	if diff := cmp.Diff(err.Error(), expectedError.Error()); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestCustomValidator_ValidateGoSyntax_Diagnostics(t *testing.T) {
+	t.Parallel()
+
+	customValidator := &schema.CustomValidator{
+		SchemaDefinition: "stringvalidator.LengthAtLeast(1)",
+	}
+
+	expected := diag.Diagnostics{
+		{
+			Severity: diag.SeverityError,
+			Summary:  "Missing Go import",
+			Detail:   `custom validator schema definition "stringvalidator.LengthAtLeast(1)" references package "stringvalidator" which is not imported`,
+			Path:     `resource "example" attribute "attr"`,
+			Pointer:  "/resources/0/schema/attributes/1",
+		},
+	}
+
+	err := customValidator.ValidateGoSyntax(context.Background(), schema.GoSyntaxValidateRequest{
+		Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 1),
+	})
+
+	if diff := cmp.Diff(diag.FromError(err), expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
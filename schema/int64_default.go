@@ -12,6 +12,15 @@ type Int64Default struct {
 	Static *int64 `json:"static,omitempty"`
 }
 
+// CustomDefault returns *CustomDefault.
+func (d *Int64Default) CustomDefault() *CustomDefault {
+	if d == nil {
+		return nil
+	}
+
+	return d.Custom
+}
+
 // Equal returns true if all fields of the given Int64Default are equal.
 func (d *Int64Default) Equal(other *Int64Default) bool {
 	if d == nil && other == nil {
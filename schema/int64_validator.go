@@ -3,6 +3,15 @@
 
 package schema
 
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/diag"
+)
+
 // Int64Validators type defines Int64Validator types
 type Int64Validators []Int64Validator
 
@@ -23,6 +32,18 @@ func (v Int64Validators) CustomValidators() CustomValidators {
 	return customValidators
 }
 
+// PathRelationships returns a PathRelationship for each of the paths of the
+// path-relationship validators of each Int64Validator.
+func (v Int64Validators) PathRelationships() PathRelationships {
+	var relationships PathRelationships
+
+	for _, validator := range v {
+		relationships = append(relationships, pathRelationships(validator.AlsoRequires, validator.AtLeastOneOf, validator.ConflictsWith, validator.ExactlyOneOf)...)
+	}
+
+	return relationships
+}
+
 // Equal returns true if the given Int64Validators is the same
 // length, and each of the Int64Validator entries is equal.
 func (v Int64Validators) Equal(other Int64Validators) bool {
@@ -38,20 +59,25 @@ func (v Int64Validators) Equal(other Int64Validators) bool {
 		return false
 	}
 
-	validators := v.CustomValidators()
+	// Validators are unordered, so each validator is matched against
+	// any equal, and not already matched, validator in other.
+	matched := make([]bool, len(other))
 
-	otherValidators := other.CustomValidators()
+	for _, validator := range v {
+		found := false
 
-	if len(validators) != len(otherValidators) {
-		return false
-	}
+		for k, otherValidator := range other {
+			if matched[k] || !validator.Equal(otherValidator) {
+				continue
+			}
 
-	validators.Sort()
+			matched[k] = true
+			found = true
 
-	otherValidators.Sort()
+			break
+		}
 
-	for k, validator := range validators {
-		if !validator.Equal(otherValidators[k]) {
+		if !found {
 			return false
 		}
 	}
@@ -59,13 +85,222 @@ func (v Int64Validators) Equal(other Int64Validators) bool {
 	return true
 }
 
+// Int64ValidatorsValidateRequest defines the Path of the attribute, and the
+// optional Default, for the Int64Validators being validated.
+type Int64ValidatorsValidateRequest struct {
+	// Path defines the location of the attribute, such as
+	// `resource "example" attribute "int64"`.
+	Path Path
+
+	Default *Int64Default
+}
+
+// Validate checks that the bounds of the AtLeast, AtMost and Between validators
+// are not inverted, and that a Static default value satisfies each of the
+// AtLeast, AtMost, Between and OneOf validators.
+func (v Int64Validators) Validate(ctx context.Context, req Int64ValidatorsValidateRequest) error {
+	var errs []error
+
+	for k, validator := range v {
+		pointer := diag.Pointer(req.Path.JSONPointer(), "int64", "validators", k)
+
+		if validator.Between != nil && validator.Between.Min > validator.Between.Max {
+			errs = append(errs, diag.NewErrorDiagnostic(req.Path.String(), pointer, "Invalid validator bounds", fmt.Sprintf("between validator min %d is greater than max %d", validator.Between.Min, validator.Between.Max)))
+		}
+
+		if validator.AtLeast == nil {
+			continue
+		}
+
+		for _, otherValidator := range v {
+			if otherValidator.AtMost != nil && validator.AtLeast.Min > otherValidator.AtMost.Max {
+				errs = append(errs, diag.NewErrorDiagnostic(req.Path.String(), pointer, "Invalid validator bounds", fmt.Sprintf("at_least validator min %d is greater than at_most validator max %d", validator.AtLeast.Min, otherValidator.AtMost.Max)))
+			}
+		}
+	}
+
+	if req.Default == nil || req.Default.Static == nil {
+		return errors.Join(errs...)
+	}
+
+	value := *req.Default.Static
+
+	for k, validator := range v {
+		pointer := diag.Pointer(req.Path.JSONPointer(), "int64", "validators", k)
+
+		if validator.AtLeast != nil && value < validator.AtLeast.Min {
+			errs = append(errs, diag.NewErrorDiagnostic(req.Path.String(), pointer, "Default does not satisfy validator", fmt.Sprintf("default %d is less than at_least validator min %d", value, validator.AtLeast.Min)))
+		}
+
+		if validator.AtMost != nil && value > validator.AtMost.Max {
+			errs = append(errs, diag.NewErrorDiagnostic(req.Path.String(), pointer, "Default does not satisfy validator", fmt.Sprintf("default %d is greater than at_most validator max %d", value, validator.AtMost.Max)))
+		}
+
+		if validator.Between != nil && value < validator.Between.Min {
+			errs = append(errs, diag.NewErrorDiagnostic(req.Path.String(), pointer, "Default does not satisfy validator", fmt.Sprintf("default %d is less than between validator min %d", value, validator.Between.Min)))
+		}
+
+		if validator.Between != nil && value > validator.Between.Max {
+			errs = append(errs, diag.NewErrorDiagnostic(req.Path.String(), pointer, "Default does not satisfy validator", fmt.Sprintf("default %d is greater than between validator max %d", value, validator.Between.Max)))
+		}
+
+		if validator.OneOf != nil && !slices.Contains(validator.OneOf.Values, value) {
+			errs = append(errs, diag.NewErrorDiagnostic(req.Path.String(), pointer, "Default does not satisfy validator", fmt.Sprintf("default %d is not one of validator values %v", value, validator.OneOf.Values)))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 // Int64Validator type defines type and function that provides validation
 // functionality.
 type Int64Validator struct {
+	// AlsoRequires validates that the attributes or blocks at each of the
+	// Paths are also configured when this value is configured.
+	AlsoRequires *PathRelationshipValidator `json:"also_requires,omitempty"`
+
+	// AtLeast validates that the value is at least Min.
+	AtLeast *Int64AtLeastValidator `json:"at_least,omitempty"`
+
+	// AtLeastOneOf validates that at least one of this value and the
+	// attributes or blocks at each of the Paths are configured.
+	AtLeastOneOf *PathRelationshipValidator `json:"at_least_one_of,omitempty"`
+
+	// AtMost validates that the value is at most Max.
+	AtMost *Int64AtMostValidator `json:"at_most,omitempty"`
+
+	// Between validates that the value is between Min and Max, inclusive.
+	Between *Int64BetweenValidator `json:"between,omitempty"`
+
+	// ConflictsWith validates that none of the attributes or blocks at each
+	// of the Paths are configured when this value is configured.
+	ConflictsWith *PathRelationshipValidator `json:"conflicts_with,omitempty"`
+
 	Custom *CustomValidator `json:"custom,omitempty"`
+
+	// ExactlyOneOf validates that exactly one of this value and the
+	// attributes or blocks at each of the Paths are configured.
+	ExactlyOneOf *PathRelationshipValidator `json:"exactly_one_of,omitempty"`
+
+	// OneOf validates that the value matches one of the Values.
+	OneOf *Int64OneOfValidator `json:"one_of,omitempty"`
 }
 
 // Equal returns true if the fields of the given Int64Validator equal.
 func (v Int64Validator) Equal(other Int64Validator) bool {
-	return v.Custom.Equal(other.Custom)
+	if !v.AlsoRequires.Equal(other.AlsoRequires) {
+		return false
+	}
+
+	if !v.AtLeastOneOf.Equal(other.AtLeastOneOf) {
+		return false
+	}
+
+	if !v.ConflictsWith.Equal(other.ConflictsWith) {
+		return false
+	}
+
+	if !v.ExactlyOneOf.Equal(other.ExactlyOneOf) {
+		return false
+	}
+
+	if !v.AtLeast.Equal(other.AtLeast) {
+		return false
+	}
+
+	if !v.AtMost.Equal(other.AtMost) {
+		return false
+	}
+
+	if !v.Between.Equal(other.Between) {
+		return false
+	}
+
+	if !v.Custom.Equal(other.Custom) {
+		return false
+	}
+
+	return v.OneOf.Equal(other.OneOf)
+}
+
+// Int64AtLeastValidator defines a validator for a minimum 64-bit integer value.
+type Int64AtLeastValidator struct {
+	// Min defines the minimum value.
+	Min int64 `json:"min"`
+}
+
+// Equal returns true if all fields of the given Int64AtLeastValidator are equal.
+func (v *Int64AtLeastValidator) Equal(other *Int64AtLeastValidator) bool {
+	if v == nil && other == nil {
+		return true
+	}
+
+	if v == nil || other == nil {
+		return false
+	}
+
+	return v.Min == other.Min
+}
+
+// Int64AtMostValidator defines a validator for a maximum 64-bit integer value.
+type Int64AtMostValidator struct {
+	// Max defines the maximum value.
+	Max int64 `json:"max"`
+}
+
+// Equal returns true if all fields of the given Int64AtMostValidator are equal.
+func (v *Int64AtMostValidator) Equal(other *Int64AtMostValidator) bool {
+	if v == nil && other == nil {
+		return true
+	}
+
+	if v == nil || other == nil {
+		return false
+	}
+
+	return v.Max == other.Max
+}
+
+// Int64BetweenValidator defines a validator for a minimum and maximum
+// 64-bit integer value.
+type Int64BetweenValidator struct {
+	// Max defines the maximum value.
+	Max int64 `json:"max"`
+
+	// Min defines the minimum value.
+	Min int64 `json:"min"`
+}
+
+// Equal returns true if all fields of the given Int64BetweenValidator are equal.
+func (v *Int64BetweenValidator) Equal(other *Int64BetweenValidator) bool {
+	if v == nil && other == nil {
+		return true
+	}
+
+	if v == nil || other == nil {
+		return false
+	}
+
+	return v.Max == other.Max && v.Min == other.Min
+}
+
+// Int64OneOfValidator defines a validator for 64-bit integer values which are
+// permitted.
+type Int64OneOfValidator struct {
+	// Values defines the values which are permitted.
+	Values []int64 `json:"values"`
+}
+
+// Equal returns true if the given Int64OneOfValidator has the same
+// Values, irrespective of order.
+func (v *Int64OneOfValidator) Equal(other *Int64OneOfValidator) bool {
+	if v == nil && other == nil {
+		return true
+	}
+
+	if v == nil || other == nil {
+		return false
+	}
+
+	return valuesEqual(v.Values, other.Values)
 }
@@ -4,6 +4,8 @@
 package schema_test
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -136,3 +138,304 @@ func TestInt64Validators_Equal(t *testing.T) {
 		})
 	}
 }
+
+func TestInt64Validator_Equal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator schema.Int64Validator
+		other     schema.Int64Validator
+		expected  bool
+	}{
+		"empty": {
+			expected: true,
+		},
+		"at_least_match": {
+			validator: schema.Int64Validator{
+				AtLeast: &schema.Int64AtLeastValidator{
+					Min: 1,
+				},
+			},
+			other: schema.Int64Validator{
+				AtLeast: &schema.Int64AtLeastValidator{
+					Min: 1,
+				},
+			},
+			expected: true,
+		},
+		"at_least_at_most": {
+			validator: schema.Int64Validator{
+				AtLeast: &schema.Int64AtLeastValidator{
+					Min: 1,
+				},
+			},
+			other: schema.Int64Validator{
+				AtMost: &schema.Int64AtMostValidator{
+					Max: 1,
+				},
+			},
+			expected: false,
+		},
+		"between_min_different": {
+			validator: schema.Int64Validator{
+				Between: &schema.Int64BetweenValidator{
+					Min: 1,
+					Max: 10,
+				},
+			},
+			other: schema.Int64Validator{
+				Between: &schema.Int64BetweenValidator{
+					Min: 2,
+					Max: 10,
+				},
+			},
+			expected: false,
+		},
+		"one_of_values_different_order": {
+			validator: schema.Int64Validator{
+				OneOf: &schema.Int64OneOfValidator{
+					Values: []int64{1, 2},
+				},
+			},
+			other: schema.Int64Validator{
+				OneOf: &schema.Int64OneOfValidator{
+					Values: []int64{2, 1},
+				},
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.validator.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestInt64Validators_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validators    schema.Int64Validators
+		request       schema.Int64ValidatorsValidateRequest
+		expectedError error
+	}{
+		"empty": {
+			request: schema.Int64ValidatorsValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+			},
+		},
+		"between": {
+			validators: schema.Int64Validators{
+				{
+					Between: &schema.Int64BetweenValidator{
+						Min: 1,
+						Max: 10,
+					},
+				},
+			},
+			request: schema.Int64ValidatorsValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+			},
+		},
+		"between_inverted": {
+			validators: schema.Int64Validators{
+				{
+					Between: &schema.Int64BetweenValidator{
+						Min: 10,
+						Max: 1,
+					},
+				},
+			},
+			request: schema.Int64ValidatorsValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr" between validator min 10 is greater than max 1`),
+		},
+		"at_least_at_most_inverted": {
+			validators: schema.Int64Validators{
+				{
+					AtMost: &schema.Int64AtMostValidator{
+						Max: 1,
+					},
+				},
+				{
+					AtLeast: &schema.Int64AtLeastValidator{
+						Min: 10,
+					},
+				},
+			},
+			request: schema.Int64ValidatorsValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr" at_least validator min 10 is greater than at_most validator max 1`),
+		},
+		"default_within_range": {
+			validators: schema.Int64Validators{
+				{
+					AtLeast: &schema.Int64AtLeastValidator{
+						Min: 1,
+					},
+				},
+				{
+					Between: &schema.Int64BetweenValidator{
+						Min: 1,
+						Max: 10,
+					},
+				},
+				{
+					OneOf: &schema.Int64OneOfValidator{
+						Values: []int64{1, 2},
+					},
+				},
+			},
+			request: schema.Int64ValidatorsValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+				Default: &schema.Int64Default{
+					Static: pointer(int64(2)),
+				},
+			},
+		},
+		"default_custom": {
+			validators: schema.Int64Validators{
+				{
+					AtLeast: &schema.Int64AtLeastValidator{
+						Min: 10,
+					},
+				},
+			},
+			request: schema.Int64ValidatorsValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+				Default: &schema.Int64Default{
+					Custom: &schema.CustomDefault{
+						SchemaDefinition: "my_default.Default()",
+					},
+				},
+			},
+		},
+		"default_less_than_at_least": {
+			validators: schema.Int64Validators{
+				{
+					AtLeast: &schema.Int64AtLeastValidator{
+						Min: 2,
+					},
+				},
+			},
+			request: schema.Int64ValidatorsValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+				Default: &schema.Int64Default{
+					Static: pointer(int64(1)),
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr" default 1 is less than at_least validator min 2`),
+		},
+		"default_greater_than_at_most": {
+			validators: schema.Int64Validators{
+				{
+					AtMost: &schema.Int64AtMostValidator{
+						Max: 2,
+					},
+				},
+			},
+			request: schema.Int64ValidatorsValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+				Default: &schema.Int64Default{
+					Static: pointer(int64(10)),
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr" default 10 is greater than at_most validator max 2`),
+		},
+		"default_not_between": {
+			validators: schema.Int64Validators{
+				{
+					Between: &schema.Int64BetweenValidator{
+						Min: 2,
+						Max: 10,
+					},
+				},
+			},
+			request: schema.Int64ValidatorsValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+				Default: &schema.Int64Default{
+					Static: pointer(int64(1)),
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr" default 1 is less than between validator min 2`),
+		},
+		"default_multiple_bounds": {
+			validators: schema.Int64Validators{
+				{
+					AtLeast: &schema.Int64AtLeastValidator{
+						Min: 5,
+					},
+					AtMost: &schema.Int64AtMostValidator{
+						Max: 7,
+					},
+					Between: &schema.Int64BetweenValidator{
+						Min: 5,
+						Max: 7,
+					},
+					OneOf: &schema.Int64OneOfValidator{
+						Values: []int64{1, 2},
+					},
+				},
+			},
+			request: schema.Int64ValidatorsValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+				Default: &schema.Int64Default{
+					Static: pointer(int64(20)),
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr" default 20 is greater than at_most validator max 7` + "\n" +
+				`resource "example" attribute "attr" default 20 is greater than between validator max 7` + "\n" +
+				`resource "example" attribute "attr" default 20 is not one of validator values [1 2]`),
+		},
+		"default_not_one_of": {
+			validators: schema.Int64Validators{
+				{
+					OneOf: &schema.Int64OneOfValidator{
+						Values: []int64{1, 2},
+					},
+				},
+			},
+			request: schema.Int64ValidatorsValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+				Default: &schema.Int64Default{
+					Static: pointer(int64(10)),
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr" default 10 is not one of validator values [1 2]`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.validators.Validate(context.Background(), testCase.request)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError.Error() {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+		})
+	}
+}
@@ -3,10 +3,22 @@
 
 package schema
 
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/diag"
+)
+
 // ListDefault defines a custom type for a default list value.
 type ListDefault struct {
 	// Custom defines a schema definition, and optional imports.
 	Custom *CustomDefault `json:"custom,omitempty"`
+
+	// Static defines a static default list value. Each element must conform to
+	// the ElementType of the attribute. Numbers decoded from JSON are
+	// json.Number.
+	Static *[]any `json:"static,omitempty"`
 }
 
 // CustomDefault returns *CustomDefault.
@@ -28,5 +40,41 @@ func (d *ListDefault) Equal(other *ListDefault) bool {
 		return false
 	}
 
-	return d.Custom.Equal(other.Custom)
+	if !d.Custom.Equal(other.Custom) {
+		return false
+	}
+
+	if d.Static == nil || other.Static == nil {
+		return d.Static == nil && other.Static == nil
+	}
+
+	return staticValueEqual(*d.Static, *other.Static)
+}
+
+// ListDefaultValidateRequest defines the Path of the attribute, and the
+// ElementType of the attribute, for the ListDefault being validated.
+type ListDefaultValidateRequest struct {
+	// Path defines the location of the attribute, such as
+	// `resource "example" attribute "list"`.
+	Path Path
+
+	// ElementType defines the type for all elements of the list.
+	ElementType ElementType
+}
+
+// Validate checks that a Static default value conforms to the ElementType.
+func (d *ListDefault) Validate(ctx context.Context, req ListDefaultValidateRequest) error {
+	if d == nil || d.Static == nil {
+		return nil
+	}
+
+	return errors.Join(staticValueErrors(req.Path, diag.Pointer(req.Path.JSONPointer(), "list", "default", "static"), "default static value", staticValueType{kind: "list", elementType: &req.ElementType}, *d.Static)...)
+}
+
+// UnmarshalJSON decodes the ListDefault, decoding numbers within the static
+// value as json.Number to preserve their precision.
+func (d *ListDefault) UnmarshalJSON(data []byte) error {
+	type listDefault ListDefault
+
+	return unmarshalStaticJSON(data, (*listDefault)(d))
 }
@@ -4,10 +4,14 @@
 package schema_test
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 
+	"github.com/hashicorp/terraform-plugin-codegen-spec/diag"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 )
 
@@ -26,6 +30,48 @@ func TestListDefault_Equal(t *testing.T) {
 			other:    &schema.ListDefault{},
 			expected: false,
 		},
+		"static_equal": {
+			listDefault: &schema.ListDefault{
+				Static: pointer([]any{float64(1), float64(2)}),
+			},
+			other: &schema.ListDefault{
+				Static: pointer([]any{float64(1), float64(2)}),
+			},
+			expected: true,
+		},
+		"static_number_equal": {
+			listDefault: &schema.ListDefault{
+				Static: pointer([]any{json.Number("1.0"), json.Number("9007199254740993")}),
+			},
+			other: &schema.ListDefault{
+				Static: pointer([]any{float64(1), json.Number("9007199254740993")}),
+			},
+			expected: true,
+		},
+		"static_number_different": {
+			listDefault: &schema.ListDefault{
+				Static: pointer([]any{json.Number("9007199254740993")}),
+			},
+			other: &schema.ListDefault{
+				Static: pointer([]any{json.Number("9007199254740992")}),
+			},
+		},
+		"static_different_order": {
+			listDefault: &schema.ListDefault{
+				Static: pointer([]any{float64(1), float64(2)}),
+			},
+			other: &schema.ListDefault{
+				Static: pointer([]any{float64(2), float64(1)}),
+			},
+			expected: false,
+		},
+		"static_nil_other_not_nil": {
+			listDefault: &schema.ListDefault{},
+			other: &schema.ListDefault{
+				Static: pointer([]any{}),
+			},
+			expected: false,
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -41,3 +87,181 @@ func TestListDefault_Equal(t *testing.T) {
 		})
 	}
 }
+
+func TestListDefault_JSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"custom":        `{"custom":{"schema_definition":"listdefault.StaticValue()"}}`,
+		"static-empty":  `{"static":[]}`,
+		"static":        `{"static":["one","two"]}`,
+		"static-number": `{"static":[9007199254740993,1.00000000000000000000000001]}`,
+	}
+
+	for name, document := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var listDefault schema.ListDefault
+
+			if err := json.Unmarshal([]byte(document), &listDefault); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := json.Marshal(listDefault)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(string(got), document); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListDefault_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		listDefault   *schema.ListDefault
+		request       schema.ListDefaultValidateRequest
+		expectedError error
+	}{
+		"nil": {
+			request: schema.ListDefaultValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+			},
+		},
+		"static_conforms": {
+			listDefault: &schema.ListDefault{
+				Static: pointer([]any{float64(1), nil, float64(3)}),
+			},
+			request: schema.ListDefaultValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+				ElementType: schema.ElementType{
+					Int64: &schema.Int64Type{},
+				},
+			},
+		},
+		"static_element_not_int64": {
+			listDefault: &schema.ListDefault{
+				Static: pointer([]any{float64(1), "two", float64(3.5)}),
+			},
+			request: schema.ListDefaultValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+				ElementType: schema.ElementType{
+					Int64: &schema.Int64Type{},
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr" default static value element 1 is not an int64` + "\n" +
+				`resource "example" attribute "attr" default static value element 2 is not an int64`),
+		},
+		"static_json_number_conforms": {
+			listDefault: &schema.ListDefault{
+				Static: pointer([]any{json.Number("9007199254740993"), json.Number("1e2")}),
+			},
+			request: schema.ListDefaultValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+				ElementType: schema.ElementType{
+					Int64: &schema.Int64Type{},
+				},
+			},
+		},
+		"static_json_number_element_not_int64": {
+			listDefault: &schema.ListDefault{
+				Static: pointer([]any{json.Number("1.5"), json.Number("9223372036854775808")}),
+			},
+			request: schema.ListDefaultValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+				ElementType: schema.ElementType{
+					Int64: &schema.Int64Type{},
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr" default static value element 0 is not an int64` + "\n" +
+				`resource "example" attribute "attr" default static value element 1 is not an int64`),
+		},
+		"static_json_number_element_not_float64": {
+			listDefault: &schema.ListDefault{
+				Static: pointer([]any{json.Number("1e400")}),
+			},
+			request: schema.ListDefaultValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+				ElementType: schema.ElementType{
+					Float64: &schema.Float64Type{},
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr" default static value element 0 is not a float64`),
+		},
+		"static_nested_list_element_not_string": {
+			listDefault: &schema.ListDefault{
+				Static: pointer([]any{[]any{"one"}, "two"}),
+			},
+			request: schema.ListDefaultValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+				ElementType: schema.ElementType{
+					List: &schema.ListType{
+						ElementType: schema.ElementType{
+							String: &schema.StringType{},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr" default static value element 1 is not a list`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.listDefault.Validate(context.Background(), testCase.request)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError.Error() {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestListDefault_Validate_Diagnostics(t *testing.T) {
+	t.Parallel()
+
+	listDefault := &schema.ListDefault{
+		Static: pointer([]any{json.Number("1"), json.Number("1.5")}),
+	}
+
+	expected := diag.Diagnostics{
+		{
+			Severity: diag.SeverityError,
+			Summary:  "Invalid default value",
+			Detail:   "default static value element 1 is not an int64",
+			Path:     `resource "example" attribute "attr"`,
+			Pointer:  "/resources/0/schema/attributes/0/list/default/static/1",
+		},
+	}
+
+	err := listDefault.Validate(context.Background(), schema.ListDefaultValidateRequest{
+		Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+		ElementType: schema.ElementType{
+			Int64: &schema.Int64Type{},
+		},
+	})
+
+	if diff := cmp.Diff(diag.FromError(err), expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
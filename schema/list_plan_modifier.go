@@ -38,20 +38,25 @@ func (v ListPlanModifiers) Equal(other ListPlanModifiers) bool {
 		return false
 	}
 
-	planModifiers := v.CustomPlanModifiers()
+	// Plan modifiers are unordered, so each plan modifier is matched against
+	// any equal, and not already matched, plan modifier in other.
+	matched := make([]bool, len(other))
 
-	otherPlanModifiers := other.CustomPlanModifiers()
+	for _, planModifier := range v {
+		found := false
 
-	if len(planModifiers) != len(otherPlanModifiers) {
-		return false
-	}
+		for k, otherPlanModifier := range other {
+			if matched[k] || !planModifier.Equal(otherPlanModifier) {
+				continue
+			}
 
-	planModifiers.Sort()
+			matched[k] = true
+			found = true
 
-	otherPlanModifiers.Sort()
+			break
+		}
 
-	for k, planModifier := range planModifiers {
-		if !planModifier.Equal(otherPlanModifiers[k]) {
+		if !found {
 			return false
 		}
 	}
@@ -62,10 +67,35 @@ func (v ListPlanModifiers) Equal(other ListPlanModifiers) bool {
 // ListPlanModifier type defines type and function that provides plan modification
 // functionality.
 type ListPlanModifier struct {
+	// Custom defines a schema definition, and optional imports.
 	Custom *CustomPlanModifier `json:"custom,omitempty"`
+
+	// RequiresReplace requires the resource to be replaced when the value
+	// changes.
+	RequiresReplace *RequiresReplacePlanModifier `json:"requires_replace,omitempty"`
+
+	// RequiresReplaceIfConfigured requires the resource to be replaced when
+	// the value changes, and the value is configured.
+	RequiresReplaceIfConfigured *RequiresReplaceIfConfiguredPlanModifier `json:"requires_replace_if_configured,omitempty"`
+
+	// UseStateForUnknown uses the prior state value, rather than an unknown
+	// value, when the value is computed and not configured.
+	UseStateForUnknown *UseStateForUnknownPlanModifier `json:"use_state_for_unknown,omitempty"`
 }
 
 // Equal returns true if the fields of the given ListPlanModifier are equal.
 func (v ListPlanModifier) Equal(other ListPlanModifier) bool {
-	return v.Custom.Equal(other.Custom)
+	if !v.Custom.Equal(other.Custom) {
+		return false
+	}
+
+	if !v.RequiresReplace.Equal(other.RequiresReplace) {
+		return false
+	}
+
+	if !v.RequiresReplaceIfConfigured.Equal(other.RequiresReplaceIfConfigured) {
+		return false
+	}
+
+	return v.UseStateForUnknown.Equal(other.UseStateForUnknown)
 }
@@ -23,6 +23,18 @@ func (v ListValidators) CustomValidators() CustomValidators {
 	return customValidators
 }
 
+// PathRelationships returns a PathRelationship for each of the paths of the
+// path-relationship validators of each ListValidator.
+func (v ListValidators) PathRelationships() PathRelationships {
+	var relationships PathRelationships
+
+	for _, validator := range v {
+		relationships = append(relationships, pathRelationships(validator.AlsoRequires, validator.AtLeastOneOf, validator.ConflictsWith, validator.ExactlyOneOf)...)
+	}
+
+	return relationships
+}
+
 // Equal returns true if the given ListValidators is the same
 // length, and each of the ListValidator entries is equal.
 func (v ListValidators) Equal(other ListValidators) bool {
@@ -38,20 +50,25 @@ func (v ListValidators) Equal(other ListValidators) bool {
 		return false
 	}
 
-	validators := v.CustomValidators()
+	// Validators are unordered, so each validator is matched against
+	// any equal, and not already matched, validator in other.
+	matched := make([]bool, len(other))
 
-	otherValidators := other.CustomValidators()
+	for _, validator := range v {
+		found := false
 
-	if len(validators) != len(otherValidators) {
-		return false
-	}
+		for k, otherValidator := range other {
+			if matched[k] || !validator.Equal(otherValidator) {
+				continue
+			}
 
-	validators.Sort()
+			matched[k] = true
+			found = true
 
-	otherValidators.Sort()
+			break
+		}
 
-	for k, validator := range validators {
-		if !validator.Equal(otherValidators[k]) {
+		if !found {
 			return false
 		}
 	}
@@ -62,10 +79,143 @@ func (v ListValidators) Equal(other ListValidators) bool {
 // ListValidator type defines type and function that provides validation
 // functionality.
 type ListValidator struct {
+	// AlsoRequires validates that the attributes or blocks at each of the
+	// Paths are also configured when this value is configured.
+	AlsoRequires *PathRelationshipValidator `json:"also_requires,omitempty"`
+
+	// AtLeastOneOf validates that at least one of this value and the
+	// attributes or blocks at each of the Paths are configured.
+	AtLeastOneOf *PathRelationshipValidator `json:"at_least_one_of,omitempty"`
+
+	// ConflictsWith validates that none of the attributes or blocks at each
+	// of the Paths are configured when this value is configured.
+	ConflictsWith *PathRelationshipValidator `json:"conflicts_with,omitempty"`
+
 	Custom *CustomValidator `json:"custom,omitempty"`
+
+	// ExactlyOneOf validates that exactly one of this value and the
+	// attributes or blocks at each of the Paths are configured.
+	ExactlyOneOf *PathRelationshipValidator `json:"exactly_one_of,omitempty"`
+
+	// SizeAtLeast validates that the list has at least Min elements.
+	SizeAtLeast *ListSizeAtLeastValidator `json:"size_at_least,omitempty"`
+
+	// SizeAtMost validates that the list has at most Max elements.
+	SizeAtMost *ListSizeAtMostValidator `json:"size_at_most,omitempty"`
+
+	// SizeBetween validates that the list has between Min and Max elements.
+	SizeBetween *ListSizeBetweenValidator `json:"size_between,omitempty"`
+
+	// UniqueValues validates that the list does not contain duplicate elements.
+	UniqueValues *ListUniqueValuesValidator `json:"unique_values,omitempty"`
 }
 
 // Equal returns true if the fields of the given ListValidator equal.
 func (v ListValidator) Equal(other ListValidator) bool {
-	return v.Custom.Equal(other.Custom)
+	if !v.AlsoRequires.Equal(other.AlsoRequires) {
+		return false
+	}
+
+	if !v.AtLeastOneOf.Equal(other.AtLeastOneOf) {
+		return false
+	}
+
+	if !v.ConflictsWith.Equal(other.ConflictsWith) {
+		return false
+	}
+
+	if !v.ExactlyOneOf.Equal(other.ExactlyOneOf) {
+		return false
+	}
+
+	if !v.Custom.Equal(other.Custom) {
+		return false
+	}
+
+	if !v.SizeAtLeast.Equal(other.SizeAtLeast) {
+		return false
+	}
+
+	if !v.SizeAtMost.Equal(other.SizeAtMost) {
+		return false
+	}
+
+	if !v.SizeBetween.Equal(other.SizeBetween) {
+		return false
+	}
+
+	return v.UniqueValues.Equal(other.UniqueValues)
+}
+
+// ListSizeAtLeastValidator defines a validator for a minimum number of
+// list elements.
+type ListSizeAtLeastValidator struct {
+	// Min defines the minimum number of elements.
+	Min int64 `json:"min"`
+}
+
+// Equal returns true if all fields of the given ListSizeAtLeastValidator are equal.
+func (v *ListSizeAtLeastValidator) Equal(other *ListSizeAtLeastValidator) bool {
+	if v == nil && other == nil {
+		return true
+	}
+
+	if v == nil || other == nil {
+		return false
+	}
+
+	return v.Min == other.Min
+}
+
+// ListSizeAtMostValidator defines a validator for a maximum number of
+// list elements.
+type ListSizeAtMostValidator struct {
+	// Max defines the maximum number of elements.
+	Max int64 `json:"max"`
+}
+
+// Equal returns true if all fields of the given ListSizeAtMostValidator are equal.
+func (v *ListSizeAtMostValidator) Equal(other *ListSizeAtMostValidator) bool {
+	if v == nil && other == nil {
+		return true
+	}
+
+	if v == nil || other == nil {
+		return false
+	}
+
+	return v.Max == other.Max
+}
+
+// ListSizeBetweenValidator defines a validator for a minimum and maximum
+// number of list elements.
+type ListSizeBetweenValidator struct {
+	// Max defines the maximum number of elements.
+	Max int64 `json:"max"`
+
+	// Min defines the minimum number of elements.
+	Min int64 `json:"min"`
+}
+
+// Equal returns true if all fields of the given ListSizeBetweenValidator are equal.
+func (v *ListSizeBetweenValidator) Equal(other *ListSizeBetweenValidator) bool {
+	if v == nil && other == nil {
+		return true
+	}
+
+	if v == nil || other == nil {
+		return false
+	}
+
+	return v.Max == other.Max && v.Min == other.Min
+}
+
+// ListUniqueValuesValidator defines a validator for lists which must not
+// contain duplicate elements.
+type ListUniqueValuesValidator struct{}
+
+// Equal returns true if both the given ListUniqueValuesValidator and this
+// ListUniqueValuesValidator are either nil or not nil.
+func (v *ListUniqueValuesValidator) Equal(other *ListUniqueValuesValidator) bool {
+	return (v == nil) == (other == nil)
 }
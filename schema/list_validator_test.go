@@ -121,6 +121,93 @@ func TestListValidators_Equal(t *testing.T) {
 			},
 			expected: true,
 		},
+		"validators_size_between_different_order": {
+			validators: schema.ListValidators{
+				{
+					SizeBetween: &schema.ListSizeBetweenValidator{
+						Min: 1,
+						Max: 10,
+					},
+				},
+				{
+					Custom: &schema.CustomValidator{
+						SchemaDefinition: "one",
+					},
+				},
+			},
+			other: schema.ListValidators{
+				{
+					Custom: &schema.CustomValidator{
+						SchemaDefinition: "one",
+					},
+				},
+				{
+					SizeBetween: &schema.ListSizeBetweenValidator{
+						Min: 1,
+						Max: 10,
+					},
+				},
+			},
+			expected: true,
+		},
+		"validators_size_at_least_min_different": {
+			validators: schema.ListValidators{
+				{
+					SizeAtLeast: &schema.ListSizeAtLeastValidator{
+						Min: 1,
+					},
+				},
+			},
+			other: schema.ListValidators{
+				{
+					SizeAtLeast: &schema.ListSizeAtLeastValidator{
+						Min: 2,
+					},
+				},
+			},
+			expected: false,
+		},
+		"validators_size_at_least_size_at_most": {
+			validators: schema.ListValidators{
+				{
+					SizeAtLeast: &schema.ListSizeAtLeastValidator{
+						Min: 1,
+					},
+				},
+			},
+			other: schema.ListValidators{
+				{
+					SizeAtMost: &schema.ListSizeAtMostValidator{
+						Max: 1,
+					},
+				},
+			},
+			expected: false,
+		},
+		"validators_unique_values": {
+			validators: schema.ListValidators{
+				{
+					UniqueValues: &schema.ListUniqueValuesValidator{},
+				},
+			},
+			other: schema.ListValidators{
+				{
+					UniqueValues: &schema.ListUniqueValuesValidator{},
+				},
+			},
+			expected: true,
+		},
+		"validators_unique_values_nil": {
+			validators: schema.ListValidators{
+				{
+					UniqueValues: &schema.ListUniqueValuesValidator{},
+				},
+			},
+			other: schema.ListValidators{
+				{},
+			},
+			expected: false,
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -3,10 +3,22 @@
 
 package schema
 
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/diag"
+)
+
 // MapDefault defines a custom type for a default map value.
 type MapDefault struct {
 	// Custom defines a schema definition, and optional imports.
 	Custom *CustomDefault `json:"custom,omitempty"`
+
+	// Static defines a static default map value. Each element must conform to
+	// the ElementType of the attribute. Numbers decoded from JSON are
+	// json.Number.
+	Static *map[string]any `json:"static,omitempty"`
 }
 
 // CustomDefault returns *CustomDefault.
@@ -28,5 +40,41 @@ func (d *MapDefault) Equal(other *MapDefault) bool {
 		return false
 	}
 
-	return d.Custom.Equal(other.Custom)
+	if !d.Custom.Equal(other.Custom) {
+		return false
+	}
+
+	if d.Static == nil || other.Static == nil {
+		return d.Static == nil && other.Static == nil
+	}
+
+	return staticValueEqual(*d.Static, *other.Static)
+}
+
+// MapDefaultValidateRequest defines the Path of the attribute, and the
+// ElementType of the attribute, for the MapDefault being validated.
+type MapDefaultValidateRequest struct {
+	// Path defines the location of the attribute, such as
+	// `resource "example" attribute "map"`.
+	Path Path
+
+	// ElementType defines the type for all elements of the map.
+	ElementType ElementType
+}
+
+// Validate checks that a Static default value conforms to the ElementType.
+func (d *MapDefault) Validate(ctx context.Context, req MapDefaultValidateRequest) error {
+	if d == nil || d.Static == nil {
+		return nil
+	}
+
+	return errors.Join(staticValueErrors(req.Path, diag.Pointer(req.Path.JSONPointer(), "map", "default", "static"), "default static value", staticValueType{kind: "map", elementType: &req.ElementType}, *d.Static)...)
+}
+
+// UnmarshalJSON decodes the MapDefault, decoding numbers within the static
+// value as json.Number to preserve their precision.
+func (d *MapDefault) UnmarshalJSON(data []byte) error {
+	type mapDefault MapDefault
+
+	return unmarshalStaticJSON(data, (*mapDefault)(d))
 }
@@ -4,6 +4,8 @@
 package schema_test
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -26,6 +28,24 @@ func TestMapDefault_Equal(t *testing.T) {
 			other:    &schema.MapDefault{},
 			expected: false,
 		},
+		"static_equal": {
+			mapDefault: &schema.MapDefault{
+				Static: pointer(map[string]any{"one": "value"}),
+			},
+			other: &schema.MapDefault{
+				Static: pointer(map[string]any{"one": "value"}),
+			},
+			expected: true,
+		},
+		"static_different": {
+			mapDefault: &schema.MapDefault{
+				Static: pointer(map[string]any{"one": "value"}),
+			},
+			other: &schema.MapDefault{
+				Static: pointer(map[string]any{"one": "other"}),
+			},
+			expected: false,
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -41,3 +61,65 @@ func TestMapDefault_Equal(t *testing.T) {
 		})
 	}
 }
+
+func TestMapDefault_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		mapDefault    *schema.MapDefault
+		request       schema.MapDefaultValidateRequest
+		expectedError error
+	}{
+		"nil": {
+			request: schema.MapDefaultValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+			},
+		},
+		"static_conforms": {
+			mapDefault: &schema.MapDefault{
+				Static: pointer(map[string]any{"one": true}),
+			},
+			request: schema.MapDefaultValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+				ElementType: schema.ElementType{
+					Bool: &schema.BoolType{},
+				},
+			},
+		},
+		"static_element_not_bool": {
+			mapDefault: &schema.MapDefault{
+				Static: pointer(map[string]any{"one": true, "two": "false"}),
+			},
+			request: schema.MapDefaultValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+				ElementType: schema.ElementType{
+					Bool: &schema.BoolType{},
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr" default static value key "two" is not a bool`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.mapDefault.Validate(context.Background(), testCase.request)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError.Error() {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+		})
+	}
+}
@@ -23,6 +23,32 @@ func (v MapValidators) CustomValidators() CustomValidators {
 	return customValidators
 }
 
+// AllCustomValidators returns CustomValidator for each MapValidator,
+// followed by each CustomValidator nested within the KeysMatch and
+// ValuesMatch validators.
+func (v MapValidators) AllCustomValidators() CustomValidators {
+	customValidators := v.CustomValidators()
+
+	for _, validator := range v {
+		customValidators = append(customValidators, validator.KeysMatch.customValidators()...)
+		customValidators = append(customValidators, validator.ValuesMatch.customValidators()...)
+	}
+
+	return customValidators
+}
+
+// PathRelationships returns a PathRelationship for each of the paths of the
+// path-relationship validators of each MapValidator.
+func (v MapValidators) PathRelationships() PathRelationships {
+	var relationships PathRelationships
+
+	for _, validator := range v {
+		relationships = append(relationships, pathRelationships(validator.AlsoRequires, validator.AtLeastOneOf, validator.ConflictsWith, validator.ExactlyOneOf)...)
+	}
+
+	return relationships
+}
+
 // Equal returns true if the given MapValidators is the same
 // length, and each of the MapValidator entries is equal.
 func (v MapValidators) Equal(other MapValidators) bool {
@@ -38,20 +64,25 @@ func (v MapValidators) Equal(other MapValidators) bool {
 		return false
 	}
 
-	validators := v.CustomValidators()
+	// Validators are unordered, so each validator is matched against
+	// any equal, and not already matched, validator in other.
+	matched := make([]bool, len(other))
 
-	otherValidators := other.CustomValidators()
+	for _, validator := range v {
+		found := false
 
-	if len(validators) != len(otherValidators) {
-		return false
-	}
+		for k, otherValidator := range other {
+			if matched[k] || !validator.Equal(otherValidator) {
+				continue
+			}
 
-	validators.Sort()
+			matched[k] = true
+			found = true
 
-	otherValidators.Sort()
+			break
+		}
 
-	for k, validator := range validators {
-		if !validator.Equal(otherValidators[k]) {
+		if !found {
 			return false
 		}
 	}
@@ -62,10 +93,252 @@ func (v MapValidators) Equal(other MapValidators) bool {
 // MapValidator type defines type and function that provides validation
 // functionality.
 type MapValidator struct {
+	// AlsoRequires validates that the attributes or blocks at each of the
+	// Paths are also configured when this value is configured.
+	AlsoRequires *PathRelationshipValidator `json:"also_requires,omitempty"`
+
+	// AtLeastOneOf validates that at least one of this value and the
+	// attributes or blocks at each of the Paths are configured.
+	AtLeastOneOf *PathRelationshipValidator `json:"at_least_one_of,omitempty"`
+
+	// ConflictsWith validates that none of the attributes or blocks at each
+	// of the Paths are configured when this value is configured.
+	ConflictsWith *PathRelationshipValidator `json:"conflicts_with,omitempty"`
+
 	Custom *CustomValidator `json:"custom,omitempty"`
+
+	// ExactlyOneOf validates that exactly one of this value and the
+	// attributes or blocks at each of the Paths are configured.
+	ExactlyOneOf *PathRelationshipValidator `json:"exactly_one_of,omitempty"`
+
+	// KeysMatch validates that each of the map keys satisfies the
+	// nested string Validators.
+	KeysMatch *MapKeysMatchValidator `json:"keys_match,omitempty"`
+
+	// SizeAtLeast validates that the map has at least Min elements.
+	SizeAtLeast *MapSizeAtLeastValidator `json:"size_at_least,omitempty"`
+
+	// SizeAtMost validates that the map has at most Max elements.
+	SizeAtMost *MapSizeAtMostValidator `json:"size_at_most,omitempty"`
+
+	// SizeBetween validates that the map has between Min and Max elements.
+	SizeBetween *MapSizeBetweenValidator `json:"size_between,omitempty"`
+
+	// ValuesMatch validates that each of the map values satisfies the
+	// nested validators for the map element type.
+	ValuesMatch *MapValuesMatchValidator `json:"values_match,omitempty"`
 }
 
 // Equal returns true if the fields of the given MapValidator equal.
 func (v MapValidator) Equal(other MapValidator) bool {
-	return v.Custom.Equal(other.Custom)
+	if !v.AlsoRequires.Equal(other.AlsoRequires) {
+		return false
+	}
+
+	if !v.AtLeastOneOf.Equal(other.AtLeastOneOf) {
+		return false
+	}
+
+	if !v.ConflictsWith.Equal(other.ConflictsWith) {
+		return false
+	}
+
+	if !v.ExactlyOneOf.Equal(other.ExactlyOneOf) {
+		return false
+	}
+
+	if !v.Custom.Equal(other.Custom) {
+		return false
+	}
+
+	if !v.KeysMatch.Equal(other.KeysMatch) {
+		return false
+	}
+
+	if !v.SizeAtLeast.Equal(other.SizeAtLeast) {
+		return false
+	}
+
+	if !v.SizeAtMost.Equal(other.SizeAtMost) {
+		return false
+	}
+
+	if !v.SizeBetween.Equal(other.SizeBetween) {
+		return false
+	}
+
+	return v.ValuesMatch.Equal(other.ValuesMatch)
+}
+
+// MapKeysMatchValidator defines a validator for map keys.
+type MapKeysMatchValidator struct {
+	// Validators defines the validators for each of the map keys.
+	Validators StringValidators `json:"validators"`
+}
+
+// Equal returns true if all fields of the given MapKeysMatchValidator are equal.
+func (v *MapKeysMatchValidator) Equal(other *MapKeysMatchValidator) bool {
+	if v == nil && other == nil {
+		return true
+	}
+
+	if v == nil || other == nil {
+		return false
+	}
+
+	return v.Validators.Equal(other.Validators)
+}
+
+// customValidators returns CustomValidator for each of the Validators.
+func (v *MapKeysMatchValidator) customValidators() CustomValidators {
+	if v == nil {
+		return nil
+	}
+
+	return v.Validators.CustomValidators()
+}
+
+// MapSizeAtLeastValidator defines a validator for a minimum number of
+// map elements.
+type MapSizeAtLeastValidator struct {
+	// Min defines the minimum number of elements.
+	Min int64 `json:"min"`
+}
+
+// Equal returns true if all fields of the given MapSizeAtLeastValidator are equal.
+func (v *MapSizeAtLeastValidator) Equal(other *MapSizeAtLeastValidator) bool {
+	if v == nil && other == nil {
+		return true
+	}
+
+	if v == nil || other == nil {
+		return false
+	}
+
+	return v.Min == other.Min
+}
+
+// MapSizeAtMostValidator defines a validator for a maximum number of
+// map elements.
+type MapSizeAtMostValidator struct {
+	// Max defines the maximum number of elements.
+	Max int64 `json:"max"`
+}
+
+// Equal returns true if all fields of the given MapSizeAtMostValidator are equal.
+func (v *MapSizeAtMostValidator) Equal(other *MapSizeAtMostValidator) bool {
+	if v == nil && other == nil {
+		return true
+	}
+
+	if v == nil || other == nil {
+		return false
+	}
+
+	return v.Max == other.Max
+}
+
+// MapSizeBetweenValidator defines a validator for a minimum and maximum
+// number of map elements.
+type MapSizeBetweenValidator struct {
+	// Max defines the maximum number of elements.
+	Max int64 `json:"max"`
+
+	// Min defines the minimum number of elements.
+	Min int64 `json:"min"`
+}
+
+// Equal returns true if all fields of the given MapSizeBetweenValidator are equal.
+func (v *MapSizeBetweenValidator) Equal(other *MapSizeBetweenValidator) bool {
+	if v == nil && other == nil {
+		return true
+	}
+
+	if v == nil || other == nil {
+		return false
+	}
+
+	return v.Max == other.Max && v.Min == other.Min
+}
+
+// MapValuesMatchValidator defines a validator for map values. Only the
+// validators for the map element type are expected to be set.
+type MapValuesMatchValidator struct {
+	Bool    BoolValidators    `json:"bool,omitempty"`
+	Float64 Float64Validators `json:"float64,omitempty"`
+	Int64   Int64Validators   `json:"int64,omitempty"`
+	List    ListValidators    `json:"list,omitempty"`
+	Map     MapValidators     `json:"map,omitempty"`
+	Number  NumberValidators  `json:"number,omitempty"`
+	Object  ObjectValidators  `json:"object,omitempty"`
+	Set     SetValidators     `json:"set,omitempty"`
+	String  StringValidators  `json:"string,omitempty"`
+}
+
+// Equal returns true if all fields of the given MapValuesMatchValidator are equal.
+func (v *MapValuesMatchValidator) Equal(other *MapValuesMatchValidator) bool {
+	if v == nil && other == nil {
+		return true
+	}
+
+	if v == nil || other == nil {
+		return false
+	}
+
+	if !v.Bool.Equal(other.Bool) {
+		return false
+	}
+
+	if !v.Float64.Equal(other.Float64) {
+		return false
+	}
+
+	if !v.Int64.Equal(other.Int64) {
+		return false
+	}
+
+	if !v.List.Equal(other.List) {
+		return false
+	}
+
+	if !v.Map.Equal(other.Map) {
+		return false
+	}
+
+	if !v.Number.Equal(other.Number) {
+		return false
+	}
+
+	if !v.Object.Equal(other.Object) {
+		return false
+	}
+
+	if !v.Set.Equal(other.Set) {
+		return false
+	}
+
+	return v.String.Equal(other.String)
+}
+
+// customValidators returns CustomValidator for each of the validators,
+// including those nested within the KeysMatch and ValuesMatch validators of
+// the Map validators.
+func (v *MapValuesMatchValidator) customValidators() CustomValidators {
+	if v == nil {
+		return nil
+	}
+
+	var customValidators CustomValidators
+
+	customValidators = append(customValidators, v.Bool.CustomValidators()...)
+	customValidators = append(customValidators, v.Float64.CustomValidators()...)
+	customValidators = append(customValidators, v.Int64.CustomValidators()...)
+	customValidators = append(customValidators, v.List.CustomValidators()...)
+	customValidators = append(customValidators, v.Map.AllCustomValidators()...)
+	customValidators = append(customValidators, v.Number.CustomValidators()...)
+	customValidators = append(customValidators, v.Object.CustomValidators()...)
+	customValidators = append(customValidators, v.Set.CustomValidators()...)
+	customValidators = append(customValidators, v.String.CustomValidators()...)
+
+	return customValidators
 }
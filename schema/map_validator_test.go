@@ -11,6 +11,62 @@ import (
 	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 )
 
+func TestMapValidators_AllCustomValidators(t *testing.T) {
+	t.Parallel()
+
+	validators := schema.MapValidators{
+		{
+			Custom: &schema.CustomValidator{
+				SchemaDefinition: "mapvalidator.Validate()",
+			},
+		},
+		{
+			KeysMatch: &schema.MapKeysMatchValidator{
+				Validators: schema.StringValidators{
+					{
+						Custom: &schema.CustomValidator{
+							SchemaDefinition: "keyvalidator.Validate()",
+						},
+					},
+				},
+			},
+			ValuesMatch: &schema.MapValuesMatchValidator{
+				Map: schema.MapValidators{
+					{
+						ValuesMatch: &schema.MapValuesMatchValidator{
+							Int64: schema.Int64Validators{
+								{
+									Custom: &schema.CustomValidator{
+										SchemaDefinition: "valuevalidator.Validate()",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expected := schema.CustomValidators{
+		{
+			SchemaDefinition: "mapvalidator.Validate()",
+		},
+		{
+			SchemaDefinition: "keyvalidator.Validate()",
+		},
+		{
+			SchemaDefinition: "valuevalidator.Validate()",
+		},
+	}
+
+	got := validators.AllCustomValidators()
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
 func TestMapValidators_Equal(t *testing.T) {
 	t.Parallel()
 
@@ -121,6 +177,185 @@ func TestMapValidators_Equal(t *testing.T) {
 			},
 			expected: true,
 		},
+		"validators_size_between_different_order": {
+			validators: schema.MapValidators{
+				{
+					SizeBetween: &schema.MapSizeBetweenValidator{
+						Min: 1,
+						Max: 10,
+					},
+				},
+				{
+					Custom: &schema.CustomValidator{
+						SchemaDefinition: "one",
+					},
+				},
+			},
+			other: schema.MapValidators{
+				{
+					Custom: &schema.CustomValidator{
+						SchemaDefinition: "one",
+					},
+				},
+				{
+					SizeBetween: &schema.MapSizeBetweenValidator{
+						Min: 1,
+						Max: 10,
+					},
+				},
+			},
+			expected: true,
+		},
+		"validators_size_at_least_min_different": {
+			validators: schema.MapValidators{
+				{
+					SizeAtLeast: &schema.MapSizeAtLeastValidator{
+						Min: 1,
+					},
+				},
+			},
+			other: schema.MapValidators{
+				{
+					SizeAtLeast: &schema.MapSizeAtLeastValidator{
+						Min: 2,
+					},
+				},
+			},
+			expected: false,
+		},
+		"validators_size_at_least_size_at_most": {
+			validators: schema.MapValidators{
+				{
+					SizeAtLeast: &schema.MapSizeAtLeastValidator{
+						Min: 1,
+					},
+				},
+			},
+			other: schema.MapValidators{
+				{
+					SizeAtMost: &schema.MapSizeAtMostValidator{
+						Max: 1,
+					},
+				},
+			},
+			expected: false,
+		},
+		"validators_keys_match": {
+			validators: schema.MapValidators{
+				{
+					KeysMatch: &schema.MapKeysMatchValidator{
+						Validators: schema.StringValidators{
+							{
+								LengthAtLeast: &schema.StringLengthAtLeastValidator{
+									Min: 1,
+								},
+							},
+						},
+					},
+				},
+			},
+			other: schema.MapValidators{
+				{
+					KeysMatch: &schema.MapKeysMatchValidator{
+						Validators: schema.StringValidators{
+							{
+								LengthAtLeast: &schema.StringLengthAtLeastValidator{
+									Min: 1,
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: true,
+		},
+		"validators_keys_match_different": {
+			validators: schema.MapValidators{
+				{
+					KeysMatch: &schema.MapKeysMatchValidator{
+						Validators: schema.StringValidators{
+							{
+								LengthAtLeast: &schema.StringLengthAtLeastValidator{
+									Min: 1,
+								},
+							},
+						},
+					},
+				},
+			},
+			other: schema.MapValidators{
+				{
+					KeysMatch: &schema.MapKeysMatchValidator{
+						Validators: schema.StringValidators{
+							{
+								LengthAtLeast: &schema.StringLengthAtLeastValidator{
+									Min: 2,
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: false,
+		},
+		"validators_values_match": {
+			validators: schema.MapValidators{
+				{
+					ValuesMatch: &schema.MapValuesMatchValidator{
+						Int64: schema.Int64Validators{
+							{
+								AtLeast: &schema.Int64AtLeastValidator{
+									Min: 0,
+								},
+							},
+						},
+					},
+				},
+			},
+			other: schema.MapValidators{
+				{
+					ValuesMatch: &schema.MapValuesMatchValidator{
+						Int64: schema.Int64Validators{
+							{
+								AtLeast: &schema.Int64AtLeastValidator{
+									Min: 0,
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: true,
+		},
+		"validators_values_match_element_type_different": {
+			validators: schema.MapValidators{
+				{
+					ValuesMatch: &schema.MapValuesMatchValidator{
+						Int64: schema.Int64Validators{
+							{
+								AtLeast: &schema.Int64AtLeastValidator{
+									Min: 0,
+								},
+							},
+						},
+					},
+				},
+			},
+			other: schema.MapValidators{
+				{
+					ValuesMatch: &schema.MapValuesMatchValidator{
+						Float64: schema.Float64Validators{
+							{
+								AtLeast: &schema.Float64AtLeastValidator{
+									Min: 0,
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: false,
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -3,6 +3,18 @@
 
 package schema
 
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/diag"
+)
+
 // NumberValidators type defines NumberValidator types
 type NumberValidators []NumberValidator
 
@@ -23,6 +35,18 @@ func (v NumberValidators) CustomValidators() CustomValidators {
 	return customValidators
 }
 
+// PathRelationships returns a PathRelationship for each of the paths of the
+// path-relationship validators of each NumberValidator.
+func (v NumberValidators) PathRelationships() PathRelationships {
+	var relationships PathRelationships
+
+	for _, validator := range v {
+		relationships = append(relationships, pathRelationships(validator.AlsoRequires, validator.AtLeastOneOf, validator.ConflictsWith, validator.ExactlyOneOf)...)
+	}
+
+	return relationships
+}
+
 // Equal returns true if the given NumberValidators is the same
 // length, and each of the NumberValidator entries is equal.
 func (v NumberValidators) Equal(other NumberValidators) bool {
@@ -38,20 +62,25 @@ func (v NumberValidators) Equal(other NumberValidators) bool {
 		return false
 	}
 
-	validators := v.CustomValidators()
+	// Validators are unordered, so each validator is matched against
+	// any equal, and not already matched, validator in other.
+	matched := make([]bool, len(other))
 
-	otherValidators := other.CustomValidators()
+	for _, validator := range v {
+		found := false
 
-	if len(validators) != len(otherValidators) {
-		return false
-	}
+		for k, otherValidator := range other {
+			if matched[k] || !validator.Equal(otherValidator) {
+				continue
+			}
 
-	validators.Sort()
+			matched[k] = true
+			found = true
 
-	otherValidators.Sort()
+			break
+		}
 
-	for k, validator := range validators {
-		if !validator.Equal(otherValidators[k]) {
+		if !found {
 			return false
 		}
 	}
@@ -59,13 +88,278 @@ func (v NumberValidators) Equal(other NumberValidators) bool {
 	return true
 }
 
+// NumberValidatorsValidateRequest defines the Path of the attribute for the
+// NumberValidators being validated.
+type NumberValidatorsValidateRequest struct {
+	// Path defines the location of the attribute, such as
+	// `resource "example" attribute "number"`.
+	Path Path
+}
+
+// Validate checks that the bounds and values of the AtLeast, AtMost, Between
+// and OneOf validators are valid numbers, and that the bounds of the AtLeast,
+// AtMost and Between validators are not inverted.
+func (v NumberValidators) Validate(ctx context.Context, req NumberValidatorsValidateRequest) error {
+	var errs []error
+
+	for k, validator := range v {
+		pointer := diag.Pointer(req.Path.JSONPointer(), "number", "validators", k)
+
+		for _, value := range validator.numbers() {
+			if _, ok := parseNumber(value.number); !ok {
+				errs = append(errs, diag.NewErrorDiagnostic(req.Path.String(), pointer, "Invalid validator value", fmt.Sprintf("%s %q is not a valid number", value.description, value.number)))
+			}
+		}
+
+		if validator.Between != nil && numberGreater(validator.Between.Min, validator.Between.Max) {
+			errs = append(errs, diag.NewErrorDiagnostic(req.Path.String(), pointer, "Invalid validator bounds", fmt.Sprintf("between validator min %s is greater than max %s", validator.Between.Min, validator.Between.Max)))
+		}
+
+		if validator.AtLeast == nil {
+			continue
+		}
+
+		for _, otherValidator := range v {
+			if otherValidator.AtMost != nil && numberGreater(validator.AtLeast.Min, otherValidator.AtMost.Max) {
+				errs = append(errs, diag.NewErrorDiagnostic(req.Path.String(), pointer, "Invalid validator bounds", fmt.Sprintf("at_least validator min %s is greater than at_most validator max %s", validator.AtLeast.Min, otherValidator.AtMost.Max)))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// numberValue defines a number within a NumberValidator, along with a
+// description of where the number is defined, such as "at_least validator
+// min".
+type numberValue struct {
+	description string
+	number      json.Number
+}
+
+// numbers returns each of the bounds and values of the AtLeast, AtMost,
+// Between and OneOf validators.
+func (v NumberValidator) numbers() []numberValue {
+	var numbers []numberValue
+
+	if v.AtLeast != nil {
+		numbers = append(numbers, numberValue{"at_least validator min", v.AtLeast.Min})
+	}
+
+	if v.AtMost != nil {
+		numbers = append(numbers, numberValue{"at_most validator max", v.AtMost.Max})
+	}
+
+	if v.Between != nil {
+		numbers = append(numbers, numberValue{"between validator min", v.Between.Min}, numberValue{"between validator max", v.Between.Max})
+	}
+
+	if v.OneOf != nil {
+		for _, value := range v.OneOf.Values {
+			numbers = append(numbers, numberValue{"one_of validator value", value})
+		}
+	}
+
+	return numbers
+}
+
+// parseNumber returns the given number with the 512 bits of precision
+// supported by number attributes, or false if the number is not valid.
+func parseNumber(number json.Number) (*big.Float, bool) {
+	f, _, err := big.ParseFloat(number.String(), 10, 512, big.ToNearestEven)
+
+	return f, err == nil
+}
+
+// compareNumbers returns -1, 0 or +1 depending on whether a is less than,
+// equal to or greater than b. Numbers which are not valid, which are reported
+// by Validate, are compared as strings.
+func compareNumbers(a, b json.Number) int {
+	x, xOk := parseNumber(a)
+	y, yOk := parseNumber(b)
+
+	if !xOk || !yOk {
+		return strings.Compare(a.String(), b.String())
+	}
+
+	return x.Cmp(y)
+}
+
+// numberGreater returns true if a and b are both valid numbers, and a is
+// greater than b.
+func numberGreater(a, b json.Number) bool {
+	x, xOk := parseNumber(a)
+	y, yOk := parseNumber(b)
+
+	return xOk && yOk && x.Cmp(y) > 0
+}
+
 // NumberValidator type defines type and function that provides validation
 // functionality.
 type NumberValidator struct {
+	// AlsoRequires validates that the attributes or blocks at each of the
+	// Paths are also configured when this value is configured.
+	AlsoRequires *PathRelationshipValidator `json:"also_requires,omitempty"`
+
+	// AtLeast validates that the value is at least Min.
+	AtLeast *NumberAtLeastValidator `json:"at_least,omitempty"`
+
+	// AtLeastOneOf validates that at least one of this value and the
+	// attributes or blocks at each of the Paths are configured.
+	AtLeastOneOf *PathRelationshipValidator `json:"at_least_one_of,omitempty"`
+
+	// AtMost validates that the value is at most Max.
+	AtMost *NumberAtMostValidator `json:"at_most,omitempty"`
+
+	// Between validates that the value is between Min and Max, inclusive.
+	Between *NumberBetweenValidator `json:"between,omitempty"`
+
+	// ConflictsWith validates that none of the attributes or blocks at each
+	// of the Paths are configured when this value is configured.
+	ConflictsWith *PathRelationshipValidator `json:"conflicts_with,omitempty"`
+
 	Custom *CustomValidator `json:"custom,omitempty"`
+
+	// ExactlyOneOf validates that exactly one of this value and the
+	// attributes or blocks at each of the Paths are configured.
+	ExactlyOneOf *PathRelationshipValidator `json:"exactly_one_of,omitempty"`
+
+	// OneOf validates that the value matches one of the Values.
+	OneOf *NumberOneOfValidator `json:"one_of,omitempty"`
 }
 
 // Equal returns true if the fields of the given NumberValidator equal.
 func (v NumberValidator) Equal(other NumberValidator) bool {
-	return v.Custom.Equal(other.Custom)
+	if !v.AlsoRequires.Equal(other.AlsoRequires) {
+		return false
+	}
+
+	if !v.AtLeastOneOf.Equal(other.AtLeastOneOf) {
+		return false
+	}
+
+	if !v.ConflictsWith.Equal(other.ConflictsWith) {
+		return false
+	}
+
+	if !v.ExactlyOneOf.Equal(other.ExactlyOneOf) {
+		return false
+	}
+
+	if !v.AtLeast.Equal(other.AtLeast) {
+		return false
+	}
+
+	if !v.AtMost.Equal(other.AtMost) {
+		return false
+	}
+
+	if !v.Between.Equal(other.Between) {
+		return false
+	}
+
+	if !v.Custom.Equal(other.Custom) {
+		return false
+	}
+
+	return v.OneOf.Equal(other.OneOf)
+}
+
+// NumberAtLeastValidator defines a validator for a minimum number value.
+type NumberAtLeastValidator struct {
+	// Min defines the minimum value, which is a number rather than a
+	// float64 so that the precision of number attributes is preserved.
+	Min json.Number `json:"min"`
+}
+
+// Equal returns true if all fields of the given NumberAtLeastValidator are
+// equal, where numbers are compared by value, so 1 is equal to 1.0.
+func (v *NumberAtLeastValidator) Equal(other *NumberAtLeastValidator) bool {
+	if v == nil && other == nil {
+		return true
+	}
+
+	if v == nil || other == nil {
+		return false
+	}
+
+	return compareNumbers(v.Min, other.Min) == 0
+}
+
+// NumberAtMostValidator defines a validator for a maximum number value.
+type NumberAtMostValidator struct {
+	// Max defines the maximum value, which is a number rather than a
+	// float64 so that the precision of number attributes is preserved.
+	Max json.Number `json:"max"`
+}
+
+// Equal returns true if all fields of the given NumberAtMostValidator are
+// equal, where numbers are compared by value, so 1 is equal to 1.0.
+func (v *NumberAtMostValidator) Equal(other *NumberAtMostValidator) bool {
+	if v == nil && other == nil {
+		return true
+	}
+
+	if v == nil || other == nil {
+		return false
+	}
+
+	return compareNumbers(v.Max, other.Max) == 0
+}
+
+// NumberBetweenValidator defines a validator for a minimum and maximum
+// number value.
+type NumberBetweenValidator struct {
+	// Max defines the maximum value.
+	Max json.Number `json:"max"`
+
+	// Min defines the minimum value.
+	Min json.Number `json:"min"`
+}
+
+// Equal returns true if all fields of the given NumberBetweenValidator are
+// equal, where numbers are compared by value, so 1 is equal to 1.0.
+func (v *NumberBetweenValidator) Equal(other *NumberBetweenValidator) bool {
+	if v == nil && other == nil {
+		return true
+	}
+
+	if v == nil || other == nil {
+		return false
+	}
+
+	return compareNumbers(v.Max, other.Max) == 0 && compareNumbers(v.Min, other.Min) == 0
+}
+
+// NumberOneOfValidator defines a validator for number values which are
+// permitted.
+type NumberOneOfValidator struct {
+	// Values defines the values which are permitted.
+	Values []json.Number `json:"values"`
+}
+
+// Equal returns true if the given NumberOneOfValidator has the same
+// Values, irrespective of order, where numbers are compared by value.
+func (v *NumberOneOfValidator) Equal(other *NumberOneOfValidator) bool {
+	if v == nil && other == nil {
+		return true
+	}
+
+	if v == nil || other == nil {
+		return false
+	}
+
+	if len(v.Values) != len(other.Values) {
+		return false
+	}
+
+	a := slices.Clone(v.Values)
+	b := slices.Clone(other.Values)
+
+	slices.SortFunc(a, compareNumbers)
+	slices.SortFunc(b, compareNumbers)
+
+	return slices.EqualFunc(a, b, func(x, y json.Number) bool {
+		return compareNumbers(x, y) == 0
+	})
 }
@@ -4,6 +4,9 @@
 package schema_test
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -136,3 +139,250 @@ func TestNumberValidators_Equal(t *testing.T) {
 		})
 	}
 }
+
+func TestNumberValidator_Equal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator schema.NumberValidator
+		other     schema.NumberValidator
+		expected  bool
+	}{
+		"empty": {
+			expected: true,
+		},
+		"at_least_match": {
+			validator: schema.NumberValidator{
+				AtLeast: &schema.NumberAtLeastValidator{
+					Min: "1.5",
+				},
+			},
+			other: schema.NumberValidator{
+				AtLeast: &schema.NumberAtLeastValidator{
+					Min: "1.5",
+				},
+			},
+			expected: true,
+		},
+		"at_least_match_different_format": {
+			validator: schema.NumberValidator{
+				AtLeast: &schema.NumberAtLeastValidator{
+					Min: "1",
+				},
+			},
+			other: schema.NumberValidator{
+				AtLeast: &schema.NumberAtLeastValidator{
+					Min: "1.0",
+				},
+			},
+			expected: true,
+		},
+		"at_least_at_most": {
+			validator: schema.NumberValidator{
+				AtLeast: &schema.NumberAtLeastValidator{
+					Min: "1.5",
+				},
+			},
+			other: schema.NumberValidator{
+				AtMost: &schema.NumberAtMostValidator{
+					Max: "1.5",
+				},
+			},
+			expected: false,
+		},
+		"between_min_different": {
+			validator: schema.NumberValidator{
+				Between: &schema.NumberBetweenValidator{
+					Min: "1.5",
+					Max: "10.5",
+				},
+			},
+			other: schema.NumberValidator{
+				Between: &schema.NumberBetweenValidator{
+					Min: "2.5",
+					Max: "10.5",
+				},
+			},
+			expected: false,
+		},
+		"one_of_values_different_order": {
+			validator: schema.NumberValidator{
+				OneOf: &schema.NumberOneOfValidator{
+					Values: []json.Number{"1.5", "2.5"},
+				},
+			},
+			other: schema.NumberValidator{
+				OneOf: &schema.NumberOneOfValidator{
+					Values: []json.Number{"2.5", "1.5"},
+				},
+			},
+			expected: true,
+		},
+		"one_of_values_different_format": {
+			validator: schema.NumberValidator{
+				OneOf: &schema.NumberOneOfValidator{
+					Values: []json.Number{"1.50", "25e-1"},
+				},
+			},
+			other: schema.NumberValidator{
+				OneOf: &schema.NumberOneOfValidator{
+					Values: []json.Number{"2.5", "1.5"},
+				},
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.validator.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNumberValidators_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validators    schema.NumberValidators
+		request       schema.NumberValidatorsValidateRequest
+		expectedError error
+	}{
+		"empty": {
+			request: schema.NumberValidatorsValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+			},
+		},
+		"between": {
+			validators: schema.NumberValidators{
+				{
+					Between: &schema.NumberBetweenValidator{
+						Min: "1.5",
+						Max: "10.5",
+					},
+				},
+			},
+			request: schema.NumberValidatorsValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+			},
+		},
+		"between_inverted": {
+			validators: schema.NumberValidators{
+				{
+					Between: &schema.NumberBetweenValidator{
+						Min: "10.5",
+						Max: "1.5",
+					},
+				},
+			},
+			request: schema.NumberValidatorsValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr" between validator min 10.5 is greater than max 1.5`),
+		},
+		"between_inverted_beyond_float64_precision": {
+			validators: schema.NumberValidators{
+				{
+					Between: &schema.NumberBetweenValidator{
+						Min: "0.10000000000000000001",
+						Max: "0.1",
+					},
+				},
+			},
+			request: schema.NumberValidatorsValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr" between validator min 0.10000000000000000001 is greater than max 0.1`),
+		},
+		"invalid_numbers": {
+			validators: schema.NumberValidators{
+				{
+					AtLeast: &schema.NumberAtLeastValidator{
+						Min: "one",
+					},
+				},
+				{
+					OneOf: &schema.NumberOneOfValidator{
+						Values: []json.Number{"1", "two"},
+					},
+				},
+			},
+			request: schema.NumberValidatorsValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr" at_least validator min "one" is not a valid number` + "\n" +
+				`resource "example" attribute "attr" one_of validator value "two" is not a valid number`),
+		},
+		"at_least_at_most_inverted": {
+			validators: schema.NumberValidators{
+				{
+					AtMost: &schema.NumberAtMostValidator{
+						Max: "1.5",
+					},
+				},
+				{
+					AtLeast: &schema.NumberAtLeastValidator{
+						Min: "10.5",
+					},
+				},
+			},
+			request: schema.NumberValidatorsValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr" at_least validator min 10.5 is greater than at_most validator max 1.5`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.validators.Validate(context.Background(), testCase.request)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError.Error() {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestNumberValidator_JSONUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	var got schema.NumberValidator
+
+	err := json.Unmarshal([]byte(`{"between": {"min": 0.10000000000000000001, "max": 1e30}}`), &got)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := schema.NumberValidator{
+		Between: &schema.NumberBetweenValidator{
+			Min: "0.10000000000000000001",
+			Max: "1e30",
+		},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -6,7 +6,6 @@ package schema
 import (
 	"context"
 	"errors"
-	"fmt"
 	"sort"
 )
 
@@ -51,16 +50,18 @@ func (o ObjectAttributeTypes) Validate(ctx context.Context, req ObjectValidateRe
 
 	var errs, nestedErrs error
 
-	for _, attributeType := range o {
+	for k, attributeType := range o {
+		path := req.Path.ObjectAttributeType(attributeType.Name, k)
+
 		if _, ok := attrTypeNames[attributeType.Name]; ok {
-			errs = errors.Join(errs, fmt.Errorf("%s object attribute type %q is duplicated", req.Path, attributeType.Name))
+			errs = errors.Join(errs, path.ErrorDiagnostic("Duplicated object attribute type", "is duplicated"))
 		}
 
 		attrTypeNames[attributeType.Name] = struct{}{}
 
 		if attributeType.Object != nil {
 			objectValidateRequest := ObjectValidateRequest{
-				Path: fmt.Sprintf("%s object attribute type %q", req.Path, attributeType.Name),
+				Path: path,
 			}
 
 			err := attributeType.Object.AttributeTypes.Validate(ctx, objectValidateRequest)
@@ -230,5 +231,7 @@ func (o ObjectAttributeType) Equal(other ObjectAttributeType) bool {
 }
 
 type ObjectValidateRequest struct {
-	Path string
+	// Path defines the location of the element with the object type, such
+	// as an attribute, element type or function parameter.
+	Path Path
 }
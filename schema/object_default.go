@@ -3,10 +3,22 @@
 
 package schema
 
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/diag"
+)
+
 // ObjectDefault defines a custom type for a default object value.
 type ObjectDefault struct {
 	// Custom defines a schema definition, and optional imports.
 	Custom *CustomDefault `json:"custom,omitempty"`
+
+	// Static defines a static default object value. Each attribute must be
+	// defined in, and conform to, the AttributeTypes of the attribute. Numbers
+	// decoded from JSON are json.Number.
+	Static *map[string]any `json:"static,omitempty"`
 }
 
 // CustomDefault returns *CustomDefault.
@@ -28,5 +40,41 @@ func (d *ObjectDefault) Equal(other *ObjectDefault) bool {
 		return false
 	}
 
-	return d.Custom.Equal(other.Custom)
+	if !d.Custom.Equal(other.Custom) {
+		return false
+	}
+
+	if d.Static == nil || other.Static == nil {
+		return d.Static == nil && other.Static == nil
+	}
+
+	return staticValueEqual(*d.Static, *other.Static)
+}
+
+// ObjectDefaultValidateRequest defines the Path of the attribute, and the
+// AttributeTypes of the attribute, for the ObjectDefault being validated.
+type ObjectDefaultValidateRequest struct {
+	// Path defines the location of the attribute, such as
+	// `resource "example" attribute "object"`.
+	Path Path
+
+	// AttributeTypes defines the attribute types of the object.
+	AttributeTypes ObjectAttributeTypes
+}
+
+// Validate checks that a Static default value conforms to the AttributeTypes.
+func (d *ObjectDefault) Validate(ctx context.Context, req ObjectDefaultValidateRequest) error {
+	if d == nil || d.Static == nil {
+		return nil
+	}
+
+	return errors.Join(staticValueErrors(req.Path, diag.Pointer(req.Path.JSONPointer(), "object", "default", "static"), "default static value", staticValueType{kind: "object", attributeTypes: req.AttributeTypes}, *d.Static)...)
+}
+
+// UnmarshalJSON decodes the ObjectDefault, decoding numbers within the static
+// value as json.Number to preserve their precision.
+func (d *ObjectDefault) UnmarshalJSON(data []byte) error {
+	type objectDefault ObjectDefault
+
+	return unmarshalStaticJSON(data, (*objectDefault)(d))
 }
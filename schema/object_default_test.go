@@ -4,6 +4,8 @@
 package schema_test
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -26,6 +28,24 @@ func TestObjectDefault_Equal(t *testing.T) {
 			other:    &schema.ObjectDefault{},
 			expected: false,
 		},
+		"static_equal": {
+			objectDefault: &schema.ObjectDefault{
+				Static: pointer(map[string]any{"bool": true, "list": []any{"one"}}),
+			},
+			other: &schema.ObjectDefault{
+				Static: pointer(map[string]any{"bool": true, "list": []any{"one"}}),
+			},
+			expected: true,
+		},
+		"static_different": {
+			objectDefault: &schema.ObjectDefault{
+				Static: pointer(map[string]any{"bool": true}),
+			},
+			other: &schema.ObjectDefault{
+				Static: pointer(map[string]any{"bool": false}),
+			},
+			expected: false,
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -41,3 +61,116 @@ func TestObjectDefault_Equal(t *testing.T) {
 		})
 	}
 }
+
+func TestObjectDefault_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		objectDefault *schema.ObjectDefault
+		request       schema.ObjectDefaultValidateRequest
+		expectedError error
+	}{
+		"nil": {
+			request: schema.ObjectDefaultValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+			},
+		},
+		"static_conforms": {
+			objectDefault: &schema.ObjectDefault{
+				Static: pointer(map[string]any{
+					"dynamic": []any{"one", float64(2)},
+					"object": map[string]any{
+						"int64": float64(1),
+					},
+				}),
+			},
+			request: schema.ObjectDefaultValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+				AttributeTypes: schema.ObjectAttributeTypes{
+					{
+						Name:    "dynamic",
+						Dynamic: &schema.DynamicType{},
+					},
+					{
+						Name: "object",
+						Object: &schema.ObjectType{
+							AttributeTypes: schema.ObjectAttributeTypes{
+								{
+									Name:  "int64",
+									Int64: &schema.Int64Type{},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"static_attribute_type_not_defined": {
+			objectDefault: &schema.ObjectDefault{
+				Static: pointer(map[string]any{
+					"string": "one",
+					"other":  "two",
+				}),
+			},
+			request: schema.ObjectDefaultValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+				AttributeTypes: schema.ObjectAttributeTypes{
+					{
+						Name:   "string",
+						String: &schema.StringType{},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr" default static value attribute type "other" is not defined`),
+		},
+		"static_nested_attribute_type_not_number": {
+			objectDefault: &schema.ObjectDefault{
+				Static: pointer(map[string]any{
+					"object": map[string]any{
+						"number": "one",
+					},
+				}),
+			},
+			request: schema.ObjectDefaultValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+				AttributeTypes: schema.ObjectAttributeTypes{
+					{
+						Name: "object",
+						Object: &schema.ObjectType{
+							AttributeTypes: schema.ObjectAttributeTypes{
+								{
+									Name:   "number",
+									Number: &schema.NumberType{},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr" default static value attribute type "object" attribute type "number" is not a number`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.objectDefault.Validate(context.Background(), testCase.request)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError.Error() {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+		})
+	}
+}
@@ -0,0 +1,420 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/diag"
+)
+
+// PathStepKind defines the kind of element of a specification which a
+// PathStep refers to.
+type PathStepKind int
+
+const (
+	// PathStepKindInvalid is the zero value of PathStepKind, and is not
+	// valid.
+	PathStepKindInvalid PathStepKind = iota
+
+	// PathStepKindDataSource is a data source, at an Index within the
+	// datasources of the specification.
+	PathStepKindDataSource
+
+	// PathStepKindEphemeralResource is an ephemeral resource, at an Index
+	// within the ephemeral_resources of the specification.
+	PathStepKindEphemeralResource
+
+	// PathStepKindProvider is the provider of the specification.
+	PathStepKindProvider
+
+	// PathStepKindResource is a resource, at an Index within the resources
+	// of the specification.
+	PathStepKindResource
+
+	// PathStepKindFunction is a function, at an Index within the functions
+	// of the specification.
+	PathStepKindFunction
+
+	// PathStepKindSchema is the schema of a data source, ephemeral
+	// resource, provider or resource.
+	PathStepKindSchema
+
+	// PathStepKindIdentity is the identity schema of a resource.
+	PathStepKindIdentity
+
+	// PathStepKindStateUpgrader is a state upgrader, with a PriorVersion,
+	// at an Index within the state_upgraders of a resource schema.
+	PathStepKindStateUpgrader
+
+	// PathStepKindPriorSchema is the prior schema of a state upgrader.
+	PathStepKindPriorSchema
+
+	// PathStepKindAttribute is an attribute, at an Index within the
+	// attributes of a schema or nested object.
+	PathStepKindAttribute
+
+	// PathStepKindBlock is a block, at an Index within the blocks of a
+	// schema or nested object.
+	PathStepKindBlock
+
+	// PathStepKindNestedObject is the object containing the attributes,
+	// and any blocks, of the list_nested, map_nested, set_nested or
+	// single_nested attribute or block named by Name.
+	PathStepKindNestedObject
+
+	// PathStepKindElementType is the element type of the list, map or set
+	// named by Name.
+	PathStepKindElementType
+
+	// PathStepKindObjectAttributeType is an object attribute type, at an
+	// Index within the attribute_types of an object.
+	PathStepKindObjectAttributeType
+
+	// PathStepKindParameter is a function parameter, at an Index within
+	// the parameters of the function.
+	PathStepKindParameter
+
+	// PathStepKindVariadicParameter is the variadic parameter of a
+	// function.
+	PathStepKindVariadicParameter
+
+	// PathStepKindReturn is the return of a function.
+	PathStepKindReturn
+
+	// PathStepKindConfigValidator is a config validator, at an Index within
+	// the config_validators of a schema.
+	PathStepKindConfigValidator
+)
+
+// PathStep defines a single step of a Path.
+type PathStep struct {
+	// Kind defines the kind of element which the PathStep refers to.
+	Kind PathStepKind
+
+	// Name is the name of the element, such as the name of a resource or
+	// attribute. For PathStepKindNestedObject, Name is one of list_nested,
+	// map_nested, set_nested or single_nested. For PathStepKindElementType,
+	// Name is one of list, map or set.
+	Name string
+
+	// Index is the index of the element within its array in the
+	// specification document.
+	Index int
+
+	// PriorVersion is the prior version of a PathStepKindStateUpgrader.
+	PriorVersion int64
+}
+
+// String returns the human readable representation of the PathStep, such as
+// `attribute "example"`. The String of a PathStepKindSchema is empty.
+func (s PathStep) String() string {
+	switch s.Kind {
+	case PathStepKindDataSource:
+		return fmt.Sprintf("data source %q", s.Name)
+	case PathStepKindEphemeralResource:
+		return fmt.Sprintf("ephemeral resource %q", s.Name)
+	case PathStepKindProvider:
+		return fmt.Sprintf("provider %q", s.Name)
+	case PathStepKindResource:
+		return fmt.Sprintf("resource %q", s.Name)
+	case PathStepKindFunction:
+		return fmt.Sprintf("function %q", s.Name)
+	case PathStepKindIdentity:
+		return "identity"
+	case PathStepKindStateUpgrader:
+		return fmt.Sprintf("state upgrader prior version %d", s.PriorVersion)
+	case PathStepKindPriorSchema:
+		return "prior schema"
+	case PathStepKindAttribute:
+		return fmt.Sprintf("attribute %q", s.Name)
+	case PathStepKindBlock:
+		return fmt.Sprintf("block %q", s.Name)
+	case PathStepKindNestedObject:
+		return "nested object"
+	case PathStepKindElementType:
+		return "element type"
+	case PathStepKindObjectAttributeType:
+		return fmt.Sprintf("object attribute type %q", s.Name)
+	case PathStepKindParameter, PathStepKindVariadicParameter:
+		return fmt.Sprintf("parameter %q", s.Name)
+	case PathStepKindReturn:
+		return "return"
+	case PathStepKindConfigValidator:
+		return "config validator"
+	}
+
+	return ""
+}
+
+// jsonPointerTokens returns the reference tokens of the PathStep within the
+// specification document.
+func (s PathStep) jsonPointerTokens() []any {
+	switch s.Kind {
+	case PathStepKindDataSource:
+		return []any{"datasources", s.Index}
+	case PathStepKindEphemeralResource:
+		return []any{"ephemeral_resources", s.Index}
+	case PathStepKindProvider:
+		return []any{"provider"}
+	case PathStepKindResource:
+		return []any{"resources", s.Index}
+	case PathStepKindFunction:
+		return []any{"functions", s.Index}
+	case PathStepKindSchema:
+		return []any{"schema"}
+	case PathStepKindIdentity:
+		return []any{"identity"}
+	case PathStepKindStateUpgrader:
+		return []any{"state_upgraders", s.Index}
+	case PathStepKindPriorSchema:
+		return []any{"prior_schema"}
+	case PathStepKindAttribute:
+		return []any{"attributes", s.Index}
+	case PathStepKindBlock:
+		return []any{"blocks", s.Index}
+	case PathStepKindNestedObject:
+		if s.Name == "single_nested" {
+			return []any{s.Name}
+		}
+
+		return []any{s.Name, "nested_object"}
+	case PathStepKindElementType:
+		return []any{s.Name, "element_type"}
+	case PathStepKindObjectAttributeType:
+		return []any{"object", "attribute_types", s.Index}
+	case PathStepKindParameter:
+		return []any{"parameters", s.Index}
+	case PathStepKindVariadicParameter:
+		return []any{"variadic_parameter"}
+	case PathStepKindReturn:
+		return []any{"return"}
+	case PathStepKindConfigValidator:
+		return []any{"config_validators", s.Index}
+	}
+
+	return nil
+}
+
+// terraformPath returns the representation of the PathStep within a
+// Terraform path expression, which is empty for steps outside of a schema.
+func (s PathStep) terraformPath() string {
+	switch s.Kind {
+	case PathStepKindAttribute, PathStepKindBlock, PathStepKindObjectAttributeType:
+		return "." + s.Name
+	case PathStepKindNestedObject, PathStepKindElementType:
+		switch s.Name {
+		case "list", "list_nested":
+			return "[*]"
+		case "map", "map_nested":
+			return `["*"]`
+		case "set", "set_nested":
+			return "[Value(*)]"
+		}
+	}
+
+	return ""
+}
+
+// Path defines the location of an element within a specification, such as
+// an attribute of a resource schema. The zero value is the root of the
+// specification. Paths are immutable, and each of the methods which append
+// a step returns a new Path.
+type Path struct {
+	steps []PathStep
+}
+
+// NewPath returns a Path with the given steps.
+func NewPath(steps ...PathStep) Path {
+	return Path{
+		steps: append([]PathStep(nil), steps...),
+	}
+}
+
+// Steps returns a copy of the steps of the Path.
+func (p Path) Steps() []PathStep {
+	return append([]PathStep(nil), p.steps...)
+}
+
+// Equal returns true if the given Path has the same steps as this Path.
+func (p Path) Equal(other Path) bool {
+	if len(p.steps) != len(other.steps) {
+		return false
+	}
+
+	for k, step := range p.steps {
+		if step != other.steps[k] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// String returns the human readable representation of the Path, as used in
+// validation errors, such as `resource "example" attribute "nested"
+// attribute "id"`. A PathStepKindNestedObject step is only included when it
+// is the last step, such as `attribute "nested" nested object`.
+func (p Path) String() string {
+	var parts []string
+
+	for k, step := range p.steps {
+		if step.Kind == PathStepKindNestedObject && k != len(p.steps)-1 {
+			continue
+		}
+
+		if s := step.String(); s != "" {
+			parts = append(parts, s)
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// JSONPointer returns the RFC 6901 JSON pointer of the element within the
+// specification document, such as /resources/0/schema/attributes/1.
+func (p Path) JSONPointer() string {
+	var tokens []any
+
+	for _, step := range p.steps {
+		tokens = append(tokens, step.jsonPointerTokens()...)
+	}
+
+	return diag.Pointer("", tokens...)
+}
+
+// TerraformPath returns the Terraform path expression of the element within
+// its schema, such as list_nested[*].id. Steps outside of a schema, such as
+// the resource containing the schema, are omitted.
+func (p Path) TerraformPath() string {
+	var b strings.Builder
+
+	for _, step := range p.steps {
+		b.WriteString(step.terraformPath())
+	}
+
+	return strings.TrimPrefix(b.String(), ".")
+}
+
+// ErrorDiagnostic returns a diag.Diagnostic with diag.SeverityError for the
+// element at the Path.
+func (p Path) ErrorDiagnostic(summary, detail string) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(p.String(), p.JSONPointer(), summary, detail)
+}
+
+// WarningDiagnostic returns a diag.Diagnostic with diag.SeverityWarning for
+// the element at the Path.
+func (p Path) WarningDiagnostic(summary, detail string) diag.Diagnostic {
+	return diag.NewWarningDiagnostic(p.String(), p.JSONPointer(), summary, detail)
+}
+
+// with returns a copy of the Path with the given step appended.
+func (p Path) with(step PathStep) Path {
+	steps := make([]PathStep, len(p.steps), len(p.steps)+1)
+
+	copy(steps, p.steps)
+
+	return Path{
+		steps: append(steps, step),
+	}
+}
+
+// DataSource returns the Path with a PathStepKindDataSource step appended.
+func (p Path) DataSource(name string, index int) Path {
+	return p.with(PathStep{Kind: PathStepKindDataSource, Name: name, Index: index})
+}
+
+// EphemeralResource returns the Path with a PathStepKindEphemeralResource
+// step appended.
+func (p Path) EphemeralResource(name string, index int) Path {
+	return p.with(PathStep{Kind: PathStepKindEphemeralResource, Name: name, Index: index})
+}
+
+// Provider returns the Path with a PathStepKindProvider step appended.
+func (p Path) Provider(name string) Path {
+	return p.with(PathStep{Kind: PathStepKindProvider, Name: name})
+}
+
+// Resource returns the Path with a PathStepKindResource step appended.
+func (p Path) Resource(name string, index int) Path {
+	return p.with(PathStep{Kind: PathStepKindResource, Name: name, Index: index})
+}
+
+// Function returns the Path with a PathStepKindFunction step appended.
+func (p Path) Function(name string, index int) Path {
+	return p.with(PathStep{Kind: PathStepKindFunction, Name: name, Index: index})
+}
+
+// Schema returns the Path with a PathStepKindSchema step appended.
+func (p Path) Schema() Path {
+	return p.with(PathStep{Kind: PathStepKindSchema})
+}
+
+// Identity returns the Path with a PathStepKindIdentity step appended.
+func (p Path) Identity() Path {
+	return p.with(PathStep{Kind: PathStepKindIdentity})
+}
+
+// StateUpgrader returns the Path with a PathStepKindStateUpgrader step
+// appended.
+func (p Path) StateUpgrader(priorVersion int64, index int) Path {
+	return p.with(PathStep{Kind: PathStepKindStateUpgrader, Index: index, PriorVersion: priorVersion})
+}
+
+// PriorSchema returns the Path with a PathStepKindPriorSchema step appended.
+func (p Path) PriorSchema() Path {
+	return p.with(PathStep{Kind: PathStepKindPriorSchema})
+}
+
+// Attribute returns the Path with a PathStepKindAttribute step appended.
+func (p Path) Attribute(name string, index int) Path {
+	return p.with(PathStep{Kind: PathStepKindAttribute, Name: name, Index: index})
+}
+
+// Block returns the Path with a PathStepKindBlock step appended.
+func (p Path) Block(name string, index int) Path {
+	return p.with(PathStep{Kind: PathStepKindBlock, Name: name, Index: index})
+}
+
+// NestedObject returns the Path with a PathStepKindNestedObject step
+// appended, where nesting is one of list_nested, map_nested, set_nested or
+// single_nested.
+func (p Path) NestedObject(nesting string) Path {
+	return p.with(PathStep{Kind: PathStepKindNestedObject, Name: nesting})
+}
+
+// ElementType returns the Path with a PathStepKindElementType step appended,
+// where collection is one of list, map or set.
+func (p Path) ElementType(collection string) Path {
+	return p.with(PathStep{Kind: PathStepKindElementType, Name: collection})
+}
+
+// ObjectAttributeType returns the Path with a
+// PathStepKindObjectAttributeType step appended.
+func (p Path) ObjectAttributeType(name string, index int) Path {
+	return p.with(PathStep{Kind: PathStepKindObjectAttributeType, Name: name, Index: index})
+}
+
+// Parameter returns the Path with a PathStepKindParameter step appended.
+func (p Path) Parameter(name string, index int) Path {
+	return p.with(PathStep{Kind: PathStepKindParameter, Name: name, Index: index})
+}
+
+// VariadicParameter returns the Path with a PathStepKindVariadicParameter
+// step appended.
+func (p Path) VariadicParameter(name string) Path {
+	return p.with(PathStep{Kind: PathStepKindVariadicParameter, Name: name})
+}
+
+// Return returns the Path with a PathStepKindReturn step appended.
+func (p Path) Return() Path {
+	return p.with(PathStep{Kind: PathStepKindReturn})
+}
+
+// ConfigValidator returns the Path with a PathStepKindConfigValidator step
+// appended.
+func (p Path) ConfigValidator(index int) Path {
+	return p.with(PathStep{Kind: PathStepKindConfigValidator, Index: index})
+}
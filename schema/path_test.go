@@ -0,0 +1,184 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+func TestPath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		path                  schema.Path
+		expectedString        string
+		expectedJSONPointer   string
+		expectedTerraformPath string
+	}{
+		"root": {},
+		"resource-attribute": {
+			path:                  schema.Path{}.Resource("example", 1).Schema().Attribute("attr", 2),
+			expectedString:        `resource "example" attribute "attr"`,
+			expectedJSONPointer:   "/resources/1/schema/attributes/2",
+			expectedTerraformPath: "attr",
+		},
+		"data-source-list-nested-attribute": {
+			path:                  schema.Path{}.DataSource("example", 0).Schema().Attribute("list_nested", 1).NestedObject("list_nested").Attribute("attr", 0),
+			expectedString:        `data source "example" attribute "list_nested" attribute "attr"`,
+			expectedJSONPointer:   "/datasources/0/schema/attributes/1/list_nested/nested_object/attributes/0",
+			expectedTerraformPath: "list_nested[*].attr",
+		},
+		"provider-map-nested-attribute": {
+			path:                  schema.Path{}.Provider("example").Schema().Attribute("map_nested", 0).NestedObject("map_nested").Attribute("attr", 1),
+			expectedString:        `provider "example" attribute "map_nested" attribute "attr"`,
+			expectedJSONPointer:   "/provider/schema/attributes/0/map_nested/nested_object/attributes/1",
+			expectedTerraformPath: `map_nested["*"].attr`,
+		},
+		"list-nested-attribute-nested-object": {
+			path:                  schema.Path{}.Resource("example", 0).Schema().Attribute("list_nested", 1).NestedObject("list_nested"),
+			expectedString:        `resource "example" attribute "list_nested" nested object`,
+			expectedJSONPointer:   "/resources/0/schema/attributes/1/list_nested/nested_object",
+			expectedTerraformPath: "list_nested[*]",
+		},
+		"config-validator": {
+			path:                  schema.Path{}.Resource("example", 0).Schema().ConfigValidator(1),
+			expectedString:        `resource "example" config validator`,
+			expectedJSONPointer:   "/resources/0/schema/config_validators/1",
+			expectedTerraformPath: "",
+		},
+		"ephemeral-resource-block": {
+			path:                  schema.Path{}.EphemeralResource("example", 0).Schema().Block("set_nested", 0).NestedObject("set_nested").Block("single_nested", 1).NestedObject("single_nested").Attribute("attr", 0),
+			expectedString:        `ephemeral resource "example" block "set_nested" block "single_nested" attribute "attr"`,
+			expectedJSONPointer:   "/ephemeral_resources/0/schema/blocks/0/set_nested/nested_object/blocks/1/single_nested/attributes/0",
+			expectedTerraformPath: "set_nested[Value(*)].single_nested.attr",
+		},
+		"object-attribute-type": {
+			path:                  schema.Path{}.Attribute("list", 0).ElementType("list").ObjectAttributeType("obj", 1).ObjectAttributeType("attr", 0),
+			expectedString:        `attribute "list" element type object attribute type "obj" object attribute type "attr"`,
+			expectedJSONPointer:   "/attributes/0/list/element_type/object/attribute_types/1/object/attribute_types/0",
+			expectedTerraformPath: "list[*].obj.attr",
+		},
+		"identity-attribute": {
+			path:                  schema.Path{}.Resource("example", 0).Identity().Attribute("id", 0),
+			expectedString:        `resource "example" identity attribute "id"`,
+			expectedJSONPointer:   "/resources/0/identity/attributes/0",
+			expectedTerraformPath: "id",
+		},
+		"prior-schema-attribute": {
+			path:                  schema.Path{}.Resource("example", 0).Schema().StateUpgrader(1, 0).PriorSchema().Attribute("attr", 0),
+			expectedString:        `resource "example" state upgrader prior version 1 prior schema attribute "attr"`,
+			expectedJSONPointer:   "/resources/0/schema/state_upgraders/0/prior_schema/attributes/0",
+			expectedTerraformPath: "attr",
+		},
+		"function-parameter": {
+			path:                  schema.Path{}.Function("example", 0).Parameter("param", 1).ObjectAttributeType("attr", 0),
+			expectedString:        `function "example" parameter "param" object attribute type "attr"`,
+			expectedJSONPointer:   "/functions/0/parameters/1/object/attribute_types/0",
+			expectedTerraformPath: "attr",
+		},
+		"function-variadic-parameter": {
+			path:                  schema.Path{}.Function("example", 0).VariadicParameter("param"),
+			expectedString:        `function "example" parameter "param"`,
+			expectedJSONPointer:   "/functions/0/variadic_parameter",
+			expectedTerraformPath: "",
+		},
+		"function-return": {
+			path:                  schema.Path{}.Function("example", 0).Return().ObjectAttributeType("attr", 0),
+			expectedString:        `function "example" return object attribute type "attr"`,
+			expectedJSONPointer:   "/functions/0/return/object/attribute_types/0",
+			expectedTerraformPath: "attr",
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.path.String(); got != testCase.expectedString {
+				t.Errorf("expected String %q, got %q", testCase.expectedString, got)
+			}
+
+			if got := testCase.path.JSONPointer(); got != testCase.expectedJSONPointer {
+				t.Errorf("expected JSONPointer %q, got %q", testCase.expectedJSONPointer, got)
+			}
+
+			if got := testCase.path.TerraformPath(); got != testCase.expectedTerraformPath {
+				t.Errorf("expected TerraformPath %q, got %q", testCase.expectedTerraformPath, got)
+			}
+		})
+	}
+}
+
+func TestPath_Equal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		path     schema.Path
+		other    schema.Path
+		expected bool
+	}{
+		"root": {
+			expected: true,
+		},
+		"equal": {
+			path:     schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 1),
+			other:    schema.NewPath(schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 1).Steps()...),
+			expected: true,
+		},
+		"different-index": {
+			path:     schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 1),
+			other:    schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 2),
+			expected: false,
+		},
+		"different-kind": {
+			path:     schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 1),
+			other:    schema.Path{}.Resource("example", 0).Schema().Block("attr", 1),
+			expected: false,
+		},
+		"different-length": {
+			path:     schema.Path{}.Resource("example", 0).Schema(),
+			other:    schema.Path{}.Resource("example", 0),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.path.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestPath_Immutable(t *testing.T) {
+	t.Parallel()
+
+	parent := schema.Path{}.Resource("example", 0).Schema()
+
+	// Appending to a Path must not modify the steps of another Path which
+	// shares the same parent.
+	first := parent.Attribute("first", 0)
+	second := parent.Attribute("second", 1)
+
+	if got, expected := first.String(), `resource "example" attribute "first"`; got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+
+	if got, expected := second.String(), `resource "example" attribute "second"`; got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+
+	if got, expected := parent.String(), `resource "example"`; got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
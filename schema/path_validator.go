@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"strings"
+)
+
+// PathExpression defines a path to an attribute or block, relative to the
+// attribute or block on which the validator is declared. A PathExpression is
+// a period-separated sequence of names, for example "name" refers to a
+// sibling attribute or block, and "name.nested" refers to an attribute or
+// block nested within the sibling "name".
+type PathExpression string
+
+// Steps returns the names of each of the steps within the PathExpression.
+func (p PathExpression) Steps() []string {
+	return strings.Split(string(p), ".")
+}
+
+// PathExpressions type defines PathExpression types.
+type PathExpressions []PathExpression
+
+// PathRelationshipValidator defines a validator which references the
+// attribute or block at each of the Paths.
+type PathRelationshipValidator struct {
+	// Paths defines the attributes or blocks which are referenced.
+	Paths PathExpressions `json:"paths"`
+}
+
+// Equal returns true if the given PathRelationshipValidator has the same
+// Paths, irrespective of order.
+func (v *PathRelationshipValidator) Equal(other *PathRelationshipValidator) bool {
+	if v == nil && other == nil {
+		return true
+	}
+
+	if v == nil || other == nil {
+		return false
+	}
+
+	return valuesEqual(v.Paths, other.Paths)
+}
+
+// PathRelationship defines the name of a path-relationship validator, for
+// example "conflicts_with", and the PathExpression that it references.
+type PathRelationship struct {
+	Name string
+
+	Path PathExpression
+}
+
+// PathRelationships type defines PathRelationship types.
+type PathRelationships []PathRelationship
+
+// pathRelationships returns a PathRelationship for each of the Paths of the
+// given path-relationship validators.
+func pathRelationships(alsoRequires, atLeastOneOf, conflictsWith, exactlyOneOf *PathRelationshipValidator) PathRelationships {
+	var relationships PathRelationships
+
+	validators := []struct {
+		name      string
+		validator *PathRelationshipValidator
+	}{
+		{"also_requires", alsoRequires},
+		{"at_least_one_of", atLeastOneOf},
+		{"conflicts_with", conflictsWith},
+		{"exactly_one_of", exactlyOneOf},
+	}
+
+	for _, v := range validators {
+		if v.validator == nil {
+			continue
+		}
+
+		for _, path := range v.validator.Paths {
+			relationships = append(relationships, PathRelationship{
+				Name: v.name,
+				Path: path,
+			})
+		}
+	}
+
+	return relationships
+}
@@ -0,0 +1,135 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+func TestPathExpression_Steps(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		path     schema.PathExpression
+		expected []string
+	}{
+		"sibling": {
+			path:     "attr_one",
+			expected: []string{"attr_one"},
+		},
+		"nested": {
+			path:     "block_one.attr_one",
+			expected: []string{"block_one", "attr_one"},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.path.Steps()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestPathRelationshipValidator_Equal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator *schema.PathRelationshipValidator
+		other     *schema.PathRelationshipValidator
+		expected  bool
+	}{
+		"both_nil": {
+			expected: true,
+		},
+		"nil_other_not_nil": {
+			other:    &schema.PathRelationshipValidator{},
+			expected: false,
+		},
+		"paths_different_order": {
+			validator: &schema.PathRelationshipValidator{
+				Paths: schema.PathExpressions{"attr_one", "attr_two"},
+			},
+			other: &schema.PathRelationshipValidator{
+				Paths: schema.PathExpressions{"attr_two", "attr_one"},
+			},
+			expected: true,
+		},
+		"paths_different": {
+			validator: &schema.PathRelationshipValidator{
+				Paths: schema.PathExpressions{"attr_one"},
+			},
+			other: &schema.PathRelationshipValidator{
+				Paths: schema.PathExpressions{"attr_two"},
+			},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.validator.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringValidators_PathRelationships(t *testing.T) {
+	t.Parallel()
+
+	validators := schema.StringValidators{
+		{
+			ConflictsWith: &schema.PathRelationshipValidator{
+				Paths: schema.PathExpressions{"attr_one", "attr_two"},
+			},
+		},
+		{
+			Custom: &schema.CustomValidator{
+				SchemaDefinition: "my_validator.Validate()",
+			},
+		},
+		{
+			AlsoRequires: &schema.PathRelationshipValidator{
+				Paths: schema.PathExpressions{"block_one.attr_three"},
+			},
+		},
+	}
+
+	expected := schema.PathRelationships{
+		{
+			Name: "conflicts_with",
+			Path: "attr_one",
+		},
+		{
+			Name: "conflicts_with",
+			Path: "attr_two",
+		},
+		{
+			Name: "also_requires",
+			Path: "block_one.attr_three",
+		},
+	}
+
+	got := validators.PathRelationships()
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+// RequiresReplacePlanModifier defines a plan modifier which requires the
+// resource to be replaced when the value changes.
+type RequiresReplacePlanModifier struct{}
+
+// Equal returns true if both the given RequiresReplacePlanModifier and this
+// RequiresReplacePlanModifier are either nil or not nil.
+func (p *RequiresReplacePlanModifier) Equal(other *RequiresReplacePlanModifier) bool {
+	return (p == nil) == (other == nil)
+}
+
+// RequiresReplaceIfConfiguredPlanModifier defines a plan modifier which
+// requires the resource to be replaced when the value changes, and the value
+// is configured.
+type RequiresReplaceIfConfiguredPlanModifier struct{}
+
+// Equal returns true if both the given RequiresReplaceIfConfiguredPlanModifier
+// and this RequiresReplaceIfConfiguredPlanModifier are either nil or not nil.
+func (p *RequiresReplaceIfConfiguredPlanModifier) Equal(other *RequiresReplaceIfConfiguredPlanModifier) bool {
+	return (p == nil) == (other == nil)
+}
+
+// UseStateForUnknownPlanModifier defines a plan modifier which uses the prior
+// state value, rather than an unknown value, when the value is computed and
+// not configured.
+type UseStateForUnknownPlanModifier struct{}
+
+// Equal returns true if both the given UseStateForUnknownPlanModifier and this
+// UseStateForUnknownPlanModifier are either nil or not nil.
+func (p *UseStateForUnknownPlanModifier) Equal(other *UseStateForUnknownPlanModifier) bool {
+	return (p == nil) == (other == nil)
+}
@@ -3,10 +3,22 @@
 
 package schema
 
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/diag"
+)
+
 // SetDefault defines a custom type for a default set value.
 type SetDefault struct {
 	// Custom defines a schema definition, and optional imports.
 	Custom *CustomDefault `json:"custom,omitempty"`
+
+	// Static defines a static default set value. Each element must conform to
+	// the ElementType of the attribute. Numbers decoded from JSON are
+	// json.Number.
+	Static *[]any `json:"static,omitempty"`
 }
 
 // CustomDefault returns *CustomDefault.
@@ -28,5 +40,42 @@ func (d *SetDefault) Equal(other *SetDefault) bool {
 		return false
 	}
 
-	return d.Custom.Equal(other.Custom)
+	if !d.Custom.Equal(other.Custom) {
+		return false
+	}
+
+	if d.Static == nil || other.Static == nil {
+		return d.Static == nil && other.Static == nil
+	}
+
+	// Set elements are unordered.
+	return staticValuesEqual(*d.Static, *other.Static)
+}
+
+// SetDefaultValidateRequest defines the Path of the attribute, and the
+// ElementType of the attribute, for the SetDefault being validated.
+type SetDefaultValidateRequest struct {
+	// Path defines the location of the attribute, such as
+	// `resource "example" attribute "set"`.
+	Path Path
+
+	// ElementType defines the type for all elements of the set.
+	ElementType ElementType
+}
+
+// Validate checks that a Static default value conforms to the ElementType.
+func (d *SetDefault) Validate(ctx context.Context, req SetDefaultValidateRequest) error {
+	if d == nil || d.Static == nil {
+		return nil
+	}
+
+	return errors.Join(staticValueErrors(req.Path, diag.Pointer(req.Path.JSONPointer(), "set", "default", "static"), "default static value", staticValueType{kind: "set", elementType: &req.ElementType}, *d.Static)...)
+}
+
+// UnmarshalJSON decodes the SetDefault, decoding numbers within the static
+// value as json.Number to preserve their precision.
+func (d *SetDefault) UnmarshalJSON(data []byte) error {
+	type setDefault SetDefault
+
+	return unmarshalStaticJSON(data, (*setDefault)(d))
 }
@@ -4,6 +4,9 @@
 package schema_test
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -26,6 +29,24 @@ func TestSetDefault_Equal(t *testing.T) {
 			other:    &schema.SetDefault{},
 			expected: false,
 		},
+		"static_different_order": {
+			setDefault: &schema.SetDefault{
+				Static: pointer([]any{"one", "two"}),
+			},
+			other: &schema.SetDefault{
+				Static: pointer([]any{"two", "one"}),
+			},
+			expected: true,
+		},
+		"static_different": {
+			setDefault: &schema.SetDefault{
+				Static: pointer([]any{"one", "two"}),
+			},
+			other: &schema.SetDefault{
+				Static: pointer([]any{"one", "three"}),
+			},
+			expected: false,
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -41,3 +62,78 @@ func TestSetDefault_Equal(t *testing.T) {
 		})
 	}
 }
+
+func TestSetDefault_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		setDefault    *schema.SetDefault
+		request       schema.SetDefaultValidateRequest
+		expectedError error
+	}{
+		"nil": {
+			request: schema.SetDefaultValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+			},
+		},
+		"static_element_not_string": {
+			setDefault: &schema.SetDefault{
+				Static: pointer([]any{"one", float64(2)}),
+			},
+			request: schema.SetDefaultValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+				ElementType: schema.ElementType{
+					String: &schema.StringType{},
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr" default static value element 1 is not a string`),
+		},
+		"static_element_duplicated_json_number": {
+			setDefault: &schema.SetDefault{
+				Static: pointer([]any{json.Number("9007199254740993"), json.Number("9007199254740992"), json.Number("9007199254740993.0")}),
+			},
+			request: schema.SetDefaultValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+				ElementType: schema.ElementType{
+					Number: &schema.NumberType{},
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr" default static value element 2 is a duplicate of element 0`),
+		},
+		"static_element_duplicated": {
+			setDefault: &schema.SetDefault{
+				Static: pointer([]any{float64(1), float64(2), float64(1)}),
+			},
+			request: schema.SetDefaultValidateRequest{
+				Path: schema.Path{}.Resource("example", 0).Schema().Attribute("attr", 0),
+				ElementType: schema.ElementType{
+					Int64: &schema.Int64Type{},
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "attr" default static value element 2 is a duplicate of element 0`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.setDefault.Validate(context.Background(), testCase.request)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError.Error() {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+		})
+	}
+}
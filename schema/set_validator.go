@@ -23,6 +23,18 @@ func (v SetValidators) CustomValidators() CustomValidators {
 	return customValidators
 }
 
+// PathRelationships returns a PathRelationship for each of the paths of the
+// path-relationship validators of each SetValidator.
+func (v SetValidators) PathRelationships() PathRelationships {
+	var relationships PathRelationships
+
+	for _, validator := range v {
+		relationships = append(relationships, pathRelationships(validator.AlsoRequires, validator.AtLeastOneOf, validator.ConflictsWith, validator.ExactlyOneOf)...)
+	}
+
+	return relationships
+}
+
 // Equal returns true if the given SetValidators is the same
 // length, and each of the SetValidator entries is equal.
 func (v SetValidators) Equal(other SetValidators) bool {
@@ -38,20 +50,25 @@ func (v SetValidators) Equal(other SetValidators) bool {
 		return false
 	}
 
-	validators := v.CustomValidators()
+	// Validators are unordered, so each validator is matched against
+	// any equal, and not already matched, validator in other.
+	matched := make([]bool, len(other))
 
-	otherValidators := other.CustomValidators()
+	for _, validator := range v {
+		found := false
 
-	if len(validators) != len(otherValidators) {
-		return false
-	}
+		for k, otherValidator := range other {
+			if matched[k] || !validator.Equal(otherValidator) {
+				continue
+			}
 
-	validators.Sort()
+			matched[k] = true
+			found = true
 
-	otherValidators.Sort()
+			break
+		}
 
-	for k, validator := range validators {
-		if !validator.Equal(otherValidators[k]) {
+		if !found {
 			return false
 		}
 	}
@@ -62,10 +79,126 @@ func (v SetValidators) Equal(other SetValidators) bool {
 // SetValidator type defines type and function that provides validation
 // functionality.
 type SetValidator struct {
+	// AlsoRequires validates that the attributes or blocks at each of the
+	// Paths are also configured when this value is configured.
+	AlsoRequires *PathRelationshipValidator `json:"also_requires,omitempty"`
+
+	// AtLeastOneOf validates that at least one of this value and the
+	// attributes or blocks at each of the Paths are configured.
+	AtLeastOneOf *PathRelationshipValidator `json:"at_least_one_of,omitempty"`
+
+	// ConflictsWith validates that none of the attributes or blocks at each
+	// of the Paths are configured when this value is configured.
+	ConflictsWith *PathRelationshipValidator `json:"conflicts_with,omitempty"`
+
 	Custom *CustomValidator `json:"custom,omitempty"`
+
+	// ExactlyOneOf validates that exactly one of this value and the
+	// attributes or blocks at each of the Paths are configured.
+	ExactlyOneOf *PathRelationshipValidator `json:"exactly_one_of,omitempty"`
+
+	// SizeAtLeast validates that the set has at least Min elements.
+	SizeAtLeast *SetSizeAtLeastValidator `json:"size_at_least,omitempty"`
+
+	// SizeAtMost validates that the set has at most Max elements.
+	SizeAtMost *SetSizeAtMostValidator `json:"size_at_most,omitempty"`
+
+	// SizeBetween validates that the set has between Min and Max elements.
+	SizeBetween *SetSizeBetweenValidator `json:"size_between,omitempty"`
 }
 
 // Equal returns true if the fields of the given SetValidator equal.
 func (v SetValidator) Equal(other SetValidator) bool {
-	return v.Custom.Equal(other.Custom)
+	if !v.AlsoRequires.Equal(other.AlsoRequires) {
+		return false
+	}
+
+	if !v.AtLeastOneOf.Equal(other.AtLeastOneOf) {
+		return false
+	}
+
+	if !v.ConflictsWith.Equal(other.ConflictsWith) {
+		return false
+	}
+
+	if !v.ExactlyOneOf.Equal(other.ExactlyOneOf) {
+		return false
+	}
+
+	if !v.Custom.Equal(other.Custom) {
+		return false
+	}
+
+	if !v.SizeAtLeast.Equal(other.SizeAtLeast) {
+		return false
+	}
+
+	if !v.SizeAtMost.Equal(other.SizeAtMost) {
+		return false
+	}
+
+	return v.SizeBetween.Equal(other.SizeBetween)
+}
+
+// SetSizeAtLeastValidator defines a validator for a minimum number of
+// set elements.
+type SetSizeAtLeastValidator struct {
+	// Min defines the minimum number of elements.
+	Min int64 `json:"min"`
+}
+
+// Equal returns true if all fields of the given SetSizeAtLeastValidator are equal.
+func (v *SetSizeAtLeastValidator) Equal(other *SetSizeAtLeastValidator) bool {
+	if v == nil && other == nil {
+		return true
+	}
+
+	if v == nil || other == nil {
+		return false
+	}
+
+	return v.Min == other.Min
+}
+
+// SetSizeAtMostValidator defines a validator for a maximum number of
+// set elements.
+type SetSizeAtMostValidator struct {
+	// Max defines the maximum number of elements.
+	Max int64 `json:"max"`
+}
+
+// Equal returns true if all fields of the given SetSizeAtMostValidator are equal.
+func (v *SetSizeAtMostValidator) Equal(other *SetSizeAtMostValidator) bool {
+	if v == nil && other == nil {
+		return true
+	}
+
+	if v == nil || other == nil {
+		return false
+	}
+
+	return v.Max == other.Max
+}
+
+// SetSizeBetweenValidator defines a validator for a minimum and maximum
+// number of set elements.
+type SetSizeBetweenValidator struct {
+	// Max defines the maximum number of elements.
+	Max int64 `json:"max"`
+
+	// Min defines the minimum number of elements.
+	Min int64 `json:"min"`
+}
+
+// Equal returns true if all fields of the given SetSizeBetweenValidator are equal.
+func (v *SetSizeBetweenValidator) Equal(other *SetSizeBetweenValidator) bool {
+	if v == nil && other == nil {
+		return true
+	}
+
+	if v == nil || other == nil {
+		return false
+	}
+
+	return v.Max == other.Max && v.Min == other.Min
 }
@@ -121,6 +121,69 @@ func TestSetValidators_Equal(t *testing.T) {
 			},
 			expected: true,
 		},
+		"validators_size_between_different_order": {
+			validators: schema.SetValidators{
+				{
+					SizeBetween: &schema.SetSizeBetweenValidator{
+						Min: 1,
+						Max: 10,
+					},
+				},
+				{
+					Custom: &schema.CustomValidator{
+						SchemaDefinition: "one",
+					},
+				},
+			},
+			other: schema.SetValidators{
+				{
+					Custom: &schema.CustomValidator{
+						SchemaDefinition: "one",
+					},
+				},
+				{
+					SizeBetween: &schema.SetSizeBetweenValidator{
+						Min: 1,
+						Max: 10,
+					},
+				},
+			},
+			expected: true,
+		},
+		"validators_size_at_least_min_different": {
+			validators: schema.SetValidators{
+				{
+					SizeAtLeast: &schema.SetSizeAtLeastValidator{
+						Min: 1,
+					},
+				},
+			},
+			other: schema.SetValidators{
+				{
+					SizeAtLeast: &schema.SetSizeAtLeastValidator{
+						Min: 2,
+					},
+				},
+			},
+			expected: false,
+		},
+		"validators_size_at_least_size_at_most": {
+			validators: schema.SetValidators{
+				{
+					SizeAtLeast: &schema.SetSizeAtLeastValidator{
+						Min: 1,
+					},
+				},
+			},
+			other: schema.SetValidators{
+				{
+					SizeAtMost: &schema.SetSizeAtMostValidator{
+						Max: 1,
+					},
+				},
+			},
+			expected: false,
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -0,0 +1,367 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/diag"
+)
+
+// staticValueType defines the type which a static value, decoded from JSON,
+// must conform to.
+type staticValueType struct {
+	kind string
+
+	// elementType is set when kind is list, map or set.
+	elementType *ElementType
+
+	// attributeTypes is set when kind is object.
+	attributeTypes ObjectAttributeTypes
+}
+
+// elementStaticValueType returns the staticValueType for the given ElementType.
+func elementStaticValueType(e ElementType) staticValueType {
+	switch {
+	case e.Bool != nil:
+		return staticValueType{kind: "bool"}
+	case e.Float64 != nil:
+		return staticValueType{kind: "float64"}
+	case e.Int64 != nil:
+		return staticValueType{kind: "int64"}
+	case e.List != nil:
+		return staticValueType{kind: "list", elementType: &e.List.ElementType}
+	case e.Map != nil:
+		return staticValueType{kind: "map", elementType: &e.Map.ElementType}
+	case e.Number != nil:
+		return staticValueType{kind: "number"}
+	case e.Object != nil:
+		return staticValueType{kind: "object", attributeTypes: e.Object.AttributeTypes}
+	case e.Set != nil:
+		return staticValueType{kind: "set", elementType: &e.Set.ElementType}
+	case e.String != nil:
+		return staticValueType{kind: "string"}
+	}
+
+	return staticValueType{}
+}
+
+// objectAttributeStaticValueType returns the staticValueType for the given
+// ObjectAttributeType.
+func objectAttributeStaticValueType(o ObjectAttributeType) staticValueType {
+	switch {
+	case o.Bool != nil:
+		return staticValueType{kind: "bool"}
+	case o.Dynamic != nil:
+		return staticValueType{kind: "dynamic"}
+	case o.Float64 != nil:
+		return staticValueType{kind: "float64"}
+	case o.Int64 != nil:
+		return staticValueType{kind: "int64"}
+	case o.List != nil:
+		return staticValueType{kind: "list", elementType: &o.List.ElementType}
+	case o.Map != nil:
+		return staticValueType{kind: "map", elementType: &o.Map.ElementType}
+	case o.Number != nil:
+		return staticValueType{kind: "number"}
+	case o.Object != nil:
+		return staticValueType{kind: "object", attributeTypes: o.Object.AttributeTypes}
+	case o.Set != nil:
+		return staticValueType{kind: "set", elementType: &o.Set.ElementType}
+	case o.String != nil:
+		return staticValueType{kind: "string"}
+	}
+
+	return staticValueType{}
+}
+
+// staticValueErrors returns a diag.Diagnostic for each part of the given value,
+// decoded from JSON and located at the given path and JSON pointer, which does
+// not conform to the given staticValueType. The field describes the part of the
+// value within the attribute at the path, such as "default static value
+// element 0", and prefixes the detail of each diag.Diagnostic. A nil value
+// represents null, and conforms to any type.
+func staticValueErrors(path Path, pointer, field string, t staticValueType, value any) []error {
+	if value == nil {
+		return nil
+	}
+
+	switch t.kind {
+	case "bool":
+		if _, ok := value.(bool); !ok {
+			return []error{staticValueErrorDiagnostic(path, pointer, field, "is not a bool")}
+		}
+	case "float64":
+		if !isStaticFloat64(value) {
+			return []error{staticValueErrorDiagnostic(path, pointer, field, "is not a float64")}
+		}
+	case "int64":
+		if !isStaticInt64(value) {
+			return []error{staticValueErrorDiagnostic(path, pointer, field, "is not an int64")}
+		}
+	case "number":
+		if _, ok := staticNumber(value); !ok {
+			return []error{staticValueErrorDiagnostic(path, pointer, field, "is not a number")}
+		}
+	case "string":
+		if _, ok := value.(string); !ok {
+			return []error{staticValueErrorDiagnostic(path, pointer, field, "is not a string")}
+		}
+	case "list", "set":
+		elements, ok := value.([]any)
+
+		if !ok {
+			return []error{staticValueErrorDiagnostic(path, pointer, field, fmt.Sprintf("is not a %s", t.kind))}
+		}
+
+		var errs []error
+
+		for k, element := range elements {
+			elementField := fmt.Sprintf("%s element %d", field, k)
+
+			errs = append(errs, staticValueErrors(path, diag.Pointer(pointer, k), elementField, elementStaticValueType(*t.elementType), element)...)
+
+			if t.kind != "set" {
+				continue
+			}
+
+			// Set elements are unique.
+			for otherK, other := range elements[:k] {
+				if staticValueEqual(element, other) {
+					errs = append(errs, staticValueErrorDiagnostic(path, diag.Pointer(pointer, k), elementField, fmt.Sprintf("is a duplicate of element %d", otherK)))
+
+					break
+				}
+			}
+		}
+
+		return errs
+	case "map":
+		elements, ok := value.(map[string]any)
+
+		if !ok {
+			return []error{staticValueErrorDiagnostic(path, pointer, field, "is not a map")}
+		}
+
+		var errs []error
+
+		for _, key := range sortedKeys(elements) {
+			errs = append(errs, staticValueErrors(path, diag.Pointer(pointer, key), fmt.Sprintf("%s key %q", field, key), elementStaticValueType(*t.elementType), elements[key])...)
+		}
+
+		return errs
+	case "object":
+		attributes, ok := value.(map[string]any)
+
+		if !ok {
+			return []error{staticValueErrorDiagnostic(path, pointer, field, "is not an object")}
+		}
+
+		return staticObjectValueErrors(path, pointer, field, t.attributeTypes, attributes)
+	}
+
+	return nil
+}
+
+// staticObjectValueErrors returns a diag.Diagnostic for each attribute of the
+// given object value which is not defined in, or does not conform to, the
+// given ObjectAttributeTypes. Attributes which are absent are treated as null.
+func staticObjectValueErrors(path Path, pointer, field string, attributeTypes ObjectAttributeTypes, value map[string]any) []error {
+	var errs []error
+
+	for _, name := range sortedKeys(value) {
+		found := false
+		attributeField := fmt.Sprintf("%s attribute type %q", field, name)
+
+		for _, attributeType := range attributeTypes {
+			if attributeType.Name != name {
+				continue
+			}
+
+			found = true
+
+			errs = append(errs, staticValueErrors(path, diag.Pointer(pointer, name), attributeField, objectAttributeStaticValueType(attributeType), value[name])...)
+
+			break
+		}
+
+		if !found {
+			errs = append(errs, staticValueErrorDiagnostic(path, diag.Pointer(pointer, name), attributeField, "is not defined"))
+		}
+	}
+
+	return errs
+}
+
+// staticValueErrorDiagnostic returns a diag.Diagnostic for the part of a static
+// value described by field, within the attribute at the given path.
+func staticValueErrorDiagnostic(path Path, pointer, field, detail string) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(path.String(), pointer, "Invalid default value", field+" "+detail)
+}
+
+// isStaticFloat64 returns true if the given value is a number within the
+// range of float64.
+func isStaticFloat64(value any) bool {
+	switch v := value.(type) {
+	case float64:
+		return true
+	case json.Number:
+		_, err := strconv.ParseFloat(v.String(), 64)
+
+		return err == nil
+	}
+
+	return false
+}
+
+// isStaticInt64 returns true if the given value is a whole number within the
+// range of int64.
+func isStaticInt64(value any) bool {
+	switch v := value.(type) {
+	case float64:
+		return v == math.Trunc(v) && v >= math.MinInt64 && v < math.MaxInt64
+	case json.Number:
+		f, ok := staticNumber(v)
+
+		if !ok || !f.IsInt() {
+			return false
+		}
+
+		_, accuracy := f.Int64()
+
+		return accuracy == big.Exact
+	}
+
+	return false
+}
+
+// staticNumber returns the given value as a *big.Float with 512 bits of
+// precision, the precision of the number type, if the value is a number.
+// Numbers decoded from JSON are json.Number, while numbers within values
+// which are constructed rather than decoded may be float64.
+func staticNumber(value any) (*big.Float, bool) {
+	switch v := value.(type) {
+	case float64:
+		if math.IsNaN(v) {
+			return nil, false
+		}
+
+		return new(big.Float).SetPrec(512).SetFloat64(v), true
+	case json.Number:
+		f, _, err := big.ParseFloat(v.String(), 10, 512, big.ToNearestEven)
+
+		return f, err == nil
+	}
+
+	return nil, false
+}
+
+// staticValueEqual returns true if the given values are equal. Numbers are
+// compared by value, irrespective of whether they are float64 or json.Number
+// and of how they are formatted.
+func staticValueEqual(value, other any) bool {
+	if valueNumber, ok := staticNumber(value); ok {
+		otherNumber, ok := staticNumber(other)
+
+		return ok && valueNumber.Cmp(otherNumber) == 0
+	}
+
+	switch v := value.(type) {
+	case []any:
+		o, ok := other.([]any)
+
+		if !ok || len(v) != len(o) {
+			return false
+		}
+
+		for k := range v {
+			if !staticValueEqual(v[k], o[k]) {
+				return false
+			}
+		}
+
+		return true
+	case map[string]any:
+		o, ok := other.(map[string]any)
+
+		if !ok || len(v) != len(o) {
+			return false
+		}
+
+		for key, element := range v {
+			otherElement, ok := o[key]
+
+			if !ok || !staticValueEqual(element, otherElement) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return reflect.DeepEqual(value, other)
+}
+
+// unmarshalStaticJSON decodes the given JSON into v, decoding numbers as
+// json.Number so that numbers within static values are not limited to the
+// precision of float64.
+func unmarshalStaticJSON(data []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+
+	decoder.UseNumber()
+
+	return decoder.Decode(v)
+}
+
+// sortedKeys returns the keys of the given map in sorted order, so that
+// errors are returned deterministically.
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// staticValuesEqual returns true if the given slices contain equal values,
+// irrespective of order.
+func staticValuesEqual(values, other []any) bool {
+	if len(values) != len(other) {
+		return false
+	}
+
+	matched := make([]bool, len(other))
+
+	for _, value := range values {
+		found := false
+
+		for k, otherValue := range other {
+			if matched[k] || !staticValueEqual(value, otherValue) {
+				continue
+			}
+
+			matched[k] = true
+			found = true
+
+			break
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
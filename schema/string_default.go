@@ -12,6 +12,15 @@ type StringDefault struct {
 	Static *string `json:"static,omitempty"`
 }
 
+// CustomDefault returns *CustomDefault.
+func (d *StringDefault) CustomDefault() *CustomDefault {
+	if d == nil {
+		return nil
+	}
+
+	return d.Custom
+}
+
 // Equal returns true if all fields of the given StringDefault are equal.
 func (d *StringDefault) Equal(other *StringDefault) bool {
 	if d == nil && other == nil {
@@ -121,6 +121,38 @@ func TestStringPlanModifiers_Equal(t *testing.T) {
 			},
 			expected: true,
 		},
+		"plan_modifiers_built_in_different_order": {
+			planModifiers: schema.StringPlanModifiers{
+				{
+					RequiresReplace: &schema.RequiresReplacePlanModifier{},
+				},
+				{
+					UseStateForUnknown: &schema.UseStateForUnknownPlanModifier{},
+				},
+			},
+			other: schema.StringPlanModifiers{
+				{
+					UseStateForUnknown: &schema.UseStateForUnknownPlanModifier{},
+				},
+				{
+					RequiresReplace: &schema.RequiresReplacePlanModifier{},
+				},
+			},
+			expected: true,
+		},
+		"plan_modifiers_built_in_different": {
+			planModifiers: schema.StringPlanModifiers{
+				{
+					RequiresReplace: &schema.RequiresReplacePlanModifier{},
+				},
+			},
+			other: schema.StringPlanModifiers{
+				{
+					RequiresReplaceIfConfigured: &schema.RequiresReplaceIfConfiguredPlanModifier{},
+				},
+			},
+			expected: false,
+		},
 	}
 
 	for name, testCase := range testCases {
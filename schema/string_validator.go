@@ -3,6 +3,10 @@
 
 package schema
 
+import (
+	"strings"
+)
+
 // StringValidators type defines StringValidator types
 type StringValidators []StringValidator
 
@@ -23,6 +27,18 @@ func (v StringValidators) CustomValidators() CustomValidators {
 	return customValidators
 }
 
+// PathRelationships returns a PathRelationship for each of the paths of the
+// path-relationship validators of each StringValidator.
+func (v StringValidators) PathRelationships() PathRelationships {
+	var relationships PathRelationships
+
+	for _, validator := range v {
+		relationships = append(relationships, pathRelationships(validator.AlsoRequires, validator.AtLeastOneOf, validator.ConflictsWith, validator.ExactlyOneOf)...)
+	}
+
+	return relationships
+}
+
 // Equal returns true if the given StringValidators is the same
 // length, and each of the StringValidator entries is equal.
 func (v StringValidators) Equal(other StringValidators) bool {
@@ -38,20 +54,25 @@ func (v StringValidators) Equal(other StringValidators) bool {
 		return false
 	}
 
-	validators := v.CustomValidators()
+	// Validators are unordered, so each validator is matched against
+	// any equal, and not already matched, validator in other.
+	matched := make([]bool, len(other))
 
-	otherValidators := other.CustomValidators()
+	for _, validator := range v {
+		found := false
 
-	if len(validators) != len(otherValidators) {
-		return false
-	}
+		for k, otherValidator := range other {
+			if matched[k] || !validator.Equal(otherValidator) {
+				continue
+			}
 
-	validators.Sort()
+			matched[k] = true
+			found = true
 
-	otherValidators.Sort()
+			break
+		}
 
-	for k, validator := range validators {
-		if !validator.Equal(otherValidators[k]) {
+		if !found {
 			return false
 		}
 	}
@@ -62,10 +83,274 @@ func (v StringValidators) Equal(other StringValidators) bool {
 // StringValidator type defines type and function that provides validation
 // functionality.
 type StringValidator struct {
+	// AlsoRequires validates that the attributes or blocks at each of the
+	// Paths are also configured when this value is configured.
+	AlsoRequires *PathRelationshipValidator `json:"also_requires,omitempty"`
+
+	// AtLeastOneOf validates that at least one of this value and the
+	// attributes or blocks at each of the Paths are configured.
+	AtLeastOneOf *PathRelationshipValidator `json:"at_least_one_of,omitempty"`
+
+	// ConflictsWith validates that none of the attributes or blocks at each
+	// of the Paths are configured when this value is configured.
+	ConflictsWith *PathRelationshipValidator `json:"conflicts_with,omitempty"`
+
 	Custom *CustomValidator `json:"custom,omitempty"`
+
+	// ExactlyOneOf validates that exactly one of this value and the
+	// attributes or blocks at each of the Paths are configured.
+	ExactlyOneOf *PathRelationshipValidator `json:"exactly_one_of,omitempty"`
+
+	// LengthAtLeast validates that the string length is at least Min.
+	LengthAtLeast *StringLengthAtLeastValidator `json:"length_at_least,omitempty"`
+
+	// LengthAtMost validates that the string length is at most Max.
+	LengthAtMost *StringLengthAtMostValidator `json:"length_at_most,omitempty"`
+
+	// LengthBetween validates that the string length is between Min and Max.
+	LengthBetween *StringLengthBetweenValidator `json:"length_between,omitempty"`
+
+	// NoneOf validates that the string does not match any of the Values.
+	NoneOf *StringNoneOfValidator `json:"none_of,omitempty"`
+
+	// OneOf validates that the string matches one of the Values.
+	OneOf *StringOneOfValidator `json:"one_of,omitempty"`
+
+	// OneOfCaseInsensitive validates that the string matches one of the
+	// Values, ignoring case.
+	OneOfCaseInsensitive *StringOneOfCaseInsensitiveValidator `json:"one_of_case_insensitive,omitempty"`
+
+	// RegexMatches validates that the string matches the Regex.
+	RegexMatches *StringRegexMatchesValidator `json:"regex_matches,omitempty"`
 }
 
 // Equal returns true if the fields of the given StringValidator equal.
 func (v StringValidator) Equal(other StringValidator) bool {
-	return v.Custom.Equal(other.Custom)
+	if !v.AlsoRequires.Equal(other.AlsoRequires) {
+		return false
+	}
+
+	if !v.AtLeastOneOf.Equal(other.AtLeastOneOf) {
+		return false
+	}
+
+	if !v.ConflictsWith.Equal(other.ConflictsWith) {
+		return false
+	}
+
+	if !v.ExactlyOneOf.Equal(other.ExactlyOneOf) {
+		return false
+	}
+
+	if !v.Custom.Equal(other.Custom) {
+		return false
+	}
+
+	if !v.LengthAtLeast.Equal(other.LengthAtLeast) {
+		return false
+	}
+
+	if !v.LengthAtMost.Equal(other.LengthAtMost) {
+		return false
+	}
+
+	if !v.LengthBetween.Equal(other.LengthBetween) {
+		return false
+	}
+
+	if !v.NoneOf.Equal(other.NoneOf) {
+		return false
+	}
+
+	if !v.OneOf.Equal(other.OneOf) {
+		return false
+	}
+
+	if !v.OneOfCaseInsensitive.Equal(other.OneOfCaseInsensitive) {
+		return false
+	}
+
+	return v.RegexMatches.Equal(other.RegexMatches)
+}
+
+// StringLengthAtLeastValidator defines a validator for a minimum string length.
+type StringLengthAtLeastValidator struct {
+	// Min defines the minimum string length.
+	Min int64 `json:"min"`
+}
+
+// Equal returns true if all fields of the given StringLengthAtLeastValidator are equal.
+func (v *StringLengthAtLeastValidator) Equal(other *StringLengthAtLeastValidator) bool {
+	if v == nil && other == nil {
+		return true
+	}
+
+	if v == nil || other == nil {
+		return false
+	}
+
+	return v.Min == other.Min
+}
+
+// StringLengthAtMostValidator defines a validator for a maximum string length.
+type StringLengthAtMostValidator struct {
+	// Max defines the maximum string length.
+	Max int64 `json:"max"`
+}
+
+// Equal returns true if all fields of the given StringLengthAtMostValidator are equal.
+func (v *StringLengthAtMostValidator) Equal(other *StringLengthAtMostValidator) bool {
+	if v == nil && other == nil {
+		return true
+	}
+
+	if v == nil || other == nil {
+		return false
+	}
+
+	return v.Max == other.Max
+}
+
+// StringLengthBetweenValidator defines a validator for a minimum and maximum
+// string length.
+type StringLengthBetweenValidator struct {
+	// Max defines the maximum string length.
+	Max int64 `json:"max"`
+
+	// Min defines the minimum string length.
+	Min int64 `json:"min"`
+}
+
+// Equal returns true if all fields of the given StringLengthBetweenValidator are equal.
+func (v *StringLengthBetweenValidator) Equal(other *StringLengthBetweenValidator) bool {
+	if v == nil && other == nil {
+		return true
+	}
+
+	if v == nil || other == nil {
+		return false
+	}
+
+	return v.Max == other.Max && v.Min == other.Min
+}
+
+// StringNoneOfValidator defines a validator for string values which are
+// not permitted.
+type StringNoneOfValidator struct {
+	// Values defines the string values which are not permitted.
+	Values []string `json:"values"`
+}
+
+// Equal returns true if the given StringNoneOfValidator has the same
+// Values, irrespective of order.
+func (v *StringNoneOfValidator) Equal(other *StringNoneOfValidator) bool {
+	if v == nil && other == nil {
+		return true
+	}
+
+	if v == nil || other == nil {
+		return false
+	}
+
+	return stringValuesEqual(v.Values, other.Values, false)
+}
+
+// StringOneOfValidator defines a validator for string values which are
+// permitted.
+type StringOneOfValidator struct {
+	// Values defines the string values which are permitted.
+	Values []string `json:"values"`
+}
+
+// Equal returns true if the given StringOneOfValidator has the same
+// Values, irrespective of order.
+func (v *StringOneOfValidator) Equal(other *StringOneOfValidator) bool {
+	if v == nil && other == nil {
+		return true
+	}
+
+	if v == nil || other == nil {
+		return false
+	}
+
+	return stringValuesEqual(v.Values, other.Values, false)
+}
+
+// StringOneOfCaseInsensitiveValidator defines a validator for string values
+// which are permitted, ignoring case.
+type StringOneOfCaseInsensitiveValidator struct {
+	// Values defines the string values which are permitted, ignoring case.
+	Values []string `json:"values"`
+}
+
+// Equal returns true if the given StringOneOfCaseInsensitiveValidator has the
+// same Values, irrespective of order and case.
+func (v *StringOneOfCaseInsensitiveValidator) Equal(other *StringOneOfCaseInsensitiveValidator) bool {
+	if v == nil && other == nil {
+		return true
+	}
+
+	if v == nil || other == nil {
+		return false
+	}
+
+	return stringValuesEqual(v.Values, other.Values, true)
+}
+
+// StringRegexMatchesValidator defines a validator for string values which
+// must match a regular expression.
+type StringRegexMatchesValidator struct {
+	// Message defines an optional message describing the expected value
+	// when the string does not match the Regex.
+	Message *string `json:"message,omitempty"`
+
+	// Regex defines the regular expression, using Go RE2 syntax, which
+	// the string must match.
+	Regex string `json:"regex"`
+}
+
+// Equal returns true if all fields of the given StringRegexMatchesValidator are equal.
+func (v *StringRegexMatchesValidator) Equal(other *StringRegexMatchesValidator) bool {
+	if v == nil && other == nil {
+		return true
+	}
+
+	if v == nil || other == nil {
+		return false
+	}
+
+	if v.Message == nil && other.Message != nil {
+		return false
+	}
+
+	if v.Message != nil && other.Message == nil {
+		return false
+	}
+
+	if v.Message != nil && other.Message != nil && *v.Message != *other.Message {
+		return false
+	}
+
+	return v.Regex == other.Regex
+}
+
+// stringValuesEqual returns true if values and other contain the same strings,
+// irrespective of order, and optionally irrespective of case.
+func stringValuesEqual(values, other []string, caseInsensitive bool) bool {
+	if !caseInsensitive {
+		return valuesEqual(values, other)
+	}
+
+	a := make([]string, len(values))
+	b := make([]string, len(other))
+
+	for k := range values {
+		a[k] = strings.ToLower(values[k])
+	}
+
+	for k := range other {
+		b[k] = strings.ToLower(other[k])
+	}
+
+	return valuesEqual(a, b)
 }
@@ -121,6 +121,77 @@ func TestStringValidators_Equal(t *testing.T) {
 			},
 			expected: true,
 		},
+		"validators_builtin_different_order": {
+			validators: schema.StringValidators{
+				{
+					LengthAtLeast: &schema.StringLengthAtLeastValidator{
+						Min: 1,
+					},
+				},
+				{
+					Custom: &schema.CustomValidator{
+						SchemaDefinition: "one",
+					},
+				},
+			},
+			other: schema.StringValidators{
+				{
+					Custom: &schema.CustomValidator{
+						SchemaDefinition: "one",
+					},
+				},
+				{
+					LengthAtLeast: &schema.StringLengthAtLeastValidator{
+						Min: 1,
+					},
+				},
+			},
+			expected: true,
+		},
+		"validators_builtin_different": {
+			validators: schema.StringValidators{
+				{
+					LengthAtLeast: &schema.StringLengthAtLeastValidator{
+						Min: 1,
+					},
+				},
+			},
+			other: schema.StringValidators{
+				{
+					LengthAtLeast: &schema.StringLengthAtLeastValidator{
+						Min: 2,
+					},
+				},
+			},
+			expected: false,
+		},
+		"validators_builtin_duplicated": {
+			validators: schema.StringValidators{
+				{
+					LengthAtMost: &schema.StringLengthAtMostValidator{
+						Max: 1,
+					},
+				},
+				{
+					LengthAtMost: &schema.StringLengthAtMostValidator{
+						Max: 1,
+					},
+				},
+			},
+			other: schema.StringValidators{
+				{
+					LengthAtMost: &schema.StringLengthAtMostValidator{
+						Max: 1,
+					},
+				},
+				{
+					LengthAtMost: &schema.StringLengthAtMostValidator{
+						Max: 2,
+					},
+				},
+			},
+			expected: false,
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -136,3 +207,167 @@ func TestStringValidators_Equal(t *testing.T) {
 		})
 	}
 }
+
+func TestStringValidator_Equal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator schema.StringValidator
+		other     schema.StringValidator
+		expected  bool
+	}{
+		"empty": {
+			expected: true,
+		},
+		"custom_builtin": {
+			validator: schema.StringValidator{
+				Custom: &schema.CustomValidator{
+					SchemaDefinition: "stringvalidator.LengthAtLeast(1)",
+				},
+			},
+			other: schema.StringValidator{
+				LengthAtLeast: &schema.StringLengthAtLeastValidator{
+					Min: 1,
+				},
+			},
+			expected: false,
+		},
+		"length_between_match": {
+			validator: schema.StringValidator{
+				LengthBetween: &schema.StringLengthBetweenValidator{
+					Min: 1,
+					Max: 2,
+				},
+			},
+			other: schema.StringValidator{
+				LengthBetween: &schema.StringLengthBetweenValidator{
+					Min: 1,
+					Max: 2,
+				},
+			},
+			expected: true,
+		},
+		"length_between_max_different": {
+			validator: schema.StringValidator{
+				LengthBetween: &schema.StringLengthBetweenValidator{
+					Min: 1,
+					Max: 2,
+				},
+			},
+			other: schema.StringValidator{
+				LengthBetween: &schema.StringLengthBetweenValidator{
+					Min: 1,
+					Max: 3,
+				},
+			},
+			expected: false,
+		},
+		"none_of_values_different_order": {
+			validator: schema.StringValidator{
+				NoneOf: &schema.StringNoneOfValidator{
+					Values: []string{"one", "two"},
+				},
+			},
+			other: schema.StringValidator{
+				NoneOf: &schema.StringNoneOfValidator{
+					Values: []string{"two", "one"},
+				},
+			},
+			expected: true,
+		},
+		"one_of_values_different": {
+			validator: schema.StringValidator{
+				OneOf: &schema.StringOneOfValidator{
+					Values: []string{"one", "two"},
+				},
+			},
+			other: schema.StringValidator{
+				OneOf: &schema.StringOneOfValidator{
+					Values: []string{"one", "three"},
+				},
+			},
+			expected: false,
+		},
+		"one_of_values_different_case": {
+			validator: schema.StringValidator{
+				OneOf: &schema.StringOneOfValidator{
+					Values: []string{"one"},
+				},
+			},
+			other: schema.StringValidator{
+				OneOf: &schema.StringOneOfValidator{
+					Values: []string{"ONE"},
+				},
+			},
+			expected: false,
+		},
+		"one_of_case_insensitive_values_different_case": {
+			validator: schema.StringValidator{
+				OneOfCaseInsensitive: &schema.StringOneOfCaseInsensitiveValidator{
+					Values: []string{"one", "two"},
+				},
+			},
+			other: schema.StringValidator{
+				OneOfCaseInsensitive: &schema.StringOneOfCaseInsensitiveValidator{
+					Values: []string{"TWO", "One"},
+				},
+			},
+			expected: true,
+		},
+		"regex_matches_match": {
+			validator: schema.StringValidator{
+				RegexMatches: &schema.StringRegexMatchesValidator{
+					Message: pointer("must be lowercase"),
+					Regex:   "^[a-z]+$",
+				},
+			},
+			other: schema.StringValidator{
+				RegexMatches: &schema.StringRegexMatchesValidator{
+					Message: pointer("must be lowercase"),
+					Regex:   "^[a-z]+$",
+				},
+			},
+			expected: true,
+		},
+		"regex_matches_message_nil_other_not_nil": {
+			validator: schema.StringValidator{
+				RegexMatches: &schema.StringRegexMatchesValidator{
+					Regex: "^[a-z]+$",
+				},
+			},
+			other: schema.StringValidator{
+				RegexMatches: &schema.StringRegexMatchesValidator{
+					Message: pointer("must be lowercase"),
+					Regex:   "^[a-z]+$",
+				},
+			},
+			expected: false,
+		},
+		"regex_matches_regex_different": {
+			validator: schema.StringValidator{
+				RegexMatches: &schema.StringRegexMatchesValidator{
+					Regex: "^[a-z]+$",
+				},
+			},
+			other: schema.StringValidator{
+				RegexMatches: &schema.StringRegexMatchesValidator{
+					Regex: "^[A-Z]+$",
+				},
+			},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.validator.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
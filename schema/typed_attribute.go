@@ -0,0 +1,121 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+var (
+	_ Validators = BoolValidators{}
+	_ Validators = DynamicValidators{}
+	_ Validators = Float64Validators{}
+	_ Validators = Int64Validators{}
+	_ Validators = ListValidators{}
+	_ Validators = MapValidators{}
+	_ Validators = NumberValidators{}
+	_ Validators = ObjectValidators{}
+	_ Validators = SetValidators{}
+	_ Validators = StringValidators{}
+
+	_ PlanModifiers = BoolPlanModifiers{}
+	_ PlanModifiers = DynamicPlanModifiers{}
+	_ PlanModifiers = Float64PlanModifiers{}
+	_ PlanModifiers = Int64PlanModifiers{}
+	_ PlanModifiers = ListPlanModifiers{}
+	_ PlanModifiers = MapPlanModifiers{}
+	_ PlanModifiers = NumberPlanModifiers{}
+	_ PlanModifiers = ObjectPlanModifiers{}
+	_ PlanModifiers = SetPlanModifiers{}
+	_ PlanModifiers = StringPlanModifiers{}
+)
+
+// AttributeKind defines the type of an attribute, which is the same as the
+// name of the attribute type within the specification, such as string or
+// list_nested.
+type AttributeKind string
+
+const (
+	AttributeKindBool         AttributeKind = "bool"
+	AttributeKindDynamic      AttributeKind = "dynamic"
+	AttributeKindFloat64      AttributeKind = "float64"
+	AttributeKindInt64        AttributeKind = "int64"
+	AttributeKindList         AttributeKind = "list"
+	AttributeKindListNested   AttributeKind = "list_nested"
+	AttributeKindMap          AttributeKind = "map"
+	AttributeKindMapNested    AttributeKind = "map_nested"
+	AttributeKindNumber       AttributeKind = "number"
+	AttributeKindObject       AttributeKind = "object"
+	AttributeKindSet          AttributeKind = "set"
+	AttributeKindSetNested    AttributeKind = "set_nested"
+	AttributeKindSingleNested AttributeKind = "single_nested"
+	AttributeKindString       AttributeKind = "string"
+)
+
+// TypedAttribute is implemented by each attribute type of the datasource,
+// ephemeralresource, provider and resource packages, such as
+// resource.StringAttribute, and provides access to the fields which the
+// attribute types have in common.
+type TypedAttribute interface {
+	// AttributeKind returns the kind of the attribute type.
+	AttributeKind() AttributeKind
+
+	// GetAssociatedExternalType returns the AssociatedExternalType, which is
+	// always nil for list, map and set nested attributes, as the
+	// AssociatedExternalType of these is defined by the nested object.
+	GetAssociatedExternalType() *AssociatedExternalType
+
+	// GetComputedOptionalRequired returns the ComputedOptionalRequired, or
+	// the OptionalRequired of a provider attribute.
+	GetComputedOptionalRequired() ComputedOptionalRequired
+
+	// GetCustomType returns the CustomType.
+	GetCustomType() *CustomType
+
+	// GetDeprecationMessage returns the DeprecationMessage.
+	GetDeprecationMessage() *string
+
+	// GetDescription returns the Description.
+	GetDescription() *string
+
+	// GetSensitive returns the Sensitive flag.
+	GetSensitive() *bool
+
+	// GetValidators returns the Validators, such as StringValidators for a
+	// string attribute, or nil if the attribute has no validators.
+	GetValidators() Validators
+
+	// GetPlanModifiers returns the PlanModifiers, such as
+	// StringPlanModifiers for a string attribute, or nil if the attribute
+	// has no plan modifiers. It is always nil for attribute types which do
+	// not support plan modifiers, such as those of a data source.
+	GetPlanModifiers() PlanModifiers
+}
+
+// Validators is implemented by the validators of each attribute type, such
+// as StringValidators. The concrete type can be recovered with a type switch.
+type Validators interface {
+	// CustomValidators returns the CustomValidator of each validator.
+	CustomValidators() CustomValidators
+
+	// PathRelationships returns the PathRelationships of the
+	// path-relationship validators.
+	PathRelationships() PathRelationships
+}
+
+// PlanModifiers is implemented by the plan modifiers of each attribute type,
+// such as StringPlanModifiers. The concrete type can be recovered with a type
+// switch.
+type PlanModifiers interface {
+	// CustomPlanModifiers returns the CustomPlanModifier of each plan
+	// modifier.
+	CustomPlanModifiers() CustomPlanModifiers
+}
+
+// NamedAttribute is implemented by the Attribute type of each of the
+// datasource, ephemeralresource, provider and resource packages.
+type NamedAttribute interface {
+	// GetName returns the name of the attribute.
+	GetName() string
+
+	// TypedAttribute returns the attribute type which is set, or nil if no
+	// attribute type is set.
+	TypedAttribute() TypedAttribute
+}
@@ -0,0 +1,25 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"cmp"
+	"slices"
+)
+
+// valuesEqual returns true if values and other contain the same values,
+// irrespective of order.
+func valuesEqual[T cmp.Ordered](values, other []T) bool {
+	if len(values) != len(other) {
+		return false
+	}
+
+	a := slices.Clone(values)
+	b := slices.Clone(other)
+
+	slices.Sort(a)
+	slices.Sort(b)
+
+	return slices.Equal(a, b)
+}
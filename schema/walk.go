@@ -0,0 +1,197 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"errors"
+)
+
+// SkipChildren is returned from Visitor.Enter to skip the children of the
+// WalkNode being entered. Visitor.Leave is still called for the WalkNode.
+var SkipChildren = errors.New("skip children")
+
+// WalkNodeKind defines the kind of element of a schema which is visited.
+type WalkNodeKind int
+
+const (
+	// WalkNodeKindInvalid is the zero value of WalkNodeKind, and is not
+	// valid.
+	WalkNodeKindInvalid WalkNodeKind = iota
+
+	// WalkNodeKindAttribute is an attribute, such as a resource.Attribute.
+	WalkNodeKindAttribute
+
+	// WalkNodeKindBlock is a block, such as a resource.Block.
+	WalkNodeKindBlock
+
+	// WalkNodeKindNestedObject is the object containing the attributes,
+	// and any blocks, of a nested attribute or block, such as a
+	// resource.NestedAttributeObject or a *resource.SingleNestedBlock.
+	WalkNodeKindNestedObject
+
+	// WalkNodeKindElementType is the ElementType of a list, map or set.
+	WalkNodeKindElementType
+
+	// WalkNodeKindObjectAttributeType is an ObjectAttributeType of an
+	// object.
+	WalkNodeKindObjectAttributeType
+)
+
+// String returns the lower case name of the WalkNodeKind.
+func (k WalkNodeKind) String() string {
+	switch k {
+	case WalkNodeKindAttribute:
+		return "attribute"
+	case WalkNodeKindBlock:
+		return "block"
+	case WalkNodeKindNestedObject:
+		return "nested object"
+	case WalkNodeKindElementType:
+		return "element type"
+	case WalkNodeKindObjectAttributeType:
+		return "object attribute type"
+	}
+
+	return "invalid"
+}
+
+// WalkNode defines an element of a schema which is visited.
+type WalkNode struct {
+	// Kind defines the kind of the element.
+	Kind WalkNodeKind
+
+	// Name is the name of an attribute, block or object attribute type, and
+	// is empty for a nested object or element type.
+	Name string
+
+	// Path is the location of the element relative to the schema. The
+	// Path of a nested object ends with a PathStepKindNestedObject step.
+	Path Path
+
+	// Value is the element, for example a resource.Attribute,
+	// datasource.Block, ElementType or ObjectAttributeType.
+	Value any
+}
+
+// Visitor defines the callbacks used when walking a schema. Enter is called
+// before the children of a WalkNode are visited, and Leave is called after.
+// Returning SkipChildren from Enter skips the children of the WalkNode.
+// Returning any other error stops the walk, and the error is returned.
+type Visitor interface {
+	Enter(node WalkNode) error
+	Leave(node WalkNode) error
+}
+
+// Walker is implemented by each schema which can be walked with a Visitor,
+// such as resource.Schema, datasource.Schema and provider.Schema.
+type Walker interface {
+	Walk(v Visitor) error
+}
+
+// VisitorFuncs is a Visitor which calls EnterFunc and LeaveFunc, either of
+// which may be nil.
+type VisitorFuncs struct {
+	EnterFunc func(node WalkNode) error
+	LeaveFunc func(node WalkNode) error
+}
+
+// Enter calls EnterFunc, if set.
+func (v VisitorFuncs) Enter(node WalkNode) error {
+	if v.EnterFunc == nil {
+		return nil
+	}
+
+	return v.EnterFunc(node)
+}
+
+// Leave calls LeaveFunc, if set.
+func (v VisitorFuncs) Leave(node WalkNode) error {
+	if v.LeaveFunc == nil {
+		return nil
+	}
+
+	return v.LeaveFunc(node)
+}
+
+// Walk calls Enter on the given Visitor for the node, calls the given
+// children function unless Enter returns SkipChildren, and then calls Leave.
+// Walk is used to implement the Walk methods of each schema.
+func Walk(v Visitor, node WalkNode, children func() error) error {
+	err := v.Enter(node)
+
+	switch {
+	case errors.Is(err, SkipChildren):
+	case err != nil:
+		return err
+	case children != nil:
+		if err := children(); err != nil {
+			return err
+		}
+	}
+
+	return v.Leave(node)
+}
+
+// Walk visits the ElementType at the given path, such as the path of a list
+// attribute with a PathStepKindElementType step appended, and any nested
+// element types or object attribute types.
+func (e ElementType) Walk(v Visitor, path Path) error {
+	node := WalkNode{
+		Kind:  WalkNodeKindElementType,
+		Path:  path,
+		Value: e,
+	}
+
+	return Walk(v, node, func() error {
+		switch {
+		case e.List != nil:
+			return e.List.ElementType.Walk(v, path.ElementType("list"))
+		case e.Map != nil:
+			return e.Map.ElementType.Walk(v, path.ElementType("map"))
+		case e.Object != nil:
+			return e.Object.AttributeTypes.Walk(v, path)
+		case e.Set != nil:
+			return e.Set.ElementType.Walk(v, path.ElementType("set"))
+		}
+
+		return nil
+	})
+}
+
+// Walk visits each ObjectAttributeType, and any nested element types or
+// object attribute types, where the given path is that of the element with
+// the object type, such as an attribute or element type.
+func (o ObjectAttributeTypes) Walk(v Visitor, path Path) error {
+	for k, attributeType := range o {
+		attributeTypePath := path.ObjectAttributeType(attributeType.Name, k)
+
+		node := WalkNode{
+			Kind:  WalkNodeKindObjectAttributeType,
+			Name:  attributeType.Name,
+			Path:  attributeTypePath,
+			Value: attributeType,
+		}
+
+		err := Walk(v, node, func() error {
+			switch {
+			case attributeType.List != nil:
+				return attributeType.List.ElementType.Walk(v, attributeTypePath.ElementType("list"))
+			case attributeType.Map != nil:
+				return attributeType.Map.ElementType.Walk(v, attributeTypePath.ElementType("map"))
+			case attributeType.Object != nil:
+				return attributeType.Object.AttributeTypes.Walk(v, attributeTypePath)
+			case attributeType.Set != nil:
+				return attributeType.Set.ElementType.Walk(v, attributeTypePath.ElementType("set"))
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+func TestElementType_Walk(t *testing.T) {
+	t.Parallel()
+
+	input := schema.ElementType{
+		Map: &schema.MapType{
+			ElementType: schema.ElementType{
+				Set: &schema.SetType{
+					ElementType: schema.ElementType{
+						String: &schema.StringType{},
+					},
+				},
+			},
+		},
+	}
+
+	var got []string
+
+	visitor := schema.VisitorFuncs{
+		EnterFunc: func(node schema.WalkNode) error {
+			got = append(got, fmt.Sprintf("enter %s %s %s", node.Path.JSONPointer(), node.Path.TerraformPath(), node.Path))
+
+			return nil
+		},
+	}
+
+	err := input.Walk(visitor, schema.Path{}.Attribute("map", 0).ElementType("map"))
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+
+	expected := []string{
+		`enter /attributes/0/map/element_type map["*"] attribute "map" element type`,
+		`enter /attributes/0/map/element_type/map/element_type map["*"]["*"] attribute "map" element type element type`,
+		`enter /attributes/0/map/element_type/map/element_type/set/element_type map["*"]["*"][Value(*)] attribute "map" element type element type element type`,
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,335 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package spec
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+// ChangeKind defines whether an element of a Specification was added,
+// removed or changed.
+type ChangeKind string
+
+const (
+	ChangeKindAdded   ChangeKind = "added"
+	ChangeKindChanged ChangeKind = "changed"
+	ChangeKindRemoved ChangeKind = "removed"
+)
+
+// ChangeClassification defines the impact of a Change on practitioners.
+type ChangeClassification string
+
+const (
+	// ChangeClassificationSafe indicates that existing configurations and
+	// state are unaffected.
+	ChangeClassificationSafe ChangeClassification = "safe"
+
+	// ChangeClassificationBreaking indicates that existing configurations
+	// may no longer be valid, or that existing state may no longer be
+	// compatible.
+	ChangeClassificationBreaking ChangeClassification = "breaking"
+
+	// ChangeClassificationStateUpgrade indicates that existing
+	// configurations remain valid, but that planned values may differ from
+	// existing state, which may require a state upgrade.
+	ChangeClassificationStateUpgrade ChangeClassification = "state_upgrade"
+)
+
+// Change defines a difference between two Specifications.
+type Change struct {
+	// Kind defines whether the element was added, removed or changed.
+	Kind ChangeKind
+
+	// Classification defines the impact of the change.
+	Classification ChangeClassification
+
+	// Path defines the location of the element within the new
+	// Specification, or within the old Specification if the element was
+	// removed.
+	Path schema.Path
+
+	// Detail describes the change, such as "changed from optional to
+	// required".
+	Detail string
+}
+
+// String returns the Path followed by the Detail.
+func (c Change) String() string {
+	return strings.TrimSpace(c.Path.String() + " " + c.Detail)
+}
+
+// Changes type defines Change types.
+type Changes []Change
+
+// Breaking returns the Changes which are classified as breaking.
+func (c Changes) Breaking() Changes {
+	var breaking Changes
+
+	for _, change := range c {
+		if change.Classification == ChangeClassificationBreaking {
+			breaking = append(breaking, change)
+		}
+	}
+
+	return breaking
+}
+
+// HasBreaking returns true if any of the Changes are classified as breaking.
+func (c Changes) HasBreaking() bool {
+	return len(c.Breaking()) > 0
+}
+
+// Diff returns the Changes to the resources and data sources, including their
+// attributes and blocks, between the old and new Specifications. Elements are
+// matched by name, so a renamed element is reported as removed and added.
+//
+// Removing an element, changing the type of an attribute or block, making an
+// attribute or block required or computed-only, making an attribute or block
+// no longer computed, adding a required attribute or block, adding or
+// changing validators, decreasing the version of a resource schema, and
+// removing or changing the identity of a resource are classified as breaking.
+// Adding, removing or changing a default, increasing the version of a
+// resource schema, and changing a state upgrader are classified as requiring
+// a state upgrade. All other changes, such as adding an optional attribute or
+// removing a validator, are classified as safe.
+func Diff(oldSpec, newSpec Specification) Changes {
+	changes := diffResources(oldSpec.Resources, newSpec.Resources)
+
+	changes = append(changes, diffDataSources(oldSpec.DataSources, newSpec.DataSources)...)
+
+	return changes
+}
+
+// diffByName matches each of the old and new elements by name. The removed
+// function is called for each old element which has no match, followed by
+// the added or matched function for each new element.
+func diffByName[T any](oldElements, newElements []T, name func(T) string, removed, added func(int, T) Changes, matched func(int, T, T) Changes) Changes {
+	var changes Changes
+
+	oldIndexes := make(map[string]int, len(oldElements))
+	newIndexes := make(map[string]int, len(newElements))
+
+	for k, element := range oldElements {
+		oldIndexes[name(element)] = k
+	}
+
+	for k, element := range newElements {
+		newIndexes[name(element)] = k
+	}
+
+	for k, element := range oldElements {
+		if _, ok := newIndexes[name(element)]; !ok {
+			changes = append(changes, removed(k, element)...)
+		}
+	}
+
+	for k, element := range newElements {
+		oldIndex, ok := oldIndexes[name(element)]
+
+		if !ok {
+			changes = append(changes, added(k, element)...)
+
+			continue
+		}
+
+		changes = append(changes, matched(k, oldElements[oldIndex], element)...)
+	}
+
+	return changes
+}
+
+// added returns a Change of ChangeKindAdded.
+func added(path schema.Path, classification ChangeClassification, detail string) Changes {
+	return Changes{
+		{
+			Kind:           ChangeKindAdded,
+			Classification: classification,
+			Path:           path,
+			Detail:         detail,
+		},
+	}
+}
+
+// removed returns a Change of ChangeKindRemoved, which is always breaking.
+func removed(path schema.Path) Changes {
+	return Changes{
+		{
+			Kind:           ChangeKindRemoved,
+			Classification: ChangeClassificationBreaking,
+			Path:           path,
+			Detail:         "removed",
+		},
+	}
+}
+
+// changed returns a Change of ChangeKindChanged, or nil if equal is true.
+func changed(path schema.Path, equal bool, classification ChangeClassification, detail string) Changes {
+	if equal {
+		return nil
+	}
+
+	return Changes{
+		{
+			Kind:           ChangeKindChanged,
+			Classification: classification,
+			Path:           path,
+			Detail:         detail,
+		},
+	}
+}
+
+// addedAttribute returns the Change for an added attribute, which is breaking
+// if the attribute is required.
+func addedAttribute(path schema.Path, attribute schema.TypedAttribute) Changes {
+	if attribute == nil {
+		return added(path, ChangeClassificationSafe, "added")
+	}
+
+	return addedComputedOptionalRequired(path, attribute.GetComputedOptionalRequired())
+}
+
+// addedComputedOptionalRequired returns the Change for an added attribute or
+// block with the given ComputedOptionalRequired, which is breaking if the
+// attribute or block is required.
+func addedComputedOptionalRequired(path schema.Path, value schema.ComputedOptionalRequired) Changes {
+	if value == schema.Required {
+		return added(path, ChangeClassificationBreaking, "added as required")
+	}
+
+	return added(path, ChangeClassificationSafe, "added")
+}
+
+// diffTypedAttribute returns the Changes to the fields which the old and new
+// attribute types have in common. The returned bool is false if the kind of
+// the attribute type changed, in which case the remaining fields are not
+// compared.
+func diffTypedAttribute(path schema.Path, oldAttribute, newAttribute schema.TypedAttribute) (Changes, bool) {
+	if oldAttribute == nil || newAttribute == nil {
+		return nil, false
+	}
+
+	if oldAttribute.AttributeKind() != newAttribute.AttributeKind() {
+		return changed(path, false, ChangeClassificationBreaking, fmt.Sprintf("type changed from %s to %s", oldAttribute.AttributeKind(), newAttribute.AttributeKind())), false
+	}
+
+	changes := diffComputedOptionalRequired(path, oldAttribute.GetComputedOptionalRequired(), newAttribute.GetComputedOptionalRequired())
+
+	changes = append(changes, changed(path, oldAttribute.GetAssociatedExternalType().Equal(newAttribute.GetAssociatedExternalType()), ChangeClassificationSafe, "associated external type changed")...)
+	changes = append(changes, changed(path, oldAttribute.GetCustomType().Equal(newAttribute.GetCustomType()), ChangeClassificationSafe, "custom type changed")...)
+	changes = append(changes, changed(path, stringPointerEqual(oldAttribute.GetDeprecationMessage(), newAttribute.GetDeprecationMessage()), ChangeClassificationSafe, "deprecation message changed")...)
+	changes = append(changes, changed(path, stringPointerEqual(oldAttribute.GetDescription(), newAttribute.GetDescription()), ChangeClassificationSafe, "description changed")...)
+	changes = append(changes, diffBool(path, "sensitive", ChangeClassificationSafe, oldAttribute.GetSensitive(), newAttribute.GetSensitive())...)
+
+	return changes, true
+}
+
+// diffComputedOptionalRequired returns the Change to the
+// ComputedOptionalRequired of an attribute or block. Changes which make an
+// attribute or block required or computed-only, or which make it no longer
+// computed, are breaking.
+func diffComputedOptionalRequired(path schema.Path, oldValue, newValue schema.ComputedOptionalRequired) Changes {
+	if oldValue.Equal(newValue) {
+		return nil
+	}
+
+	classification := ChangeClassificationSafe
+
+	oldComputed := oldValue == schema.Computed || oldValue == schema.ComputedOptional
+	newComputed := newValue == schema.Computed || newValue == schema.ComputedOptional
+
+	if newValue == schema.Required || newValue == schema.Computed || (oldComputed && !newComputed) {
+		classification = ChangeClassificationBreaking
+	}
+
+	return changed(path, false, classification, fmt.Sprintf("changed from %s to %s", oldValue, newValue))
+}
+
+// diffDefault returns the Change to a default, given whether the old and new
+// defaults are set, and whether they are equal.
+func diffDefault(path schema.Path, oldSet, newSet, equal bool) Changes {
+	switch {
+	case equal:
+		return nil
+	case !oldSet:
+		return changed(path, false, ChangeClassificationStateUpgrade, "default added")
+	case !newSet:
+		return changed(path, false, ChangeClassificationStateUpgrade, "default removed")
+	}
+
+	return changed(path, false, ChangeClassificationStateUpgrade, "default changed")
+}
+
+// diffValidators returns the Change to the named validators. Validators are
+// unordered, so each new validator is matched against any equal, and not
+// already matched, old validator. Removing validators is safe, while adding
+// or changing validators is breaking.
+func diffValidators[V interface{ Equal(V) bool }](path schema.Path, name string, oldValidators, newValidators []V) Changes {
+	matched := make([]bool, len(oldValidators))
+
+	for _, newValidator := range newValidators {
+		found := false
+
+		for k, oldValidator := range oldValidators {
+			if matched[k] || !oldValidator.Equal(newValidator) {
+				continue
+			}
+
+			matched[k] = true
+			found = true
+
+			break
+		}
+
+		if !found {
+			return changed(path, false, ChangeClassificationBreaking, name+" changed")
+		}
+	}
+
+	return changed(path, len(oldValidators) == len(newValidators), ChangeClassificationSafe, name+" removed")
+}
+
+// diffBool returns the Change to the named boolean field, where nil is
+// equivalent to false.
+func diffBool(path schema.Path, name string, classification ChangeClassification, oldValue, newValue *bool) Changes {
+	oldBool := oldValue != nil && *oldValue
+	newBool := newValue != nil && *newValue
+
+	return changed(path, oldBool == newBool, classification, fmt.Sprintf("%s changed from %t to %t", name, oldBool, newBool))
+}
+
+// diffVersion returns the Change to the version at the given path, where nil
+// is equivalent to 0. Increasing the version has the given classification,
+// while decreasing the version is breaking.
+func diffVersion(path schema.Path, oldVersion, newVersion *int64, increased ChangeClassification) Changes {
+	var oldValue, newValue int64
+
+	if oldVersion != nil {
+		oldValue = *oldVersion
+	}
+
+	if newVersion != nil {
+		newValue = *newVersion
+	}
+
+	classification := increased
+
+	if newValue < oldValue {
+		classification = ChangeClassificationBreaking
+	}
+
+	return changed(path, oldValue == newValue, classification, fmt.Sprintf("version changed from %d to %d", oldValue, newValue))
+}
+
+// stringPointerEqual returns true if both of the given strings are nil, or
+// both are not nil and have the same value.
+func stringPointerEqual(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
@@ -0,0 +1,226 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package spec
+
+import (
+	"github.com/hashicorp/terraform-plugin-codegen-spec/datasource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+// diffDataSources returns the Changes between the old and new data sources.
+func diffDataSources(oldDataSources, newDataSources datasource.DataSources) Changes {
+	return diffByName(
+		oldDataSources,
+		newDataSources,
+		func(r datasource.DataSource) string { return r.Name },
+		func(k int, r datasource.DataSource) Changes { return removed(schema.Path{}.DataSource(r.Name, k)) },
+		func(k int, r datasource.DataSource) Changes {
+			return added(schema.Path{}.DataSource(r.Name, k), ChangeClassificationSafe, "added")
+		},
+		func(k int, oldDataSource, newDataSource datasource.DataSource) Changes {
+			var oldSchema, newSchema datasource.Schema
+
+			if oldDataSource.Schema != nil {
+				oldSchema = *oldDataSource.Schema
+			}
+
+			if newDataSource.Schema != nil {
+				newSchema = *newDataSource.Schema
+			}
+
+			return diffDataSourceSchema(schema.Path{}.DataSource(newDataSource.Name, k).Schema(), oldSchema, newSchema)
+		},
+	)
+}
+
+// diffDataSourceSchema returns the Changes between the old and new data source
+// schemas.
+func diffDataSourceSchema(path schema.Path, oldSchema, newSchema datasource.Schema) Changes {
+	changes := diffValidators(path, "config validators", oldSchema.ConfigValidators, newSchema.ConfigValidators)
+
+	changes = append(changes, changed(path, stringPointerEqual(oldSchema.DeprecationMessage, newSchema.DeprecationMessage), ChangeClassificationSafe, "deprecation message changed")...)
+	changes = append(changes, changed(path, stringPointerEqual(oldSchema.Description, newSchema.Description), ChangeClassificationSafe, "description changed")...)
+	changes = append(changes, changed(path, stringPointerEqual(oldSchema.MarkdownDescription, newSchema.MarkdownDescription), ChangeClassificationSafe, "markdown description changed")...)
+	changes = append(changes, diffDataSourceAttributes(path, oldSchema.Attributes, newSchema.Attributes)...)
+	changes = append(changes, diffDataSourceBlocks(path, oldSchema.Blocks, newSchema.Blocks)...)
+
+	return changes
+}
+
+// diffDataSourceAttributes returns the Changes between the old and new
+// attributes of the object at the given path.
+func diffDataSourceAttributes(path schema.Path, oldAttributes, newAttributes datasource.Attributes) Changes {
+	return diffByName(
+		oldAttributes,
+		newAttributes,
+		datasource.Attribute.GetName,
+		func(k int, a datasource.Attribute) Changes { return removed(path.Attribute(a.Name, k)) },
+		func(k int, a datasource.Attribute) Changes {
+			return addedAttribute(path.Attribute(a.Name, k), a.TypedAttribute())
+		},
+		func(k int, oldAttribute, newAttribute datasource.Attribute) Changes {
+			return diffDataSourceAttribute(path.Attribute(newAttribute.Name, k), oldAttribute, newAttribute)
+		},
+	)
+}
+
+// diffDataSourceAttribute returns the Changes between the old and new attribute.
+func diffDataSourceAttribute(path schema.Path, oldAttribute, newAttribute datasource.Attribute) Changes {
+	changes, ok := diffTypedAttribute(path, oldAttribute.TypedAttribute(), newAttribute.TypedAttribute())
+
+	if !ok {
+		return changes
+	}
+
+	switch {
+	case oldAttribute.Bool != nil:
+		changes = append(changes, diffValidators(path, "validators", oldAttribute.Bool.Validators, newAttribute.Bool.Validators)...)
+	case oldAttribute.Dynamic != nil:
+		changes = append(changes, diffValidators(path, "validators", oldAttribute.Dynamic.Validators, newAttribute.Dynamic.Validators)...)
+	case oldAttribute.Float64 != nil:
+		changes = append(changes, diffValidators(path, "validators", oldAttribute.Float64.Validators, newAttribute.Float64.Validators)...)
+	case oldAttribute.Int64 != nil:
+		changes = append(changes, diffValidators(path, "validators", oldAttribute.Int64.Validators, newAttribute.Int64.Validators)...)
+	case oldAttribute.List != nil:
+		changes = append(changes, changed(path, oldAttribute.List.ElementType.Equal(newAttribute.List.ElementType), ChangeClassificationBreaking, "element type changed")...)
+		changes = append(changes, diffValidators(path, "validators", oldAttribute.List.Validators, newAttribute.List.Validators)...)
+	case oldAttribute.ListNested != nil:
+		changes = append(changes, diffValidators(path, "validators", oldAttribute.ListNested.Validators, newAttribute.ListNested.Validators)...)
+		changes = append(changes, diffDataSourceNestedAttributeObject(path.NestedObject("list_nested"), oldAttribute.ListNested.NestedObject, newAttribute.ListNested.NestedObject)...)
+	case oldAttribute.Map != nil:
+		changes = append(changes, changed(path, oldAttribute.Map.ElementType.Equal(newAttribute.Map.ElementType), ChangeClassificationBreaking, "element type changed")...)
+		changes = append(changes, diffValidators(path, "validators", oldAttribute.Map.Validators, newAttribute.Map.Validators)...)
+	case oldAttribute.MapNested != nil:
+		changes = append(changes, diffValidators(path, "validators", oldAttribute.MapNested.Validators, newAttribute.MapNested.Validators)...)
+		changes = append(changes, diffDataSourceNestedAttributeObject(path.NestedObject("map_nested"), oldAttribute.MapNested.NestedObject, newAttribute.MapNested.NestedObject)...)
+	case oldAttribute.Number != nil:
+		changes = append(changes, diffValidators(path, "validators", oldAttribute.Number.Validators, newAttribute.Number.Validators)...)
+	case oldAttribute.Object != nil:
+		changes = append(changes, changed(path, oldAttribute.Object.AttributeTypes.Equal(newAttribute.Object.AttributeTypes), ChangeClassificationBreaking, "attribute types changed")...)
+		changes = append(changes, diffValidators(path, "validators", oldAttribute.Object.Validators, newAttribute.Object.Validators)...)
+	case oldAttribute.Set != nil:
+		changes = append(changes, changed(path, oldAttribute.Set.ElementType.Equal(newAttribute.Set.ElementType), ChangeClassificationBreaking, "element type changed")...)
+		changes = append(changes, diffValidators(path, "validators", oldAttribute.Set.Validators, newAttribute.Set.Validators)...)
+	case oldAttribute.SetNested != nil:
+		changes = append(changes, diffValidators(path, "validators", oldAttribute.SetNested.Validators, newAttribute.SetNested.Validators)...)
+		changes = append(changes, diffDataSourceNestedAttributeObject(path.NestedObject("set_nested"), oldAttribute.SetNested.NestedObject, newAttribute.SetNested.NestedObject)...)
+	case oldAttribute.SingleNested != nil:
+		changes = append(changes, diffValidators(path, "validators", oldAttribute.SingleNested.Validators, newAttribute.SingleNested.Validators)...)
+		changes = append(changes, diffDataSourceAttributes(path.NestedObject("single_nested"), oldAttribute.SingleNested.Attributes, newAttribute.SingleNested.Attributes)...)
+	case oldAttribute.String != nil:
+		changes = append(changes, diffValidators(path, "validators", oldAttribute.String.Validators, newAttribute.String.Validators)...)
+	}
+
+	return changes
+}
+
+// diffDataSourceNestedAttributeObject returns the Changes between the old and new
+// nested attribute objects.
+func diffDataSourceNestedAttributeObject(path schema.Path, oldObject, newObject datasource.NestedAttributeObject) Changes {
+	changes := changed(path, oldObject.AssociatedExternalType.Equal(newObject.AssociatedExternalType), ChangeClassificationSafe, "associated external type changed")
+
+	changes = append(changes, changed(path, oldObject.CustomType.Equal(newObject.CustomType), ChangeClassificationSafe, "custom type changed")...)
+	changes = append(changes, diffValidators(path, "validators", oldObject.Validators, newObject.Validators)...)
+	changes = append(changes, diffDataSourceAttributes(path, oldObject.Attributes, newObject.Attributes)...)
+
+	return changes
+}
+
+// diffDataSourceBlocks returns the Changes between the old and new blocks of the
+// object at the given path.
+func diffDataSourceBlocks(path schema.Path, oldBlocks, newBlocks datasource.Blocks) Changes {
+	return diffByName(
+		oldBlocks,
+		newBlocks,
+		func(b datasource.Block) string { return b.Name },
+		func(k int, b datasource.Block) Changes { return removed(path.Block(b.Name, k)) },
+		func(k int, b datasource.Block) Changes {
+			return addedComputedOptionalRequired(path.Block(b.Name, k), dataSourceBlockComputedOptionalRequired(b))
+		},
+		func(k int, oldBlock, newBlock datasource.Block) Changes {
+			return diffDataSourceBlock(path.Block(newBlock.Name, k), oldBlock, newBlock)
+		},
+	)
+}
+
+// diffDataSourceBlock returns the Changes between the old and new block.
+func diffDataSourceBlock(path schema.Path, oldBlock, newBlock datasource.Block) Changes {
+	oldKind, newKind := dataSourceBlockKind(oldBlock), dataSourceBlockKind(newBlock)
+
+	if oldKind != newKind {
+		return changed(path, false, ChangeClassificationBreaking, "type changed from "+oldKind+" to "+newKind)
+	}
+
+	changes := diffComputedOptionalRequired(path, dataSourceBlockComputedOptionalRequired(oldBlock), dataSourceBlockComputedOptionalRequired(newBlock))
+
+	switch {
+	case oldBlock.ListNested != nil:
+		changes = append(changes, changed(path, oldBlock.ListNested.CustomType.Equal(newBlock.ListNested.CustomType), ChangeClassificationSafe, "custom type changed")...)
+		changes = append(changes, changed(path, stringPointerEqual(oldBlock.ListNested.DeprecationMessage, newBlock.ListNested.DeprecationMessage), ChangeClassificationSafe, "deprecation message changed")...)
+		changes = append(changes, changed(path, stringPointerEqual(oldBlock.ListNested.Description, newBlock.ListNested.Description), ChangeClassificationSafe, "description changed")...)
+		changes = append(changes, diffBool(path, "sensitive", ChangeClassificationSafe, oldBlock.ListNested.Sensitive, newBlock.ListNested.Sensitive)...)
+		changes = append(changes, diffValidators(path, "validators", oldBlock.ListNested.Validators, newBlock.ListNested.Validators)...)
+		changes = append(changes, diffDataSourceNestedBlockObject(path.NestedObject("list_nested"), oldBlock.ListNested.NestedObject, newBlock.ListNested.NestedObject)...)
+	case oldBlock.SetNested != nil:
+		changes = append(changes, changed(path, oldBlock.SetNested.CustomType.Equal(newBlock.SetNested.CustomType), ChangeClassificationSafe, "custom type changed")...)
+		changes = append(changes, changed(path, stringPointerEqual(oldBlock.SetNested.DeprecationMessage, newBlock.SetNested.DeprecationMessage), ChangeClassificationSafe, "deprecation message changed")...)
+		changes = append(changes, changed(path, stringPointerEqual(oldBlock.SetNested.Description, newBlock.SetNested.Description), ChangeClassificationSafe, "description changed")...)
+		changes = append(changes, diffBool(path, "sensitive", ChangeClassificationSafe, oldBlock.SetNested.Sensitive, newBlock.SetNested.Sensitive)...)
+		changes = append(changes, diffValidators(path, "validators", oldBlock.SetNested.Validators, newBlock.SetNested.Validators)...)
+		changes = append(changes, diffDataSourceNestedBlockObject(path.NestedObject("set_nested"), oldBlock.SetNested.NestedObject, newBlock.SetNested.NestedObject)...)
+	case oldBlock.SingleNested != nil:
+		changes = append(changes, changed(path, oldBlock.SingleNested.AssociatedExternalType.Equal(newBlock.SingleNested.AssociatedExternalType), ChangeClassificationSafe, "associated external type changed")...)
+		changes = append(changes, changed(path, oldBlock.SingleNested.CustomType.Equal(newBlock.SingleNested.CustomType), ChangeClassificationSafe, "custom type changed")...)
+		changes = append(changes, changed(path, stringPointerEqual(oldBlock.SingleNested.DeprecationMessage, newBlock.SingleNested.DeprecationMessage), ChangeClassificationSafe, "deprecation message changed")...)
+		changes = append(changes, changed(path, stringPointerEqual(oldBlock.SingleNested.Description, newBlock.SingleNested.Description), ChangeClassificationSafe, "description changed")...)
+		changes = append(changes, diffBool(path, "sensitive", ChangeClassificationSafe, oldBlock.SingleNested.Sensitive, newBlock.SingleNested.Sensitive)...)
+		changes = append(changes, diffValidators(path, "validators", oldBlock.SingleNested.Validators, newBlock.SingleNested.Validators)...)
+		changes = append(changes, diffDataSourceAttributes(path.NestedObject("single_nested"), oldBlock.SingleNested.Attributes, newBlock.SingleNested.Attributes)...)
+		changes = append(changes, diffDataSourceBlocks(path.NestedObject("single_nested"), oldBlock.SingleNested.Blocks, newBlock.SingleNested.Blocks)...)
+	}
+
+	return changes
+}
+
+// diffDataSourceNestedBlockObject returns the Changes between the old and new
+// nested block objects.
+func diffDataSourceNestedBlockObject(path schema.Path, oldObject, newObject datasource.NestedBlockObject) Changes {
+	changes := changed(path, oldObject.AssociatedExternalType.Equal(newObject.AssociatedExternalType), ChangeClassificationSafe, "associated external type changed")
+
+	changes = append(changes, changed(path, oldObject.CustomType.Equal(newObject.CustomType), ChangeClassificationSafe, "custom type changed")...)
+	changes = append(changes, diffValidators(path, "validators", oldObject.Validators, newObject.Validators)...)
+	changes = append(changes, diffDataSourceAttributes(path, oldObject.Attributes, newObject.Attributes)...)
+	changes = append(changes, diffDataSourceBlocks(path, oldObject.Blocks, newObject.Blocks)...)
+
+	return changes
+}
+
+// dataSourceBlockKind returns the name of the block type which is set.
+func dataSourceBlockKind(b datasource.Block) string {
+	switch {
+	case b.ListNested != nil:
+		return "list_nested"
+	case b.SetNested != nil:
+		return "set_nested"
+	case b.SingleNested != nil:
+		return "single_nested"
+	}
+
+	return ""
+}
+
+// dataSourceBlockComputedOptionalRequired returns the ComputedOptionalRequired of
+// the block type which is set.
+func dataSourceBlockComputedOptionalRequired(b datasource.Block) schema.ComputedOptionalRequired {
+	switch {
+	case b.ListNested != nil:
+		return b.ListNested.ComputedOptionalRequired
+	case b.SetNested != nil:
+		return b.SetNested.ComputedOptionalRequired
+	case b.SingleNested != nil:
+		return b.SingleNested.ComputedOptionalRequired
+	}
+
+	return ""
+}
@@ -0,0 +1,333 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package spec
+
+import (
+	"reflect"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+// diffResources returns the Changes between the old and new resources.
+func diffResources(oldResources, newResources resource.Resources) Changes {
+	return diffByName(
+		oldResources,
+		newResources,
+		func(r resource.Resource) string { return r.Name },
+		func(k int, r resource.Resource) Changes { return removed(schema.Path{}.Resource(r.Name, k)) },
+		func(k int, r resource.Resource) Changes {
+			return added(schema.Path{}.Resource(r.Name, k), ChangeClassificationSafe, "added")
+		},
+		func(k int, oldResource, newResource resource.Resource) Changes {
+			var oldSchema, newSchema resource.Schema
+
+			if oldResource.Schema != nil {
+				oldSchema = *oldResource.Schema
+			}
+
+			if newResource.Schema != nil {
+				newSchema = *newResource.Schema
+			}
+
+			changes := diffResourceSchema(schema.Path{}.Resource(newResource.Name, k).Schema(), oldSchema, newSchema)
+
+			return append(changes, diffResourceIdentity(schema.Path{}.Resource(newResource.Name, k).Identity(), oldResource.Identity, newResource.Identity)...)
+		},
+	)
+}
+
+// diffResourceIdentity returns the Changes between the old and new resource
+// identity schemas. Adding an identity is safe, while removing or changing an
+// identity is breaking.
+func diffResourceIdentity(path schema.Path, oldIdentity, newIdentity *resource.IdentitySchema) Changes {
+	switch {
+	case oldIdentity == nil && newIdentity == nil:
+		return nil
+	case oldIdentity == nil:
+		return added(path, ChangeClassificationSafe, "added")
+	case newIdentity == nil:
+		return removed(path)
+	}
+
+	changes := diffVersion(path, oldIdentity.Version, newIdentity.Version, ChangeClassificationBreaking)
+
+	changes = append(changes, diffByName(
+		oldIdentity.Attributes,
+		newIdentity.Attributes,
+		func(a resource.IdentityAttribute) string { return a.Name },
+		func(k int, a resource.IdentityAttribute) Changes { return removed(path.Attribute(a.Name, k)) },
+		func(k int, a resource.IdentityAttribute) Changes {
+			return added(path.Attribute(a.Name, k), ChangeClassificationBreaking, "added")
+		},
+		func(k int, oldAttribute, newAttribute resource.IdentityAttribute) Changes {
+			return changed(path.Attribute(newAttribute.Name, k), reflect.DeepEqual(oldAttribute, newAttribute), ChangeClassificationBreaking, "changed")
+		},
+	)...)
+
+	return changes
+}
+
+// diffStateUpgraders returns the Changes between the old and new state
+// upgraders, matched by prior version. Removing a state upgrader is breaking,
+// adding a state upgrader is safe, and changing a state upgrader requires a
+// state upgrade.
+func diffStateUpgraders(path schema.Path, oldStateUpgraders, newStateUpgraders resource.StateUpgraders) Changes {
+	return diffByName(
+		oldStateUpgraders,
+		newStateUpgraders,
+		func(s resource.StateUpgrader) string { return strconv.FormatInt(s.PriorVersion, 10) },
+		func(k int, s resource.StateUpgrader) Changes { return removed(path.StateUpgrader(s.PriorVersion, k)) },
+		func(k int, s resource.StateUpgrader) Changes {
+			return added(path.StateUpgrader(s.PriorVersion, k), ChangeClassificationSafe, "added")
+		},
+		func(k int, oldStateUpgrader, newStateUpgrader resource.StateUpgrader) Changes {
+			return changed(path.StateUpgrader(newStateUpgrader.PriorVersion, k), reflect.DeepEqual(oldStateUpgrader, newStateUpgrader), ChangeClassificationStateUpgrade, "changed")
+		},
+	)
+}
+
+// diffResourceSchema returns the Changes between the old and new resource
+// schemas.
+func diffResourceSchema(path schema.Path, oldSchema, newSchema resource.Schema) Changes {
+	changes := diffValidators(path, "config validators", oldSchema.ConfigValidators, newSchema.ConfigValidators)
+
+	changes = append(changes, changed(path, stringPointerEqual(oldSchema.DeprecationMessage, newSchema.DeprecationMessage), ChangeClassificationSafe, "deprecation message changed")...)
+	changes = append(changes, changed(path, stringPointerEqual(oldSchema.Description, newSchema.Description), ChangeClassificationSafe, "description changed")...)
+	changes = append(changes, changed(path, stringPointerEqual(oldSchema.MarkdownDescription, newSchema.MarkdownDescription), ChangeClassificationSafe, "markdown description changed")...)
+	changes = append(changes, diffVersion(path, oldSchema.Version, newSchema.Version, ChangeClassificationStateUpgrade)...)
+	changes = append(changes, diffStateUpgraders(path, oldSchema.StateUpgraders, newSchema.StateUpgraders)...)
+	changes = append(changes, diffResourceAttributes(path, oldSchema.Attributes, newSchema.Attributes)...)
+	changes = append(changes, diffResourceBlocks(path, oldSchema.Blocks, newSchema.Blocks)...)
+
+	return changes
+}
+
+// diffResourceAttributes returns the Changes between the old and new
+// attributes of the object at the given path.
+func diffResourceAttributes(path schema.Path, oldAttributes, newAttributes resource.Attributes) Changes {
+	return diffByName(
+		oldAttributes,
+		newAttributes,
+		resource.Attribute.GetName,
+		func(k int, a resource.Attribute) Changes { return removed(path.Attribute(a.Name, k)) },
+		func(k int, a resource.Attribute) Changes {
+			return addedAttribute(path.Attribute(a.Name, k), a.TypedAttribute())
+		},
+		func(k int, oldAttribute, newAttribute resource.Attribute) Changes {
+			return diffResourceAttribute(path.Attribute(newAttribute.Name, k), oldAttribute, newAttribute)
+		},
+	)
+}
+
+// diffResourceAttribute returns the Changes between the old and new attribute.
+func diffResourceAttribute(path schema.Path, oldAttribute, newAttribute resource.Attribute) Changes {
+	changes, ok := diffTypedAttribute(path, oldAttribute.TypedAttribute(), newAttribute.TypedAttribute())
+
+	if !ok {
+		return changes
+	}
+
+	switch {
+	case oldAttribute.Bool != nil:
+		changes = append(changes, diffDefault(path, oldAttribute.Bool.Default != nil, newAttribute.Bool.Default != nil, oldAttribute.Bool.Default.Equal(newAttribute.Bool.Default))...)
+		changes = append(changes, changed(path, oldAttribute.Bool.PlanModifiers.Equal(newAttribute.Bool.PlanModifiers), ChangeClassificationSafe, "plan modifiers changed")...)
+		changes = append(changes, diffValidators(path, "validators", oldAttribute.Bool.Validators, newAttribute.Bool.Validators)...)
+		changes = append(changes, diffBool(path, "write only", ChangeClassificationBreaking, oldAttribute.Bool.WriteOnly, newAttribute.Bool.WriteOnly)...)
+	case oldAttribute.Dynamic != nil:
+		changes = append(changes, diffDefault(path, oldAttribute.Dynamic.Default != nil, newAttribute.Dynamic.Default != nil, oldAttribute.Dynamic.Default.Equal(newAttribute.Dynamic.Default))...)
+		changes = append(changes, changed(path, oldAttribute.Dynamic.PlanModifiers.Equal(newAttribute.Dynamic.PlanModifiers), ChangeClassificationSafe, "plan modifiers changed")...)
+		changes = append(changes, diffValidators(path, "validators", oldAttribute.Dynamic.Validators, newAttribute.Dynamic.Validators)...)
+		changes = append(changes, diffBool(path, "write only", ChangeClassificationBreaking, oldAttribute.Dynamic.WriteOnly, newAttribute.Dynamic.WriteOnly)...)
+	case oldAttribute.Float64 != nil:
+		changes = append(changes, diffDefault(path, oldAttribute.Float64.Default != nil, newAttribute.Float64.Default != nil, oldAttribute.Float64.Default.Equal(newAttribute.Float64.Default))...)
+		changes = append(changes, changed(path, oldAttribute.Float64.PlanModifiers.Equal(newAttribute.Float64.PlanModifiers), ChangeClassificationSafe, "plan modifiers changed")...)
+		changes = append(changes, diffValidators(path, "validators", oldAttribute.Float64.Validators, newAttribute.Float64.Validators)...)
+		changes = append(changes, diffBool(path, "write only", ChangeClassificationBreaking, oldAttribute.Float64.WriteOnly, newAttribute.Float64.WriteOnly)...)
+	case oldAttribute.Int64 != nil:
+		changes = append(changes, diffDefault(path, oldAttribute.Int64.Default != nil, newAttribute.Int64.Default != nil, oldAttribute.Int64.Default.Equal(newAttribute.Int64.Default))...)
+		changes = append(changes, changed(path, oldAttribute.Int64.PlanModifiers.Equal(newAttribute.Int64.PlanModifiers), ChangeClassificationSafe, "plan modifiers changed")...)
+		changes = append(changes, diffValidators(path, "validators", oldAttribute.Int64.Validators, newAttribute.Int64.Validators)...)
+		changes = append(changes, diffBool(path, "write only", ChangeClassificationBreaking, oldAttribute.Int64.WriteOnly, newAttribute.Int64.WriteOnly)...)
+	case oldAttribute.List != nil:
+		changes = append(changes, changed(path, oldAttribute.List.ElementType.Equal(newAttribute.List.ElementType), ChangeClassificationBreaking, "element type changed")...)
+		changes = append(changes, diffDefault(path, oldAttribute.List.Default != nil, newAttribute.List.Default != nil, oldAttribute.List.Default.Equal(newAttribute.List.Default))...)
+		changes = append(changes, changed(path, oldAttribute.List.PlanModifiers.Equal(newAttribute.List.PlanModifiers), ChangeClassificationSafe, "plan modifiers changed")...)
+		changes = append(changes, diffValidators(path, "validators", oldAttribute.List.Validators, newAttribute.List.Validators)...)
+		changes = append(changes, diffBool(path, "write only", ChangeClassificationBreaking, oldAttribute.List.WriteOnly, newAttribute.List.WriteOnly)...)
+	case oldAttribute.ListNested != nil:
+		changes = append(changes, diffDefault(path, oldAttribute.ListNested.Default != nil, newAttribute.ListNested.Default != nil, oldAttribute.ListNested.Default.Equal(newAttribute.ListNested.Default))...)
+		changes = append(changes, changed(path, oldAttribute.ListNested.PlanModifiers.Equal(newAttribute.ListNested.PlanModifiers), ChangeClassificationSafe, "plan modifiers changed")...)
+		changes = append(changes, diffValidators(path, "validators", oldAttribute.ListNested.Validators, newAttribute.ListNested.Validators)...)
+		changes = append(changes, diffBool(path, "write only", ChangeClassificationBreaking, oldAttribute.ListNested.WriteOnly, newAttribute.ListNested.WriteOnly)...)
+		changes = append(changes, diffResourceNestedAttributeObject(path.NestedObject("list_nested"), oldAttribute.ListNested.NestedObject, newAttribute.ListNested.NestedObject)...)
+	case oldAttribute.Map != nil:
+		changes = append(changes, changed(path, oldAttribute.Map.ElementType.Equal(newAttribute.Map.ElementType), ChangeClassificationBreaking, "element type changed")...)
+		changes = append(changes, diffDefault(path, oldAttribute.Map.Default != nil, newAttribute.Map.Default != nil, oldAttribute.Map.Default.Equal(newAttribute.Map.Default))...)
+		changes = append(changes, changed(path, oldAttribute.Map.PlanModifiers.Equal(newAttribute.Map.PlanModifiers), ChangeClassificationSafe, "plan modifiers changed")...)
+		changes = append(changes, diffValidators(path, "validators", oldAttribute.Map.Validators, newAttribute.Map.Validators)...)
+		changes = append(changes, diffBool(path, "write only", ChangeClassificationBreaking, oldAttribute.Map.WriteOnly, newAttribute.Map.WriteOnly)...)
+	case oldAttribute.MapNested != nil:
+		changes = append(changes, diffDefault(path, oldAttribute.MapNested.Default != nil, newAttribute.MapNested.Default != nil, oldAttribute.MapNested.Default.Equal(newAttribute.MapNested.Default))...)
+		changes = append(changes, changed(path, oldAttribute.MapNested.PlanModifiers.Equal(newAttribute.MapNested.PlanModifiers), ChangeClassificationSafe, "plan modifiers changed")...)
+		changes = append(changes, diffValidators(path, "validators", oldAttribute.MapNested.Validators, newAttribute.MapNested.Validators)...)
+		changes = append(changes, diffBool(path, "write only", ChangeClassificationBreaking, oldAttribute.MapNested.WriteOnly, newAttribute.MapNested.WriteOnly)...)
+		changes = append(changes, diffResourceNestedAttributeObject(path.NestedObject("map_nested"), oldAttribute.MapNested.NestedObject, newAttribute.MapNested.NestedObject)...)
+	case oldAttribute.Number != nil:
+		changes = append(changes, diffDefault(path, oldAttribute.Number.Default != nil, newAttribute.Number.Default != nil, oldAttribute.Number.Default.Equal(newAttribute.Number.Default))...)
+		changes = append(changes, changed(path, oldAttribute.Number.PlanModifiers.Equal(newAttribute.Number.PlanModifiers), ChangeClassificationSafe, "plan modifiers changed")...)
+		changes = append(changes, diffValidators(path, "validators", oldAttribute.Number.Validators, newAttribute.Number.Validators)...)
+		changes = append(changes, diffBool(path, "write only", ChangeClassificationBreaking, oldAttribute.Number.WriteOnly, newAttribute.Number.WriteOnly)...)
+	case oldAttribute.Object != nil:
+		changes = append(changes, changed(path, oldAttribute.Object.AttributeTypes.Equal(newAttribute.Object.AttributeTypes), ChangeClassificationBreaking, "attribute types changed")...)
+		changes = append(changes, diffDefault(path, oldAttribute.Object.Default != nil, newAttribute.Object.Default != nil, oldAttribute.Object.Default.Equal(newAttribute.Object.Default))...)
+		changes = append(changes, changed(path, oldAttribute.Object.PlanModifiers.Equal(newAttribute.Object.PlanModifiers), ChangeClassificationSafe, "plan modifiers changed")...)
+		changes = append(changes, diffValidators(path, "validators", oldAttribute.Object.Validators, newAttribute.Object.Validators)...)
+		changes = append(changes, diffBool(path, "write only", ChangeClassificationBreaking, oldAttribute.Object.WriteOnly, newAttribute.Object.WriteOnly)...)
+	case oldAttribute.Set != nil:
+		changes = append(changes, changed(path, oldAttribute.Set.ElementType.Equal(newAttribute.Set.ElementType), ChangeClassificationBreaking, "element type changed")...)
+		changes = append(changes, diffDefault(path, oldAttribute.Set.Default != nil, newAttribute.Set.Default != nil, oldAttribute.Set.Default.Equal(newAttribute.Set.Default))...)
+		changes = append(changes, changed(path, oldAttribute.Set.PlanModifiers.Equal(newAttribute.Set.PlanModifiers), ChangeClassificationSafe, "plan modifiers changed")...)
+		changes = append(changes, diffValidators(path, "validators", oldAttribute.Set.Validators, newAttribute.Set.Validators)...)
+		changes = append(changes, diffBool(path, "write only", ChangeClassificationBreaking, oldAttribute.Set.WriteOnly, newAttribute.Set.WriteOnly)...)
+	case oldAttribute.SetNested != nil:
+		changes = append(changes, diffDefault(path, oldAttribute.SetNested.Default != nil, newAttribute.SetNested.Default != nil, oldAttribute.SetNested.Default.Equal(newAttribute.SetNested.Default))...)
+		changes = append(changes, changed(path, oldAttribute.SetNested.PlanModifiers.Equal(newAttribute.SetNested.PlanModifiers), ChangeClassificationSafe, "plan modifiers changed")...)
+		changes = append(changes, diffValidators(path, "validators", oldAttribute.SetNested.Validators, newAttribute.SetNested.Validators)...)
+		changes = append(changes, diffBool(path, "write only", ChangeClassificationBreaking, oldAttribute.SetNested.WriteOnly, newAttribute.SetNested.WriteOnly)...)
+		changes = append(changes, diffResourceNestedAttributeObject(path.NestedObject("set_nested"), oldAttribute.SetNested.NestedObject, newAttribute.SetNested.NestedObject)...)
+	case oldAttribute.SingleNested != nil:
+		changes = append(changes, diffDefault(path, oldAttribute.SingleNested.Default != nil, newAttribute.SingleNested.Default != nil, oldAttribute.SingleNested.Default.Equal(newAttribute.SingleNested.Default))...)
+		changes = append(changes, changed(path, oldAttribute.SingleNested.PlanModifiers.Equal(newAttribute.SingleNested.PlanModifiers), ChangeClassificationSafe, "plan modifiers changed")...)
+		changes = append(changes, diffValidators(path, "validators", oldAttribute.SingleNested.Validators, newAttribute.SingleNested.Validators)...)
+		changes = append(changes, diffBool(path, "write only", ChangeClassificationBreaking, oldAttribute.SingleNested.WriteOnly, newAttribute.SingleNested.WriteOnly)...)
+		changes = append(changes, diffResourceAttributes(path.NestedObject("single_nested"), oldAttribute.SingleNested.Attributes, newAttribute.SingleNested.Attributes)...)
+	case oldAttribute.String != nil:
+		changes = append(changes, diffDefault(path, oldAttribute.String.Default != nil, newAttribute.String.Default != nil, oldAttribute.String.Default.Equal(newAttribute.String.Default))...)
+		changes = append(changes, changed(path, oldAttribute.String.PlanModifiers.Equal(newAttribute.String.PlanModifiers), ChangeClassificationSafe, "plan modifiers changed")...)
+		changes = append(changes, diffValidators(path, "validators", oldAttribute.String.Validators, newAttribute.String.Validators)...)
+		changes = append(changes, diffBool(path, "write only", ChangeClassificationBreaking, oldAttribute.String.WriteOnly, newAttribute.String.WriteOnly)...)
+	}
+
+	return changes
+}
+
+// diffResourceNestedAttributeObject returns the Changes between the old and new
+// nested attribute objects.
+func diffResourceNestedAttributeObject(path schema.Path, oldObject, newObject resource.NestedAttributeObject) Changes {
+	changes := changed(path, oldObject.AssociatedExternalType.Equal(newObject.AssociatedExternalType), ChangeClassificationSafe, "associated external type changed")
+
+	changes = append(changes, changed(path, oldObject.CustomType.Equal(newObject.CustomType), ChangeClassificationSafe, "custom type changed")...)
+	changes = append(changes, changed(path, oldObject.PlanModifiers.Equal(newObject.PlanModifiers), ChangeClassificationSafe, "plan modifiers changed")...)
+	changes = append(changes, diffValidators(path, "validators", oldObject.Validators, newObject.Validators)...)
+	changes = append(changes, diffResourceAttributes(path, oldObject.Attributes, newObject.Attributes)...)
+
+	return changes
+}
+
+// diffResourceBlocks returns the Changes between the old and new blocks of the
+// object at the given path.
+func diffResourceBlocks(path schema.Path, oldBlocks, newBlocks resource.Blocks) Changes {
+	return diffByName(
+		oldBlocks,
+		newBlocks,
+		func(b resource.Block) string { return b.Name },
+		func(k int, b resource.Block) Changes { return removed(path.Block(b.Name, k)) },
+		func(k int, b resource.Block) Changes {
+			return addedComputedOptionalRequired(path.Block(b.Name, k), resourceBlockComputedOptionalRequired(b))
+		},
+		func(k int, oldBlock, newBlock resource.Block) Changes {
+			return diffResourceBlock(path.Block(newBlock.Name, k), oldBlock, newBlock)
+		},
+	)
+}
+
+// diffResourceBlock returns the Changes between the old and new block.
+func diffResourceBlock(path schema.Path, oldBlock, newBlock resource.Block) Changes {
+	oldKind, newKind := resourceBlockKind(oldBlock), resourceBlockKind(newBlock)
+
+	if oldKind != newKind {
+		return changed(path, false, ChangeClassificationBreaking, "type changed from "+oldKind+" to "+newKind)
+	}
+
+	changes := diffComputedOptionalRequired(path, resourceBlockComputedOptionalRequired(oldBlock), resourceBlockComputedOptionalRequired(newBlock))
+
+	switch {
+	case oldBlock.ListNested != nil:
+		changes = append(changes, changed(path, oldBlock.ListNested.CustomType.Equal(newBlock.ListNested.CustomType), ChangeClassificationSafe, "custom type changed")...)
+		changes = append(changes, diffDefault(path, oldBlock.ListNested.Default != nil, newBlock.ListNested.Default != nil, oldBlock.ListNested.Default.Equal(newBlock.ListNested.Default))...)
+		changes = append(changes, changed(path, stringPointerEqual(oldBlock.ListNested.DeprecationMessage, newBlock.ListNested.DeprecationMessage), ChangeClassificationSafe, "deprecation message changed")...)
+		changes = append(changes, changed(path, stringPointerEqual(oldBlock.ListNested.Description, newBlock.ListNested.Description), ChangeClassificationSafe, "description changed")...)
+		changes = append(changes, changed(path, oldBlock.ListNested.PlanModifiers.Equal(newBlock.ListNested.PlanModifiers), ChangeClassificationSafe, "plan modifiers changed")...)
+		changes = append(changes, diffBool(path, "sensitive", ChangeClassificationSafe, oldBlock.ListNested.Sensitive, newBlock.ListNested.Sensitive)...)
+		changes = append(changes, diffValidators(path, "validators", oldBlock.ListNested.Validators, newBlock.ListNested.Validators)...)
+		changes = append(changes, diffResourceNestedBlockObject(path.NestedObject("list_nested"), oldBlock.ListNested.NestedObject, newBlock.ListNested.NestedObject)...)
+	case oldBlock.SetNested != nil:
+		changes = append(changes, changed(path, oldBlock.SetNested.CustomType.Equal(newBlock.SetNested.CustomType), ChangeClassificationSafe, "custom type changed")...)
+		changes = append(changes, diffDefault(path, oldBlock.SetNested.Default != nil, newBlock.SetNested.Default != nil, oldBlock.SetNested.Default.Equal(newBlock.SetNested.Default))...)
+		changes = append(changes, changed(path, stringPointerEqual(oldBlock.SetNested.DeprecationMessage, newBlock.SetNested.DeprecationMessage), ChangeClassificationSafe, "deprecation message changed")...)
+		changes = append(changes, changed(path, stringPointerEqual(oldBlock.SetNested.Description, newBlock.SetNested.Description), ChangeClassificationSafe, "description changed")...)
+		changes = append(changes, changed(path, oldBlock.SetNested.PlanModifiers.Equal(newBlock.SetNested.PlanModifiers), ChangeClassificationSafe, "plan modifiers changed")...)
+		changes = append(changes, diffBool(path, "sensitive", ChangeClassificationSafe, oldBlock.SetNested.Sensitive, newBlock.SetNested.Sensitive)...)
+		changes = append(changes, diffValidators(path, "validators", oldBlock.SetNested.Validators, newBlock.SetNested.Validators)...)
+		changes = append(changes, diffResourceNestedBlockObject(path.NestedObject("set_nested"), oldBlock.SetNested.NestedObject, newBlock.SetNested.NestedObject)...)
+	case oldBlock.SingleNested != nil:
+		changes = append(changes, changed(path, oldBlock.SingleNested.AssociatedExternalType.Equal(newBlock.SingleNested.AssociatedExternalType), ChangeClassificationSafe, "associated external type changed")...)
+		changes = append(changes, changed(path, oldBlock.SingleNested.CustomType.Equal(newBlock.SingleNested.CustomType), ChangeClassificationSafe, "custom type changed")...)
+		changes = append(changes, diffDefault(path, oldBlock.SingleNested.Default != nil, newBlock.SingleNested.Default != nil, oldBlock.SingleNested.Default.Equal(newBlock.SingleNested.Default))...)
+		changes = append(changes, changed(path, stringPointerEqual(oldBlock.SingleNested.DeprecationMessage, newBlock.SingleNested.DeprecationMessage), ChangeClassificationSafe, "deprecation message changed")...)
+		changes = append(changes, changed(path, stringPointerEqual(oldBlock.SingleNested.Description, newBlock.SingleNested.Description), ChangeClassificationSafe, "description changed")...)
+		changes = append(changes, changed(path, oldBlock.SingleNested.PlanModifiers.Equal(newBlock.SingleNested.PlanModifiers), ChangeClassificationSafe, "plan modifiers changed")...)
+		changes = append(changes, diffBool(path, "sensitive", ChangeClassificationSafe, oldBlock.SingleNested.Sensitive, newBlock.SingleNested.Sensitive)...)
+		changes = append(changes, diffValidators(path, "validators", oldBlock.SingleNested.Validators, newBlock.SingleNested.Validators)...)
+		changes = append(changes, diffResourceAttributes(path.NestedObject("single_nested"), oldBlock.SingleNested.Attributes, newBlock.SingleNested.Attributes)...)
+		changes = append(changes, diffResourceBlocks(path.NestedObject("single_nested"), oldBlock.SingleNested.Blocks, newBlock.SingleNested.Blocks)...)
+	}
+
+	return changes
+}
+
+// diffResourceNestedBlockObject returns the Changes between the old and new
+// nested block objects.
+func diffResourceNestedBlockObject(path schema.Path, oldObject, newObject resource.NestedBlockObject) Changes {
+	changes := changed(path, oldObject.AssociatedExternalType.Equal(newObject.AssociatedExternalType), ChangeClassificationSafe, "associated external type changed")
+
+	changes = append(changes, changed(path, oldObject.CustomType.Equal(newObject.CustomType), ChangeClassificationSafe, "custom type changed")...)
+	changes = append(changes, changed(path, oldObject.PlanModifiers.Equal(newObject.PlanModifiers), ChangeClassificationSafe, "plan modifiers changed")...)
+	changes = append(changes, diffValidators(path, "validators", oldObject.Validators, newObject.Validators)...)
+	changes = append(changes, diffResourceAttributes(path, oldObject.Attributes, newObject.Attributes)...)
+	changes = append(changes, diffResourceBlocks(path, oldObject.Blocks, newObject.Blocks)...)
+
+	return changes
+}
+
+// resourceBlockKind returns the name of the block type which is set.
+func resourceBlockKind(b resource.Block) string {
+	switch {
+	case b.ListNested != nil:
+		return "list_nested"
+	case b.SetNested != nil:
+		return "set_nested"
+	case b.SingleNested != nil:
+		return "single_nested"
+	}
+
+	return ""
+}
+
+// resourceBlockComputedOptionalRequired returns the ComputedOptionalRequired of
+// the block type which is set.
+func resourceBlockComputedOptionalRequired(b resource.Block) schema.ComputedOptionalRequired {
+	switch {
+	case b.ListNested != nil:
+		return b.ListNested.ComputedOptionalRequired
+	case b.SetNested != nil:
+		return b.SetNested.ComputedOptionalRequired
+	case b.SingleNested != nil:
+		return b.SingleNested.ComputedOptionalRequired
+	}
+
+	return ""
+}
@@ -0,0 +1,920 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package spec_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/datasource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	resourceSpec := func(attributes resource.Attributes, blocks resource.Blocks) spec.Specification {
+		return spec.Specification{
+			Resources: resource.Resources{
+				{
+					Name: "example",
+					Schema: &resource.Schema{
+						Attributes: attributes,
+						Blocks:     blocks,
+					},
+				},
+			},
+		}
+	}
+
+	resourcePath := schema.Path{}.Resource("example", 0).Schema()
+
+	testCases := map[string]struct {
+		oldSpec  spec.Specification
+		newSpec  spec.Specification
+		expected spec.Changes
+	}{
+		"empty": {},
+		"equal": {
+			oldSpec: resourceSpec(resource.Attributes{
+				{
+					Name: "name",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+			}, nil),
+			newSpec: resourceSpec(resource.Attributes{
+				{
+					Name: "name",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+			}, nil),
+		},
+		"resource-added-removed": {
+			oldSpec: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "old",
+					},
+				},
+			},
+			newSpec: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "new",
+					},
+				},
+			},
+			expected: spec.Changes{
+				{
+					Kind:           spec.ChangeKindRemoved,
+					Classification: spec.ChangeClassificationBreaking,
+					Path:           schema.Path{}.Resource("old", 0),
+					Detail:         "removed",
+				},
+				{
+					Kind:           spec.ChangeKindAdded,
+					Classification: spec.ChangeClassificationSafe,
+					Path:           schema.Path{}.Resource("new", 0),
+					Detail:         "added",
+				},
+			},
+		},
+		"resource-version-increased": {
+			oldSpec: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "example",
+						Schema: &resource.Schema{
+							Version: pointer(int64(1)),
+						},
+					},
+				},
+			},
+			newSpec: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "example",
+						Schema: &resource.Schema{
+							Version: pointer(int64(2)),
+						},
+					},
+				},
+			},
+			expected: spec.Changes{
+				{
+					Kind:           spec.ChangeKindChanged,
+					Classification: spec.ChangeClassificationStateUpgrade,
+					Path:           resourcePath,
+					Detail:         "version changed from 1 to 2",
+				},
+			},
+		},
+		"resource-version-decreased": {
+			oldSpec: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "example",
+						Schema: &resource.Schema{
+							Version: pointer(int64(1)),
+						},
+					},
+				},
+			},
+			newSpec: resourceSpec(nil, nil),
+			expected: spec.Changes{
+				{
+					Kind:           spec.ChangeKindChanged,
+					Classification: spec.ChangeClassificationBreaking,
+					Path:           resourcePath,
+					Detail:         "version changed from 1 to 0",
+				},
+			},
+		},
+		"resource-state-upgraders-changed": {
+			oldSpec: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "example",
+						Schema: &resource.Schema{
+							StateUpgraders: resource.StateUpgraders{
+								{
+									PriorVersion:     0,
+									SchemaDefinition: "resource_example_v0.json",
+								},
+								{
+									PriorVersion:     1,
+									SchemaDefinition: "resource_example_v1.json",
+								},
+							},
+							Version: pointer(int64(2)),
+						},
+					},
+				},
+			},
+			newSpec: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "example",
+						Schema: &resource.Schema{
+							StateUpgraders: resource.StateUpgraders{
+								{
+									PriorVersion:     1,
+									SchemaDefinition: "resource_example_v1_fixed.json",
+								},
+								{
+									PriorVersion:     2,
+									SchemaDefinition: "resource_example_v2.json",
+								},
+							},
+							Version: pointer(int64(3)),
+						},
+					},
+				},
+			},
+			expected: spec.Changes{
+				{
+					Kind:           spec.ChangeKindChanged,
+					Classification: spec.ChangeClassificationStateUpgrade,
+					Path:           resourcePath,
+					Detail:         "version changed from 2 to 3",
+				},
+				{
+					Kind:           spec.ChangeKindRemoved,
+					Classification: spec.ChangeClassificationBreaking,
+					Path:           resourcePath.StateUpgrader(0, 0),
+					Detail:         "removed",
+				},
+				{
+					Kind:           spec.ChangeKindChanged,
+					Classification: spec.ChangeClassificationStateUpgrade,
+					Path:           resourcePath.StateUpgrader(1, 0),
+					Detail:         "changed",
+				},
+				{
+					Kind:           spec.ChangeKindAdded,
+					Classification: spec.ChangeClassificationSafe,
+					Path:           resourcePath.StateUpgrader(2, 1),
+					Detail:         "added",
+				},
+			},
+		},
+		"resource-identity-added": {
+			oldSpec: resourceSpec(nil, nil),
+			newSpec: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "example",
+						Identity: &resource.IdentitySchema{
+							Attributes: resource.IdentityAttributes{
+								{
+									Name:   "id",
+									String: &resource.IdentityStringAttribute{},
+								},
+							},
+						},
+						Schema: &resource.Schema{},
+					},
+				},
+			},
+			expected: spec.Changes{
+				{
+					Kind:           spec.ChangeKindAdded,
+					Classification: spec.ChangeClassificationSafe,
+					Path:           schema.Path{}.Resource("example", 0).Identity(),
+					Detail:         "added",
+				},
+			},
+		},
+		"resource-identity-removed": {
+			oldSpec: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "example",
+						Identity: &resource.IdentitySchema{
+							Attributes: resource.IdentityAttributes{
+								{
+									Name:   "id",
+									String: &resource.IdentityStringAttribute{},
+								},
+							},
+						},
+						Schema: &resource.Schema{},
+					},
+				},
+			},
+			newSpec: resourceSpec(nil, nil),
+			expected: spec.Changes{
+				{
+					Kind:           spec.ChangeKindRemoved,
+					Classification: spec.ChangeClassificationBreaking,
+					Path:           schema.Path{}.Resource("example", 0).Identity(),
+					Detail:         "removed",
+				},
+			},
+		},
+		"resource-identity-changed": {
+			oldSpec: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "example",
+						Identity: &resource.IdentitySchema{
+							Attributes: resource.IdentityAttributes{
+								{
+									Name:   "id",
+									String: &resource.IdentityStringAttribute{},
+								},
+								{
+									Name:   "region",
+									String: &resource.IdentityStringAttribute{},
+								},
+							},
+						},
+						Schema: &resource.Schema{},
+					},
+				},
+			},
+			newSpec: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "example",
+						Identity: &resource.IdentitySchema{
+							Attributes: resource.IdentityAttributes{
+								{
+									Name:  "id",
+									Int64: &resource.IdentityInt64Attribute{},
+								},
+								{
+									Name:   "account",
+									String: &resource.IdentityStringAttribute{},
+								},
+							},
+							Version: pointer(int64(1)),
+						},
+						Schema: &resource.Schema{},
+					},
+				},
+			},
+			expected: spec.Changes{
+				{
+					Kind:           spec.ChangeKindChanged,
+					Classification: spec.ChangeClassificationBreaking,
+					Path:           schema.Path{}.Resource("example", 0).Identity(),
+					Detail:         "version changed from 0 to 1",
+				},
+				{
+					Kind:           spec.ChangeKindRemoved,
+					Classification: spec.ChangeClassificationBreaking,
+					Path:           schema.Path{}.Resource("example", 0).Identity().Attribute("region", 1),
+					Detail:         "removed",
+				},
+				{
+					Kind:           spec.ChangeKindChanged,
+					Classification: spec.ChangeClassificationBreaking,
+					Path:           schema.Path{}.Resource("example", 0).Identity().Attribute("id", 0),
+					Detail:         "changed",
+				},
+				{
+					Kind:           spec.ChangeKindAdded,
+					Classification: spec.ChangeClassificationBreaking,
+					Path:           schema.Path{}.Resource("example", 0).Identity().Attribute("account", 1),
+					Detail:         "added",
+				},
+			},
+		},
+		"attribute-added-removed": {
+			oldSpec: resourceSpec(resource.Attributes{
+				{
+					Name: "removed",
+					Bool: &resource.BoolAttribute{
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			}, nil),
+			newSpec: resourceSpec(resource.Attributes{
+				{
+					Name: "optional",
+					Bool: &resource.BoolAttribute{
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+				{
+					Name: "required",
+					Bool: &resource.BoolAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+			}, nil),
+			expected: spec.Changes{
+				{
+					Kind:           spec.ChangeKindRemoved,
+					Classification: spec.ChangeClassificationBreaking,
+					Path:           resourcePath.Attribute("removed", 0),
+					Detail:         "removed",
+				},
+				{
+					Kind:           spec.ChangeKindAdded,
+					Classification: spec.ChangeClassificationSafe,
+					Path:           resourcePath.Attribute("optional", 0),
+					Detail:         "added",
+				},
+				{
+					Kind:           spec.ChangeKindAdded,
+					Classification: spec.ChangeClassificationBreaking,
+					Path:           resourcePath.Attribute("required", 1),
+					Detail:         "added as required",
+				},
+			},
+		},
+		"attribute-computed-optional-required": {
+			oldSpec: resourceSpec(resource.Attributes{
+				{
+					Name: "now_required",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+				{
+					Name: "now_optional",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+				{
+					Name: "now_computed",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+				{
+					Name: "computed_optional_now_optional",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+				{
+					Name: "computed_now_optional",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+					},
+				},
+			}, nil),
+			newSpec: resourceSpec(resource.Attributes{
+				{
+					Name: "now_required",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+				{
+					Name: "now_optional",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+				{
+					Name: "now_computed",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+					},
+				},
+				{
+					Name: "computed_optional_now_optional",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+				{
+					Name: "computed_now_optional",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			}, nil),
+			expected: spec.Changes{
+				{
+					Kind:           spec.ChangeKindChanged,
+					Classification: spec.ChangeClassificationBreaking,
+					Path:           resourcePath.Attribute("now_required", 0),
+					Detail:         "changed from optional to required",
+				},
+				{
+					Kind:           spec.ChangeKindChanged,
+					Classification: spec.ChangeClassificationSafe,
+					Path:           resourcePath.Attribute("now_optional", 1),
+					Detail:         "changed from required to optional",
+				},
+				{
+					Kind:           spec.ChangeKindChanged,
+					Classification: spec.ChangeClassificationBreaking,
+					Path:           resourcePath.Attribute("now_computed", 2),
+					Detail:         "changed from computed_optional to computed",
+				},
+				{
+					Kind:           spec.ChangeKindChanged,
+					Classification: spec.ChangeClassificationBreaking,
+					Path:           resourcePath.Attribute("computed_optional_now_optional", 3),
+					Detail:         "changed from computed_optional to optional",
+				},
+				{
+					Kind:           spec.ChangeKindChanged,
+					Classification: spec.ChangeClassificationBreaking,
+					Path:           resourcePath.Attribute("computed_now_optional", 4),
+					Detail:         "changed from computed to optional",
+				},
+			},
+		},
+		"attribute-type-changed": {
+			oldSpec: resourceSpec(resource.Attributes{
+				{
+					Name: "example",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Optional,
+						Description:              pointer("old"),
+					},
+				},
+			}, nil),
+			newSpec: resourceSpec(resource.Attributes{
+				{
+					Name: "example",
+					Int64: &resource.Int64Attribute{
+						ComputedOptionalRequired: schema.Optional,
+						Description:              pointer("new"),
+					},
+				},
+			}, nil),
+			expected: spec.Changes{
+				{
+					Kind:           spec.ChangeKindChanged,
+					Classification: spec.ChangeClassificationBreaking,
+					Path:           resourcePath.Attribute("example", 0),
+					Detail:         "type changed from string to int64",
+				},
+			},
+		},
+		"attribute-element-type-changed": {
+			oldSpec: resourceSpec(resource.Attributes{
+				{
+					Name: "example",
+					List: &resource.ListAttribute{
+						ComputedOptionalRequired: schema.Optional,
+						ElementType: schema.ElementType{
+							String: &schema.StringType{},
+						},
+					},
+				},
+			}, nil),
+			newSpec: resourceSpec(resource.Attributes{
+				{
+					Name: "example",
+					List: &resource.ListAttribute{
+						ComputedOptionalRequired: schema.Optional,
+						ElementType: schema.ElementType{
+							Int64: &schema.Int64Type{},
+						},
+					},
+				},
+			}, nil),
+			expected: spec.Changes{
+				{
+					Kind:           spec.ChangeKindChanged,
+					Classification: spec.ChangeClassificationBreaking,
+					Path:           resourcePath.Attribute("example", 0),
+					Detail:         "element type changed",
+				},
+			},
+		},
+		"attribute-default-added": {
+			oldSpec: resourceSpec(resource.Attributes{
+				{
+					Name: "example",
+					Int64: &resource.Int64Attribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+			}, nil),
+			newSpec: resourceSpec(resource.Attributes{
+				{
+					Name: "example",
+					Int64: &resource.Int64Attribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Default: &schema.Int64Default{
+							Static: pointer(int64(1)),
+						},
+						Description: pointer("description"),
+					},
+				},
+			}, nil),
+			expected: spec.Changes{
+				{
+					Kind:           spec.ChangeKindChanged,
+					Classification: spec.ChangeClassificationSafe,
+					Path:           resourcePath.Attribute("example", 0),
+					Detail:         "description changed",
+				},
+				{
+					Kind:           spec.ChangeKindChanged,
+					Classification: spec.ChangeClassificationStateUpgrade,
+					Path:           resourcePath.Attribute("example", 0),
+					Detail:         "default added",
+				},
+			},
+		},
+		"nested-attribute-changed": {
+			oldSpec: resourceSpec(resource.Attributes{
+				{
+					Name: "list_nested",
+					ListNested: &resource.ListNestedAttribute{
+						ComputedOptionalRequired: schema.Optional,
+						NestedObject: resource.NestedAttributeObject{
+							Attributes: resource.Attributes{
+								{
+									Name: "example",
+									String: &resource.StringAttribute{
+										ComputedOptionalRequired: schema.Optional,
+									},
+								},
+							},
+						},
+					},
+				},
+			}, nil),
+			newSpec: resourceSpec(resource.Attributes{
+				{
+					Name: "list_nested",
+					ListNested: &resource.ListNestedAttribute{
+						ComputedOptionalRequired: schema.Optional,
+						NestedObject: resource.NestedAttributeObject{
+							Attributes: resource.Attributes{
+								{
+									Name: "example",
+									String: &resource.StringAttribute{
+										ComputedOptionalRequired: schema.Optional,
+										Validators: schema.StringValidators{
+											{
+												Custom: &schema.CustomValidator{
+													SchemaDefinition: "my_validator.Validate()",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}, nil),
+			expected: spec.Changes{
+				{
+					Kind:           spec.ChangeKindChanged,
+					Classification: spec.ChangeClassificationBreaking,
+					Path:           resourcePath.Attribute("list_nested", 0).NestedObject("list_nested").Attribute("example", 0),
+					Detail:         "validators changed",
+				},
+			},
+		},
+		"attribute-validators-removed": {
+			oldSpec: resourceSpec(resource.Attributes{
+				{
+					Name: "removed",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Optional,
+						Validators: schema.StringValidators{
+							{
+								Custom: &schema.CustomValidator{
+									SchemaDefinition: "my_validator.Validate()",
+								},
+							},
+							{
+								Custom: &schema.CustomValidator{
+									SchemaDefinition: "other_validator.Validate()",
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "changed",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Optional,
+						Validators: schema.StringValidators{
+							{
+								Custom: &schema.CustomValidator{
+									SchemaDefinition: "my_validator.Validate()",
+								},
+							},
+						},
+					},
+				},
+			}, nil),
+			newSpec: resourceSpec(resource.Attributes{
+				{
+					Name: "removed",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Optional,
+						Validators: schema.StringValidators{
+							{
+								Custom: &schema.CustomValidator{
+									SchemaDefinition: "other_validator.Validate()",
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "changed",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Optional,
+						Validators: schema.StringValidators{
+							{
+								Custom: &schema.CustomValidator{
+									SchemaDefinition: "other_validator.Validate()",
+								},
+							},
+						},
+					},
+				},
+			}, nil),
+			expected: spec.Changes{
+				{
+					Kind:           spec.ChangeKindChanged,
+					Classification: spec.ChangeClassificationSafe,
+					Path:           resourcePath.Attribute("removed", 0),
+					Detail:         "validators removed",
+				},
+				{
+					Kind:           spec.ChangeKindChanged,
+					Classification: spec.ChangeClassificationBreaking,
+					Path:           resourcePath.Attribute("changed", 1),
+					Detail:         "validators changed",
+				},
+			},
+		},
+		"block-computed-optional-required": {
+			oldSpec: resourceSpec(nil, resource.Blocks{
+				{
+					Name: "optional_to_required",
+					ListNested: &resource.ListNestedBlock{
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			}),
+			newSpec: resourceSpec(nil, resource.Blocks{
+				{
+					Name: "optional_to_required",
+					ListNested: &resource.ListNestedBlock{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+				{
+					Name: "added_required",
+					SingleNested: &resource.SingleNestedBlock{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+			}),
+			expected: spec.Changes{
+				{
+					Kind:           spec.ChangeKindChanged,
+					Classification: spec.ChangeClassificationBreaking,
+					Path:           resourcePath.Block("optional_to_required", 0),
+					Detail:         "changed from optional to required",
+				},
+				{
+					Kind:           spec.ChangeKindAdded,
+					Classification: spec.ChangeClassificationBreaking,
+					Path:           resourcePath.Block("added_required", 1),
+					Detail:         "added as required",
+				},
+			},
+		},
+		"block-changed": {
+			oldSpec: resourceSpec(nil, resource.Blocks{
+				{
+					Name: "type_changed",
+					ListNested: &resource.ListNestedBlock{
+						NestedObject: resource.NestedBlockObject{
+							Attributes: resource.Attributes{
+								{
+									Name: "example",
+									Bool: &resource.BoolAttribute{
+										ComputedOptionalRequired: schema.Optional,
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "nested_changed",
+					SingleNested: &resource.SingleNestedBlock{
+						Attributes: resource.Attributes{
+							{
+								Name: "example",
+								Bool: &resource.BoolAttribute{
+									ComputedOptionalRequired: schema.Optional,
+								},
+							},
+						},
+					},
+				},
+			}),
+			newSpec: resourceSpec(nil, resource.Blocks{
+				{
+					Name: "type_changed",
+					SetNested: &resource.SetNestedBlock{
+						NestedObject: resource.NestedBlockObject{
+							Attributes: resource.Attributes{
+								{
+									Name: "example",
+									Bool: &resource.BoolAttribute{
+										ComputedOptionalRequired: schema.Optional,
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "nested_changed",
+					SingleNested: &resource.SingleNestedBlock{
+						Blocks: resource.Blocks{
+							{
+								Name:       "added",
+								ListNested: &resource.ListNestedBlock{},
+							},
+						},
+					},
+				},
+			}),
+			expected: spec.Changes{
+				{
+					Kind:           spec.ChangeKindChanged,
+					Classification: spec.ChangeClassificationBreaking,
+					Path:           resourcePath.Block("type_changed", 0),
+					Detail:         "type changed from list_nested to set_nested",
+				},
+				{
+					Kind:           spec.ChangeKindRemoved,
+					Classification: spec.ChangeClassificationBreaking,
+					Path:           resourcePath.Block("nested_changed", 1).NestedObject("single_nested").Attribute("example", 0),
+					Detail:         "removed",
+				},
+				{
+					Kind:           spec.ChangeKindAdded,
+					Classification: spec.ChangeClassificationSafe,
+					Path:           resourcePath.Block("nested_changed", 1).NestedObject("single_nested").Block("added", 0),
+					Detail:         "added",
+				},
+			},
+		},
+		"data-source-changed": {
+			oldSpec: spec.Specification{
+				DataSources: datasource.DataSources{
+					{
+						Name: "example",
+						Schema: &datasource.Schema{
+							Attributes: datasource.Attributes{
+								{
+									Name: "id",
+									String: &datasource.StringAttribute{
+										ComputedOptionalRequired: schema.Computed,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			newSpec: spec.Specification{
+				DataSources: datasource.DataSources{
+					{
+						Name: "example",
+						Schema: &datasource.Schema{
+							Attributes: datasource.Attributes{
+								{
+									Name: "id",
+									String: &datasource.StringAttribute{
+										ComputedOptionalRequired: schema.ComputedOptional,
+										Sensitive:                pointer(true),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: spec.Changes{
+				{
+					Kind:           spec.ChangeKindChanged,
+					Classification: spec.ChangeClassificationSafe,
+					Path:           schema.Path{}.DataSource("example", 0).Schema().Attribute("id", 0),
+					Detail:         "changed from computed to computed_optional",
+				},
+				{
+					Kind:           spec.ChangeKindChanged,
+					Classification: spec.ChangeClassificationSafe,
+					Path:           schema.Path{}.DataSource("example", 0).Schema().Attribute("id", 0),
+					Detail:         "sensitive changed from false to true",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := spec.Diff(testCase.oldSpec, testCase.newSpec)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestChanges_Breaking(t *testing.T) {
+	t.Parallel()
+
+	breaking := spec.Change{
+		Kind:           spec.ChangeKindRemoved,
+		Classification: spec.ChangeClassificationBreaking,
+		Path:           schema.Path{}.Resource("example", 0).Schema().Attribute("name", 0),
+		Detail:         "removed",
+	}
+
+	changes := spec.Changes{
+		{
+			Kind:           spec.ChangeKindAdded,
+			Classification: spec.ChangeClassificationSafe,
+			Path:           schema.Path{}.Resource("other", 1),
+			Detail:         "added",
+		},
+		breaking,
+	}
+
+	if diff := cmp.Diff(changes.Breaking(), spec.Changes{breaking}); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	if !changes.HasBreaking() {
+		t.Error("expected breaking changes")
+	}
+
+	if spec.Changes(nil).HasBreaking() {
+		t.Error("expected no breaking changes")
+	}
+
+	if got, expected := breaking.String(), `resource "example" attribute "name" removed`; got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
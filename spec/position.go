@@ -0,0 +1,155 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package spec
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/diag"
+)
+
+// withPositions returns the given Diagnostics, with the Position of each
+// Diagnostic set from its Pointer, if not already known. Pointers which do
+// not refer to an element of the document, such as that of a missing member,
+// are resolved to the nearest element which contains them.
+func withPositions(document []byte, diagnostics diag.Diagnostics) diag.Diagnostics {
+	if len(diagnostics) == 0 || len(bytes.TrimSpace(document)) == 0 {
+		return diagnostics
+	}
+
+	offsets := documentOffsets(document)
+
+	for k, diagnostic := range diagnostics {
+		if diagnostic.Position.IsKnown() {
+			continue
+		}
+
+		pointer := diagnostic.Pointer
+
+		for {
+			if offset, ok := offsets[pointer]; ok {
+				diagnostics[k].Position = diag.NewPosition(document, offset)
+
+				break
+			}
+
+			if pointer == "" {
+				break
+			}
+
+			pointer = pointer[:strings.LastIndexByte(pointer, '/')]
+		}
+	}
+
+	return diagnostics
+}
+
+// jsonErrorDiagnostic returns a diag.Diagnostic for an error returned while
+// decoding the JSON document, with the Position of any syntax or type error.
+func jsonErrorDiagnostic(document []byte, err error) diag.Diagnostic {
+	diagnostic := diag.Diagnostic{
+		Severity: diag.SeverityError,
+		Summary:  "Invalid JSON",
+		Detail:   err.Error(),
+	}
+
+	var syntaxErr *json.SyntaxError
+
+	// The offset of a syntax error is that of the byte after the invalid
+	// character.
+	if errors.As(err, &syntaxErr) {
+		diagnostic.Position = diag.NewPosition(document, int(syntaxErr.Offset)-1)
+	}
+
+	var unmarshalTypeErr *json.UnmarshalTypeError
+
+	if errors.As(err, &unmarshalTypeErr) {
+		diagnostic.Position = diag.NewPosition(document, int(unmarshalTypeErr.Offset))
+	}
+
+	return diagnostic
+}
+
+// documentOffsets returns the byte offset of each element of the given JSON
+// document, keyed by JSON pointer. The offset of an object member is that of
+// its name, and the offset of an array element is that of its value. Elements
+// following any syntax error are omitted.
+func documentOffsets(document []byte) map[string]int {
+	offsets := map[string]int{
+		"": tokenOffset(document, 0),
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(document))
+
+	// Errors are ignored, as any syntax error has already been reported when
+	// the document was decoded.
+	_ = valueOffsets(decoder, document, "", offsets)
+
+	return offsets
+}
+
+// valueOffsets reads the next value from the decoder, recording the offsets of
+// any object members or array elements within the value at the given pointer.
+func valueOffsets(decoder *json.Decoder, document []byte, pointer string, offsets map[string]int) error {
+	token, err := decoder.Token()
+
+	if err != nil {
+		return err
+	}
+
+	switch token {
+	case json.Delim('{'):
+		for decoder.More() {
+			offset := tokenOffset(document, int(decoder.InputOffset()))
+
+			name, err := decoder.Token()
+
+			if err != nil {
+				return err
+			}
+
+			memberPointer := diag.Pointer(pointer, name)
+
+			offsets[memberPointer] = offset
+
+			if err := valueOffsets(decoder, document, memberPointer, offsets); err != nil {
+				return err
+			}
+		}
+
+		_, err = decoder.Token()
+	case json.Delim('['):
+		for k := 0; decoder.More(); k++ {
+			elementPointer := diag.Pointer(pointer, k)
+
+			offsets[elementPointer] = tokenOffset(document, int(decoder.InputOffset()))
+
+			if err := valueOffsets(decoder, document, elementPointer, offsets); err != nil {
+				return err
+			}
+		}
+
+		_, err = decoder.Token()
+	}
+
+	return err
+}
+
+// tokenOffset returns the offset of the next token in the document at or after
+// the given offset, skipping whitespace and the separators between tokens.
+func tokenOffset(document []byte, offset int) int {
+	for offset < len(document) {
+		switch document[offset] {
+		case ' ', '\t', '\n', '\r', ',', ':':
+			offset++
+		default:
+			return offset
+		}
+	}
+
+	return offset
+}
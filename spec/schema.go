@@ -9,9 +9,13 @@ import (
 
 const (
 	Version0_1 = "0.1"
+	Version0_2 = "0.2"
 )
 
 var (
 	//go:embed v0.1/schema.json
 	JSONSchemaVersion0_1 []byte
+
+	//go:embed v0.2/schema.json
+	JSONSchemaVersion0_2 []byte
 )
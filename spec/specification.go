@@ -7,59 +7,300 @@ import (
 	"context"
 	"errors"
 
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/datasource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/diag"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/ephemeralresource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/function"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/provider"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 )
 
-// Specification defines the data source(s), provider, and resource(s) for
-// a [Terraform provider].
+// Specification defines the data source(s), ephemeral resource(s),
+// function(s), provider, and resource(s) for a [Terraform provider].
 //
 // [Terraform provider]: https://developer.hashicorp.com/terraform/language/providers
 type Specification struct {
 	// DataSources defines a slice of datasource.DataSource type.
 	DataSources datasource.DataSources `json:"datasources,omitempty"`
 
+	// EphemeralResources defines a slice of ephemeralresource.EphemeralResource type.
+	EphemeralResources ephemeralresource.EphemeralResources `json:"ephemeral_resources,omitempty"`
+
+	// Functions defines a slice of function.Function type.
+	Functions function.Functions `json:"functions,omitempty"`
+
 	// Provider defines an instance of the provider.Provider type.
 	Provider *provider.Provider `json:"provider,omitempty"`
 
 	// Resources defines a slice of resource.Resource type.
 	Resources resource.Resources `json:"resources,omitempty"`
 
-	// Version defines the Provider Code Specification JSON schema version
+	// Version defines the Provider Code Specification JSON schema version.
+	// An empty Version, such as for a Specification which is constructed
+	// rather than parsed, is validated as version 0.1.
 	Version string `json:"version,omitempty"`
 }
 
-// Validate delegates validation to each of datasource.DataSources,
-// *provider.Provider and resource.Resources.
+// isVersion0_1 returns true if the Version is 0.1 or empty.
+func (s Specification) isVersion0_1() bool {
+	return s.Version == "" || s.Version == Version0_1
+}
+
+// Validate returns the diagnostics returned from ValidateDiagnostics as an
+// error, if any have diag.SeverityError.
 func (s Specification) Validate(ctx context.Context) error {
+	return s.ValidateDiagnostics(ctx).Err()
+}
+
+// ValidateDiagnostics delegates validation to each of datasource.DataSources,
+// ephemeralresource.EphemeralResources, function.Functions,
+// *provider.Provider and resource.Resources, and returns the diag.Diagnostic
+// of each problem found. When the Version is 0.1 or empty, resource
+// attributes which are optional or required may have a default, and data
+// source, provider and resource schemas may use names which Terraform
+// reserves at their root, as these were permitted by version 0.1 of the
+// specification. Ephemeral resources were introduced in version 0.2, so their
+// names are always checked.
+func (s Specification) ValidateDiagnostics(ctx context.Context) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	datasourcesValidateReq := datasource.DataSourcesValidateRequest{
+		ReservedNames: s.isVersion0_1(),
+	}
+
+	diagnostics = append(diagnostics, diag.FromError(s.DataSources.Validate(ctx, datasourcesValidateReq))...)
+
+	ephemeralResourcesValidateReq := ephemeralresource.EphemeralResourcesValidateRequest{}
+
+	diagnostics = append(diagnostics, diag.FromError(s.EphemeralResources.Validate(ctx, ephemeralResourcesValidateReq))...)
+
+	functionsValidateReq := function.FunctionsValidateRequest{}
+
+	diagnostics = append(diagnostics, diag.FromError(s.Functions.Validate(ctx, functionsValidateReq))...)
+
+	if s.Provider != nil {
+		providerValidateReq := provider.ValidateRequest{
+			Path:          schema.Path{}.Provider(s.Provider.Name),
+			ReservedNames: s.isVersion0_1(),
+		}
+
+		diagnostics = append(diagnostics, diag.FromError(s.Provider.Validate(ctx, providerValidateReq))...)
+	}
+
+	resourcesValidateReq := resource.ResourcesValidateRequest{
+		OptionalDefaults: s.isVersion0_1(),
+		ReservedNames:    s.isVersion0_1(),
+	}
+
+	diagnostics = append(diagnostics, diag.FromError(s.Resources.Validate(ctx, resourcesValidateReq))...)
+
+	return diagnostics
+}
+
+// ValidateGoIdentifiers delegates to the ValidateGoIdentifiers method of the
+// Schema of each data source, ephemeral resource, provider and resource, the
+// IdentitySchema of each resource, and each function, to detect attribute,
+// block and object attribute type names which generate colliding Go
+// identifiers. This validation is opt-in, and is not performed by Validate.
+func (s Specification) ValidateGoIdentifiers(ctx context.Context) error {
 	var errs []error
 
-	datasourcesValidateReq := datasource.DataSourcesValidateRequest{}
+	for k, d := range s.DataSources {
+		if d.Schema == nil {
+			continue
+		}
+
+		schemaValidateRequest := datasource.SchemaValidateRequest{
+			Path: schema.Path{}.DataSource(d.Name, k).Schema(),
+		}
+
+		err := d.Schema.ValidateGoIdentifiers(ctx, schemaValidateRequest)
+
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for k, e := range s.EphemeralResources {
+		if e.Schema == nil {
+			continue
+		}
+
+		schemaValidateRequest := ephemeralresource.SchemaValidateRequest{
+			Path: schema.Path{}.EphemeralResource(e.Name, k).Schema(),
+		}
 
-	err := s.DataSources.Validate(ctx, datasourcesValidateReq)
+		err := e.Schema.ValidateGoIdentifiers(ctx, schemaValidateRequest)
 
-	if err != nil {
-		errs = append(errs, err)
+		if err != nil {
+			errs = append(errs, err)
+		}
 	}
 
-	if s.Provider != nil {
-		providerValidateReq := provider.ValidateRequest{}
+	for k, f := range s.Functions {
+		validateRequest := function.ValidateRequest{
+			Path: schema.Path{}.Function(f.Name, k),
+		}
+
+		err := f.ValidateGoIdentifiers(ctx, validateRequest)
+
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if s.Provider != nil && s.Provider.Schema != nil {
+		schemaValidateRequest := provider.SchemaValidateRequest{
+			Path: schema.Path{}.Provider(s.Provider.Name).Schema(),
+		}
+
+		err := s.Provider.Schema.ValidateGoIdentifiers(ctx, schemaValidateRequest)
+
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for k, r := range s.Resources {
+		if r.Schema != nil {
+			schemaValidateRequest := resource.SchemaValidateRequest{
+				Path: schema.Path{}.Resource(r.Name, k).Schema(),
+			}
+
+			err := r.Schema.ValidateGoIdentifiers(ctx, schemaValidateRequest)
+
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
 
-		err = s.Provider.Validate(ctx, providerValidateReq)
+		if r.Identity != nil {
+			identitySchemaValidateRequest := resource.IdentitySchemaValidateRequest{
+				Path: schema.Path{}.Resource(r.Name, k).Identity(),
+			}
+
+			err := r.Identity.ValidateGoIdentifiers(ctx, identitySchemaValidateRequest)
+
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ValidateGoSyntax delegates to the ValidateGoSyntax method of each data
+// source, ephemeral resource and resource Schema, each function, and the
+// provider Schema, to detect Go code snippets which are not valid Go syntax or
+// which reference a package that is not imported. This validation is opt-in,
+// and is not performed by Validate.
+func (s Specification) ValidateGoSyntax(ctx context.Context) error {
+	var errs []error
+
+	for k, d := range s.DataSources {
+		if d.Schema == nil {
+			continue
+		}
+
+		schemaValidateRequest := datasource.SchemaValidateRequest{
+			Path: schema.Path{}.DataSource(d.Name, k).Schema(),
+		}
+
+		err := d.Schema.ValidateGoSyntax(ctx, schemaValidateRequest)
+
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for k, e := range s.EphemeralResources {
+		if e.Schema == nil {
+			continue
+		}
+
+		schemaValidateRequest := ephemeralresource.SchemaValidateRequest{
+			Path: schema.Path{}.EphemeralResource(e.Name, k).Schema(),
+		}
+
+		err := e.Schema.ValidateGoSyntax(ctx, schemaValidateRequest)
+
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for k, f := range s.Functions {
+		validateRequest := function.ValidateRequest{
+			Path: schema.Path{}.Function(f.Name, k),
+		}
+
+		err := f.ValidateGoSyntax(ctx, validateRequest)
 
 		if err != nil {
 			errs = append(errs, err)
 		}
 	}
 
-	resourcesValidateReq := resource.ResourcesValidateRequest{}
+	if s.Provider != nil && s.Provider.Schema != nil {
+		schemaValidateRequest := provider.SchemaValidateRequest{
+			Path: schema.Path{}.Provider(s.Provider.Name).Schema(),
+		}
+
+		err := s.Provider.Schema.ValidateGoSyntax(ctx, schemaValidateRequest)
 
-	err = s.Resources.Validate(ctx, resourcesValidateReq)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
 
-	if err != nil {
-		errs = append(errs, err)
+	for k, r := range s.Resources {
+		if r.Schema == nil {
+			continue
+		}
+
+		schemaValidateRequest := resource.SchemaValidateRequest{
+			Path: schema.Path{}.Resource(r.Name, k).Schema(),
+		}
+
+		err := r.Schema.ValidateGoSyntax(ctx, schemaValidateRequest)
+
+		if err != nil {
+			errs = append(errs, err)
+		}
 	}
 
 	return errors.Join(errs...)
 }
+
+// CodeImports returns each of the imports within the data sources, ephemeral
+// resources, functions, provider and resources, which may contain
+// duplicates. Use code.CollectImports to de-duplicate the imports, and to
+// detect conflicting aliases.
+func (s Specification) CodeImports() []code.Import {
+	var imports []code.Import
+
+	for _, d := range s.DataSources {
+		imports = append(imports, d.CodeImports()...)
+	}
+
+	for _, e := range s.EphemeralResources {
+		imports = append(imports, e.CodeImports()...)
+	}
+
+	for _, f := range s.Functions {
+		imports = append(imports, f.CodeImports()...)
+	}
+
+	if s.Provider != nil {
+		imports = append(imports, s.Provider.CodeImports()...)
+	}
+
+	for _, r := range s.Resources {
+		imports = append(imports, r.CodeImports()...)
+	}
+
+	return imports
+}
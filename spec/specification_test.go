@@ -14,6 +14,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/datasource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/diag"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/ephemeralresource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/function"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/provider"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
@@ -4335,6 +4338,125 @@ func TestSpecification_Validate_DataSources(t *testing.T) {
 				},
 			},
 		},
+		"data-source-config-validator-paths-resolve": {
+			spec: spec.Specification{
+				DataSources: datasource.DataSources{
+					{
+						Name: "example",
+						Schema: &datasource.Schema{
+							Attributes: datasource.Attributes{
+								{
+									Name: "attr_one",
+									Bool: &datasource.BoolAttribute{
+										ComputedOptionalRequired: schema.Optional,
+									},
+								},
+							},
+							Blocks: datasource.Blocks{
+								{
+									Name: "block_one",
+									SingleNested: &datasource.SingleNestedBlock{
+										Attributes: datasource.Attributes{
+											{
+												Name: "attr_two",
+												Bool: &datasource.BoolAttribute{
+													ComputedOptionalRequired: schema.Optional,
+												},
+											},
+										},
+									},
+								},
+							},
+							ConfigValidators: schema.ConfigValidators{
+								{
+									ExactlyOneOf: &schema.PathRelationshipValidator{
+										Paths: schema.PathExpressions{
+											"attr_one",
+											"block_one.attr_two",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"data-source-config-validator-path-unresolved": {
+			spec: spec.Specification{
+				DataSources: datasource.DataSources{
+					{
+						Name: "example",
+						Schema: &datasource.Schema{
+							Attributes: datasource.Attributes{
+								{
+									Name: "attr_one",
+									Bool: &datasource.BoolAttribute{
+										ComputedOptionalRequired: schema.Optional,
+									},
+								},
+							},
+							ConfigValidators: schema.ConfigValidators{
+								{
+									ExactlyOneOf: &schema.PathRelationshipValidator{
+										Paths: schema.PathExpressions{
+											"attr_one",
+											"attr_two",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`data source "example" exactly_one_of config validator path "attr_two" does not resolve to an attribute or block`),
+		},
+		"data-source-reserved-name-block": {
+			spec: spec.Specification{
+				Version: spec.Version0_2,
+				DataSources: datasource.DataSources{
+					{
+						Name: "example",
+						Schema: &datasource.Schema{
+							Blocks: datasource.Blocks{
+								{
+									Name:         "depends_on",
+									SingleNested: &datasource.SingleNestedBlock{},
+								},
+								{
+									Name:         "connection",
+									SingleNested: &datasource.SingleNestedBlock{},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`data source "example" block "depends_on" is a reserved name`),
+		},
+		"data-source-reserved-name-block-version-0.1": {
+			spec: spec.Specification{
+				Version: spec.Version0_1,
+				DataSources: datasource.DataSources{
+					{
+						Name: "example",
+						Schema: &datasource.Schema{
+							Blocks: datasource.Blocks{
+								{
+									Name:         "depends_on",
+									SingleNested: &datasource.SingleNestedBlock{},
+								},
+								{
+									Name:         "connection",
+									SingleNested: &datasource.SingleNestedBlock{},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -4361,148 +4483,130 @@ func TestSpecification_Validate_DataSources(t *testing.T) {
 	}
 }
 
-func TestSpecification_Validate_Provider(t *testing.T) {
+func TestSpecification_Validate_EphemeralResources(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]struct {
 		spec          spec.Specification
 		expectedError error
 	}{
-		"provider-attribute-names-duplicated": {
+		"ephemeral-resource-names-duplicated": {
 			spec: spec.Specification{
-				Provider: &provider.Provider{
-					Name: "example",
-					Schema: &provider.Schema{
-						Attributes: []provider.Attribute{
-							{
-								Name: "first_attr",
-							},
-							{
-								Name: "first_attr",
-							},
-						},
+				EphemeralResources: ephemeralresource.EphemeralResources{
+					{
+						Name: "example",
+					},
+					{
+						Name: "example",
 					},
 				},
 			},
-			expectedError: fmt.Errorf(`provider "example" attribute "first_attr" is duplicated`),
+			expectedError: fmt.Errorf(`ephemeral resource "example" is duplicated`),
 		},
-		"provider-attribute-names-unique": {
+		"ephemeral-resource-names-unique": {
 			spec: spec.Specification{
-				Provider: &provider.Provider{
-					Name: "example",
-					Schema: &provider.Schema{
-						Attributes: []provider.Attribute{
-							{
-								Name: "first_attr",
-							},
-							{
-								Name: "second_attr",
-							},
-						},
+				EphemeralResources: ephemeralresource.EphemeralResources{
+					{
+						Name: "example",
+					},
+					{
+						Name: "different",
 					},
 				},
 			},
 		},
-		"provider-block-names-duplicated": {
+		"ephemeral-resource-attribute-names-duplicated": {
 			spec: spec.Specification{
-				Provider: &provider.Provider{
-					Name: "example",
-					Schema: &provider.Schema{
-						Blocks: []provider.Block{
-							{
-								Name: "first_block",
-							},
-							{
-								Name: "first_block",
+				EphemeralResources: ephemeralresource.EphemeralResources{
+					{
+						Name: "example",
+						Schema: &ephemeralresource.Schema{
+							Attributes: []ephemeralresource.Attribute{
+								{
+									Name: "first_attr",
+								},
+								{
+									Name: "first_attr",
+								},
 							},
 						},
 					},
 				},
 			},
-			expectedError: fmt.Errorf(`provider "example" block "first_block" is duplicated`),
+			expectedError: fmt.Errorf(`ephemeral resource "example" attribute "first_attr" is duplicated`),
 		},
-		"provider-attribute-and-block-names-duplicated": {
+		"ephemeral-resource-attribute-names-unique": {
 			spec: spec.Specification{
-				Provider: &provider.Provider{
-					Name: "example",
-					Schema: &provider.Schema{
-						Attributes: []provider.Attribute{
-							{
-								Name: "first",
-							},
-						},
-						Blocks: []provider.Block{
-							{
-								Name: "first",
+				EphemeralResources: ephemeralresource.EphemeralResources{
+					{
+						Name: "example",
+						Schema: &ephemeralresource.Schema{
+							Attributes: []ephemeralresource.Attribute{
+								{
+									Name: "first_attr",
+								},
+								{
+									Name: "second_attr",
+								},
 							},
 						},
 					},
 				},
 			},
 		},
-		"provider-block-names-unique": {
+		"ephemeral-resource-block-names-duplicated": {
 			spec: spec.Specification{
-				Provider: &provider.Provider{
-					Name: "example",
-					Schema: &provider.Schema{
-						Blocks: []provider.Block{
-							{
-								Name: "first_block",
-							},
-							{
-								Name: "second_block",
+				EphemeralResources: ephemeralresource.EphemeralResources{
+					{
+						Name: "example",
+						Schema: &ephemeralresource.Schema{
+							Blocks: []ephemeralresource.Block{
+								{
+									Name: "first_block",
+								},
+								{
+									Name: "first_block",
+								},
 							},
 						},
 					},
 				},
 			},
+			expectedError: fmt.Errorf(`ephemeral resource "example" block "first_block" is duplicated`),
 		},
-		"provider-list-nested-attribute-names-duplicated": {
+		"ephemeral-resource-attribute-and-block-names-duplicated": {
 			spec: spec.Specification{
-				Provider: &provider.Provider{
-					Name: "example",
-					Schema: &provider.Schema{
-						Attributes: []provider.Attribute{
-							{
-								Name: "first_attr",
-								ListNested: &provider.ListNestedAttribute{
-									NestedObject: provider.NestedAttributeObject{
-										Attributes: []provider.Attribute{
-											{
-												Name: "nested_attr",
-											},
-											{
-												Name: "nested_attr",
-											},
-										},
-									},
+				EphemeralResources: ephemeralresource.EphemeralResources{
+					{
+						Name: "example",
+						Schema: &ephemeralresource.Schema{
+							Attributes: []ephemeralresource.Attribute{
+								{
+									Name: "first",
+								},
+							},
+							Blocks: []ephemeralresource.Block{
+								{
+									Name: "first",
 								},
 							},
 						},
 					},
 				},
 			},
-			expectedError: fmt.Errorf(`provider "example" attribute "first_attr" attribute "nested_attr" is duplicated`),
 		},
-		"provider-list-nested-attribute-names-unique": {
+		"ephemeral-resource-block-names-unique": {
 			spec: spec.Specification{
-				Provider: &provider.Provider{
-					Name: "example",
-					Schema: &provider.Schema{
-						Attributes: []provider.Attribute{
-							{
-								Name: "first_attr",
-								ListNested: &provider.ListNestedAttribute{
-									NestedObject: provider.NestedAttributeObject{
-										Attributes: []provider.Attribute{
-											{
-												Name: "nested_first_attr",
-											},
-											{
-												Name: "nested_second_attr",
-											},
-										},
-									},
+				EphemeralResources: ephemeralresource.EphemeralResources{
+					{
+						Name: "example",
+						Schema: &ephemeralresource.Schema{
+							Blocks: []ephemeralresource.Block{
+								{
+									Name: "first_block",
+								},
+								{
+									Name: "second_block",
 								},
 							},
 						},
@@ -4510,19 +4614,24 @@ func TestSpecification_Validate_Provider(t *testing.T) {
 				},
 			},
 		},
-		"provider-attribute-and-list-nested-attribute-names-duplicated": {
+		"ephemeral-resource-list-nested-attribute-names-duplicated": {
 			spec: spec.Specification{
-				Provider: &provider.Provider{
-					Name: "example",
-					Schema: &provider.Schema{
-						Attributes: []provider.Attribute{
-							{
-								Name: "first_attr",
-								ListNested: &provider.ListNestedAttribute{
-									NestedObject: provider.NestedAttributeObject{
-										Attributes: []provider.Attribute{
-											{
-												Name: "first_attr",
+				EphemeralResources: ephemeralresource.EphemeralResources{
+					{
+						Name: "example",
+						Schema: &ephemeralresource.Schema{
+							Attributes: []ephemeralresource.Attribute{
+								{
+									Name: "first_attr",
+									ListNested: &ephemeralresource.ListNestedAttribute{
+										NestedObject: ephemeralresource.NestedAttributeObject{
+											Attributes: []ephemeralresource.Attribute{
+												{
+													Name: "nested_attr",
+												},
+												{
+													Name: "nested_attr",
+												},
 											},
 										},
 									},
@@ -4532,23 +4641,26 @@ func TestSpecification_Validate_Provider(t *testing.T) {
 					},
 				},
 			},
+			expectedError: fmt.Errorf(`ephemeral resource "example" attribute "first_attr" attribute "nested_attr" is duplicated`),
 		},
-		"provider-map-nested-attribute-names-duplicated": {
+		"ephemeral-resource-list-nested-attribute-names-unique": {
 			spec: spec.Specification{
-				Provider: &provider.Provider{
-					Name: "example",
-					Schema: &provider.Schema{
-						Attributes: []provider.Attribute{
-							{
-								Name: "first_attr",
-								MapNested: &provider.MapNestedAttribute{
-									NestedObject: provider.NestedAttributeObject{
-										Attributes: []provider.Attribute{
-											{
-												Name: "nested_attr",
-											},
-											{
-												Name: "nested_attr",
+				EphemeralResources: ephemeralresource.EphemeralResources{
+					{
+						Name: "example",
+						Schema: &ephemeralresource.Schema{
+							Attributes: []ephemeralresource.Attribute{
+								{
+									Name: "first_attr",
+									ListNested: &ephemeralresource.ListNestedAttribute{
+										NestedObject: ephemeralresource.NestedAttributeObject{
+											Attributes: []ephemeralresource.Attribute{
+												{
+													Name: "nested_first_attr",
+												},
+												{
+													Name: "nested_second_attr",
+												},
 											},
 										},
 									},
@@ -4558,24 +4670,22 @@ func TestSpecification_Validate_Provider(t *testing.T) {
 					},
 				},
 			},
-			expectedError: fmt.Errorf(`provider "example" attribute "first_attr" attribute "nested_attr" is duplicated`),
 		},
-		"provider-map-nested-attribute-names-unique": {
+		"ephemeral-resource-attribute-and-list-nested-attribute-names-duplicated": {
 			spec: spec.Specification{
-				Provider: &provider.Provider{
-					Name: "example",
-					Schema: &provider.Schema{
-						Attributes: []provider.Attribute{
-							{
-								Name: "first_attr",
-								MapNested: &provider.MapNestedAttribute{
-									NestedObject: provider.NestedAttributeObject{
-										Attributes: []provider.Attribute{
-											{
-												Name: "nested_first_attr",
-											},
-											{
-												Name: "nested_second_attr",
+				EphemeralResources: ephemeralresource.EphemeralResources{
+					{
+						Name: "example",
+						Schema: &ephemeralresource.Schema{
+							Attributes: []ephemeralresource.Attribute{
+								{
+									Name: "first_attr",
+									ListNested: &ephemeralresource.ListNestedAttribute{
+										NestedObject: ephemeralresource.NestedAttributeObject{
+											Attributes: []ephemeralresource.Attribute{
+												{
+													Name: "first_attr",
+												},
 											},
 										},
 									},
@@ -4586,19 +4696,24 @@ func TestSpecification_Validate_Provider(t *testing.T) {
 				},
 			},
 		},
-		"provider-attribute-and-map-nested-attribute-names-duplicated": {
+		"ephemeral-resource-map-nested-attribute-names-duplicated": {
 			spec: spec.Specification{
-				Provider: &provider.Provider{
-					Name: "example",
-					Schema: &provider.Schema{
-						Attributes: []provider.Attribute{
-							{
-								Name: "first_attr",
-								MapNested: &provider.MapNestedAttribute{
-									NestedObject: provider.NestedAttributeObject{
-										Attributes: []provider.Attribute{
-											{
-												Name: "first_attr",
+				EphemeralResources: ephemeralresource.EphemeralResources{
+					{
+						Name: "example",
+						Schema: &ephemeralresource.Schema{
+							Attributes: []ephemeralresource.Attribute{
+								{
+									Name: "first_attr",
+									MapNested: &ephemeralresource.MapNestedAttribute{
+										NestedObject: ephemeralresource.NestedAttributeObject{
+											Attributes: []ephemeralresource.Attribute{
+												{
+													Name: "nested_attr",
+												},
+												{
+													Name: "nested_attr",
+												},
 											},
 										},
 									},
@@ -4608,23 +4723,26 @@ func TestSpecification_Validate_Provider(t *testing.T) {
 					},
 				},
 			},
+			expectedError: fmt.Errorf(`ephemeral resource "example" attribute "first_attr" attribute "nested_attr" is duplicated`),
 		},
-		"provider-set-nested-attribute-names-duplicated": {
+		"ephemeral-resource-map-nested-attribute-names-unique": {
 			spec: spec.Specification{
-				Provider: &provider.Provider{
-					Name: "example",
-					Schema: &provider.Schema{
-						Attributes: []provider.Attribute{
-							{
-								Name: "first_attr",
-								SetNested: &provider.SetNestedAttribute{
-									NestedObject: provider.NestedAttributeObject{
-										Attributes: []provider.Attribute{
-											{
-												Name: "nested_attr",
-											},
-											{
-												Name: "nested_attr",
+				EphemeralResources: ephemeralresource.EphemeralResources{
+					{
+						Name: "example",
+						Schema: &ephemeralresource.Schema{
+							Attributes: []ephemeralresource.Attribute{
+								{
+									Name: "first_attr",
+									MapNested: &ephemeralresource.MapNestedAttribute{
+										NestedObject: ephemeralresource.NestedAttributeObject{
+											Attributes: []ephemeralresource.Attribute{
+												{
+													Name: "nested_first_attr",
+												},
+												{
+													Name: "nested_second_attr",
+												},
 											},
 										},
 									},
@@ -4634,24 +4752,22 @@ func TestSpecification_Validate_Provider(t *testing.T) {
 					},
 				},
 			},
-			expectedError: fmt.Errorf(`provider "example" attribute "first_attr" attribute "nested_attr" is duplicated`),
 		},
-		"provider-set-nested-attribute-names-unique": {
+		"ephemeral-resource-attribute-and-map-nested-attribute-names-duplicated": {
 			spec: spec.Specification{
-				Provider: &provider.Provider{
-					Name: "example",
-					Schema: &provider.Schema{
-						Attributes: []provider.Attribute{
-							{
-								Name: "first_attr",
-								SetNested: &provider.SetNestedAttribute{
-									NestedObject: provider.NestedAttributeObject{
-										Attributes: []provider.Attribute{
-											{
-												Name: "nested_first_attr",
-											},
-											{
-												Name: "nested_second_attr",
+				EphemeralResources: ephemeralresource.EphemeralResources{
+					{
+						Name: "example",
+						Schema: &ephemeralresource.Schema{
+							Attributes: []ephemeralresource.Attribute{
+								{
+									Name: "first_attr",
+									MapNested: &ephemeralresource.MapNestedAttribute{
+										NestedObject: ephemeralresource.NestedAttributeObject{
+											Attributes: []ephemeralresource.Attribute{
+												{
+													Name: "first_attr",
+												},
 											},
 										},
 									},
@@ -4662,19 +4778,24 @@ func TestSpecification_Validate_Provider(t *testing.T) {
 				},
 			},
 		},
-		"provider-attribute-and-set-nested-attribute-names-duplicated": {
+		"ephemeral-resource-set-nested-attribute-names-duplicated": {
 			spec: spec.Specification{
-				Provider: &provider.Provider{
-					Name: "example",
-					Schema: &provider.Schema{
-						Attributes: []provider.Attribute{
-							{
-								Name: "first_attr",
-								SetNested: &provider.SetNestedAttribute{
-									NestedObject: provider.NestedAttributeObject{
-										Attributes: []provider.Attribute{
-											{
-												Name: "first_attr",
+				EphemeralResources: ephemeralresource.EphemeralResources{
+					{
+						Name: "example",
+						Schema: &ephemeralresource.Schema{
+							Attributes: []ephemeralresource.Attribute{
+								{
+									Name: "first_attr",
+									SetNested: &ephemeralresource.SetNestedAttribute{
+										NestedObject: ephemeralresource.NestedAttributeObject{
+											Attributes: []ephemeralresource.Attribute{
+												{
+													Name: "nested_attr",
+												},
+												{
+													Name: "nested_attr",
+												},
 											},
 										},
 									},
@@ -4684,47 +4805,27 @@ func TestSpecification_Validate_Provider(t *testing.T) {
 					},
 				},
 			},
+			expectedError: fmt.Errorf(`ephemeral resource "example" attribute "first_attr" attribute "nested_attr" is duplicated`),
 		},
-		"provider-single-nested-attribute-names-duplicated": {
-			spec: spec.Specification{
-				Provider: &provider.Provider{
-					Name: "example",
-					Schema: &provider.Schema{
-						Attributes: []provider.Attribute{
-							{
-								Name: "first_attr",
-								SingleNested: &provider.SingleNestedAttribute{
-									Attributes: []provider.Attribute{
-										{
-											Name: "nested_attr",
-										},
-										{
-											Name: "nested_attr",
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-			expectedError: fmt.Errorf(`provider "example" attribute "first_attr" attribute "nested_attr" is duplicated`),
-		},
-		"provider-single-nested-attribute-names-unique": {
+		"ephemeral-resource-set-nested-attribute-names-unique": {
 			spec: spec.Specification{
-				Provider: &provider.Provider{
-					Name: "example",
-					Schema: &provider.Schema{
-						Attributes: []provider.Attribute{
-							{
-								Name: "first_attr",
-								SingleNested: &provider.SingleNestedAttribute{
-									Attributes: []provider.Attribute{
-										{
-											Name: "nested_first_attr",
-										},
-										{
-											Name: "nested_second_attr",
+				EphemeralResources: ephemeralresource.EphemeralResources{
+					{
+						Name: "example",
+						Schema: &ephemeralresource.Schema{
+							Attributes: []ephemeralresource.Attribute{
+								{
+									Name: "first_attr",
+									SetNested: &ephemeralresource.SetNestedAttribute{
+										NestedObject: ephemeralresource.NestedAttributeObject{
+											Attributes: []ephemeralresource.Attribute{
+												{
+													Name: "nested_first_attr",
+												},
+												{
+													Name: "nested_second_attr",
+												},
+											},
 										},
 									},
 								},
@@ -4734,18 +4835,22 @@ func TestSpecification_Validate_Provider(t *testing.T) {
 				},
 			},
 		},
-		"provider-attribute-and-single-nested-attribute-names-duplicated": {
+		"ephemeral-resource-attribute-and-set-nested-attribute-names-duplicated": {
 			spec: spec.Specification{
-				Provider: &provider.Provider{
-					Name: "example",
-					Schema: &provider.Schema{
-						Attributes: []provider.Attribute{
-							{
-								Name: "first_attr",
-								SingleNested: &provider.SingleNestedAttribute{
-									Attributes: []provider.Attribute{
-										{
-											Name: "first_attr",
+				EphemeralResources: ephemeralresource.EphemeralResources{
+					{
+						Name: "example",
+						Schema: &ephemeralresource.Schema{
+							Attributes: []ephemeralresource.Attribute{
+								{
+									Name: "first_attr",
+									SetNested: &ephemeralresource.SetNestedAttribute{
+										NestedObject: ephemeralresource.NestedAttributeObject{
+											Attributes: []ephemeralresource.Attribute{
+												{
+													Name: "first_attr",
+												},
+											},
 										},
 									},
 								},
@@ -4755,22 +4860,22 @@ func TestSpecification_Validate_Provider(t *testing.T) {
 				},
 			},
 		},
-		"provider-list-nested-block-names-duplicated": {
+		"ephemeral-resource-single-nested-attribute-names-duplicated": {
 			spec: spec.Specification{
-				Provider: &provider.Provider{
-					Name: "example",
-					Schema: &provider.Schema{
-						Blocks: []provider.Block{
-							{
-								Name: "first_block",
-								ListNested: &provider.ListNestedBlock{
-									NestedObject: provider.NestedBlockObject{
-										Blocks: []provider.Block{
+				EphemeralResources: ephemeralresource.EphemeralResources{
+					{
+						Name: "example",
+						Schema: &ephemeralresource.Schema{
+							Attributes: []ephemeralresource.Attribute{
+								{
+									Name: "first_attr",
+									SingleNested: &ephemeralresource.SingleNestedAttribute{
+										Attributes: []ephemeralresource.Attribute{
 											{
-												Name: "nested_block",
+												Name: "nested_attr",
 											},
 											{
-												Name: "nested_block",
+												Name: "nested_attr",
 											},
 										},
 									},
@@ -4780,24 +4885,24 @@ func TestSpecification_Validate_Provider(t *testing.T) {
 					},
 				},
 			},
-			expectedError: fmt.Errorf(`provider "example" block "first_block" block "nested_block" is duplicated`),
+			expectedError: fmt.Errorf(`ephemeral resource "example" attribute "first_attr" attribute "nested_attr" is duplicated`),
 		},
-		"provider-list-nested-block-names-unique": {
+		"ephemeral-resource-single-nested-attribute-names-unique": {
 			spec: spec.Specification{
-				Provider: &provider.Provider{
-					Name: "example",
-					Schema: &provider.Schema{
-						Blocks: []provider.Block{
-							{
-								Name: "first_block",
-								ListNested: &provider.ListNestedBlock{
-									NestedObject: provider.NestedBlockObject{
-										Blocks: []provider.Block{
+				EphemeralResources: ephemeralresource.EphemeralResources{
+					{
+						Name: "example",
+						Schema: &ephemeralresource.Schema{
+							Attributes: []ephemeralresource.Attribute{
+								{
+									Name: "first_attr",
+									SingleNested: &ephemeralresource.SingleNestedAttribute{
+										Attributes: []ephemeralresource.Attribute{
 											{
-												Name: "nested_first_block",
+												Name: "nested_first_attr",
 											},
 											{
-												Name: "nested_second_block",
+												Name: "nested_second_attr",
 											},
 										},
 									},
@@ -4808,19 +4913,19 @@ func TestSpecification_Validate_Provider(t *testing.T) {
 				},
 			},
 		},
-		"provider-block-and-list-nested-block-names-duplicated": {
+		"ephemeral-resource-attribute-and-single-nested-attribute-names-duplicated": {
 			spec: spec.Specification{
-				Provider: &provider.Provider{
-					Name: "example",
-					Schema: &provider.Schema{
-						Blocks: []provider.Block{
-							{
-								Name: "first_block",
-								ListNested: &provider.ListNestedBlock{
-									NestedObject: provider.NestedBlockObject{
-										Blocks: []provider.Block{
+				EphemeralResources: ephemeralresource.EphemeralResources{
+					{
+						Name: "example",
+						Schema: &ephemeralresource.Schema{
+							Attributes: []ephemeralresource.Attribute{
+								{
+									Name: "first_attr",
+									SingleNested: &ephemeralresource.SingleNestedAttribute{
+										Attributes: []ephemeralresource.Attribute{
 											{
-												Name: "first_block",
+												Name: "first_attr",
 											},
 										},
 									},
@@ -4831,22 +4936,24 @@ func TestSpecification_Validate_Provider(t *testing.T) {
 				},
 			},
 		},
-		"provider-set-nested-block-names-duplicated": {
+		"ephemeral-resource-list-nested-block-names-duplicated": {
 			spec: spec.Specification{
-				Provider: &provider.Provider{
-					Name: "example",
-					Schema: &provider.Schema{
-						Blocks: []provider.Block{
-							{
-								Name: "first_block",
-								SetNested: &provider.SetNestedBlock{
-									NestedObject: provider.NestedBlockObject{
-										Blocks: []provider.Block{
-											{
-												Name: "nested_block",
-											},
-											{
-												Name: "nested_block",
+				EphemeralResources: ephemeralresource.EphemeralResources{
+					{
+						Name: "example",
+						Schema: &ephemeralresource.Schema{
+							Blocks: []ephemeralresource.Block{
+								{
+									Name: "first_block",
+									ListNested: &ephemeralresource.ListNestedBlock{
+										NestedObject: ephemeralresource.NestedBlockObject{
+											Blocks: []ephemeralresource.Block{
+												{
+													Name: "nested_block",
+												},
+												{
+													Name: "nested_block",
+												},
 											},
 										},
 									},
@@ -4856,24 +4963,26 @@ func TestSpecification_Validate_Provider(t *testing.T) {
 					},
 				},
 			},
-			expectedError: fmt.Errorf(`provider "example" block "first_block" block "nested_block" is duplicated`),
+			expectedError: fmt.Errorf(`ephemeral resource "example" block "first_block" block "nested_block" is duplicated`),
 		},
-		"provider-set-nested-block-names-unique": {
+		"ephemeral-resource-list-nested-block-names-unique": {
 			spec: spec.Specification{
-				Provider: &provider.Provider{
-					Name: "example",
-					Schema: &provider.Schema{
-						Blocks: []provider.Block{
-							{
-								Name: "first_block",
-								SetNested: &provider.SetNestedBlock{
-									NestedObject: provider.NestedBlockObject{
-										Blocks: []provider.Block{
-											{
-												Name: "nested_first_block",
-											},
-											{
-												Name: "nested_second_block",
+				EphemeralResources: ephemeralresource.EphemeralResources{
+					{
+						Name: "example",
+						Schema: &ephemeralresource.Schema{
+							Blocks: []ephemeralresource.Block{
+								{
+									Name: "first_block",
+									ListNested: &ephemeralresource.ListNestedBlock{
+										NestedObject: ephemeralresource.NestedBlockObject{
+											Blocks: []ephemeralresource.Block{
+												{
+													Name: "nested_first_block",
+												},
+												{
+													Name: "nested_second_block",
+												},
 											},
 										},
 									},
@@ -4884,19 +4993,21 @@ func TestSpecification_Validate_Provider(t *testing.T) {
 				},
 			},
 		},
-		"provider-block-and-set-nested-block-names-duplicated": {
+		"ephemeral-resource-block-and-list-nested-block-names-duplicated": {
 			spec: spec.Specification{
-				Provider: &provider.Provider{
-					Name: "example",
-					Schema: &provider.Schema{
-						Blocks: []provider.Block{
-							{
-								Name: "first_block",
-								SetNested: &provider.SetNestedBlock{
-									NestedObject: provider.NestedBlockObject{
-										Blocks: []provider.Block{
-											{
-												Name: "first_block",
+				EphemeralResources: ephemeralresource.EphemeralResources{
+					{
+						Name: "example",
+						Schema: &ephemeralresource.Schema{
+							Blocks: []ephemeralresource.Block{
+								{
+									Name: "first_block",
+									ListNested: &ephemeralresource.ListNestedBlock{
+										NestedObject: ephemeralresource.NestedBlockObject{
+											Blocks: []ephemeralresource.Block{
+												{
+													Name: "first_block",
+												},
 											},
 										},
 									},
@@ -4907,21 +5018,25 @@ func TestSpecification_Validate_Provider(t *testing.T) {
 				},
 			},
 		},
-		"provider-single-nested-block-names-duplicated": {
+		"ephemeral-resource-set-nested-block-names-duplicated": {
 			spec: spec.Specification{
-				Provider: &provider.Provider{
-					Name: "example",
-					Schema: &provider.Schema{
-						Blocks: []provider.Block{
-							{
-								Name: "first_block",
-								SingleNested: &provider.SingleNestedBlock{
-									Blocks: []provider.Block{
-										{
-											Name: "nested_block",
-										},
-										{
-											Name: "nested_block",
+				EphemeralResources: ephemeralresource.EphemeralResources{
+					{
+						Name: "example",
+						Schema: &ephemeralresource.Schema{
+							Blocks: []ephemeralresource.Block{
+								{
+									Name: "first_block",
+									SetNested: &ephemeralresource.SetNestedBlock{
+										NestedObject: ephemeralresource.NestedBlockObject{
+											Blocks: []ephemeralresource.Block{
+												{
+													Name: "nested_block",
+												},
+												{
+													Name: "nested_block",
+												},
+											},
 										},
 									},
 								},
@@ -4930,23 +5045,27 @@ func TestSpecification_Validate_Provider(t *testing.T) {
 					},
 				},
 			},
-			expectedError: fmt.Errorf(`provider "example" block "first_block" block "nested_block" is duplicated`),
+			expectedError: fmt.Errorf(`ephemeral resource "example" block "first_block" block "nested_block" is duplicated`),
 		},
-		"provider-single-nested-block-names-unique": {
+		"ephemeral-resource-set-nested-block-names-unique": {
 			spec: spec.Specification{
-				Provider: &provider.Provider{
-					Name: "example",
-					Schema: &provider.Schema{
-						Blocks: []provider.Block{
-							{
-								Name: "first_block",
-								SingleNested: &provider.SingleNestedBlock{
-									Blocks: []provider.Block{
-										{
-											Name: "nested_first_block",
-										},
-										{
-											Name: "nested_second_block",
+				EphemeralResources: ephemeralresource.EphemeralResources{
+					{
+						Name: "example",
+						Schema: &ephemeralresource.Schema{
+							Blocks: []ephemeralresource.Block{
+								{
+									Name: "first_block",
+									SetNested: &ephemeralresource.SetNestedBlock{
+										NestedObject: ephemeralresource.NestedBlockObject{
+											Blocks: []ephemeralresource.Block{
+												{
+													Name: "nested_first_block",
+												},
+												{
+													Name: "nested_second_block",
+												},
+											},
 										},
 									},
 								},
@@ -4956,18 +5075,22 @@ func TestSpecification_Validate_Provider(t *testing.T) {
 				},
 			},
 		},
-		"provider-block-and-single-nested-block-names-duplicated": {
+		"ephemeral-resource-block-and-set-nested-block-names-duplicated": {
 			spec: spec.Specification{
-				Provider: &provider.Provider{
-					Name: "example",
-					Schema: &provider.Schema{
-						Blocks: []provider.Block{
-							{
-								Name: "first_block",
-								SingleNested: &provider.SingleNestedBlock{
-									Blocks: []provider.Block{
-										{
-											Name: "first_block",
+				EphemeralResources: ephemeralresource.EphemeralResources{
+					{
+						Name: "example",
+						Schema: &ephemeralresource.Schema{
+							Blocks: []ephemeralresource.Block{
+								{
+									Name: "first_block",
+									SetNested: &ephemeralresource.SetNestedBlock{
+										NestedObject: ephemeralresource.NestedBlockObject{
+											Blocks: []ephemeralresource.Block{
+												{
+													Name: "first_block",
+												},
+											},
 										},
 									},
 								},
@@ -4977,21 +5100,23 @@ func TestSpecification_Validate_Provider(t *testing.T) {
 				},
 			},
 		},
-		"provider-object-attribute-type-names-duplicated": {
+		"ephemeral-resource-single-nested-block-names-duplicated": {
 			spec: spec.Specification{
-				Provider: &provider.Provider{
-					Name: "example",
-					Schema: &provider.Schema{
-						Attributes: []provider.Attribute{
-							{
-								Name: "first_attr",
-								Object: &provider.ObjectAttribute{
-									AttributeTypes: []schema.ObjectAttributeType{
-										{
-											Name: "obj_attr",
-										},
-										{
-											Name: "obj_attr",
+				EphemeralResources: ephemeralresource.EphemeralResources{
+					{
+						Name: "example",
+						Schema: &ephemeralresource.Schema{
+							Blocks: []ephemeralresource.Block{
+								{
+									Name: "first_block",
+									SingleNested: &ephemeralresource.SingleNestedBlock{
+										Blocks: []ephemeralresource.Block{
+											{
+												Name: "nested_block",
+											},
+											{
+												Name: "nested_block",
+											},
 										},
 									},
 								},
@@ -5000,29 +5125,24 @@ func TestSpecification_Validate_Provider(t *testing.T) {
 					},
 				},
 			},
-			expectedError: fmt.Errorf(`provider "example" attribute "first_attr" object attribute type "obj_attr" is duplicated`),
+			expectedError: fmt.Errorf(`ephemeral resource "example" block "first_block" block "nested_block" is duplicated`),
 		},
-		"provider-object-object-attribute-type-names-duplicated": {
+		"ephemeral-resource-single-nested-block-names-unique": {
 			spec: spec.Specification{
-				Provider: &provider.Provider{
-					Name: "example",
-					Schema: &provider.Schema{
-						Attributes: []provider.Attribute{
-							{
-								Name: "first_attr",
-								Object: &provider.ObjectAttribute{
-									AttributeTypes: []schema.ObjectAttributeType{
-										{
-											Name: "obj_attr",
-											Object: &schema.ObjectType{
-												AttributeTypes: []schema.ObjectAttributeType{
-													{
-														Name: "obj_obj_attr",
-													},
-													{
-														Name: "obj_obj_attr",
-													},
-												},
+				EphemeralResources: ephemeralresource.EphemeralResources{
+					{
+						Name: "example",
+						Schema: &ephemeralresource.Schema{
+							Blocks: []ephemeralresource.Block{
+								{
+									Name: "first_block",
+									SingleNested: &ephemeralresource.SingleNestedBlock{
+										Blocks: []ephemeralresource.Block{
+											{
+												Name: "nested_first_block",
+											},
+											{
+												Name: "nested_second_block",
 											},
 										},
 									},
@@ -5032,26 +5152,20 @@ func TestSpecification_Validate_Provider(t *testing.T) {
 					},
 				},
 			},
-			expectedError: fmt.Errorf(`provider "example" attribute "first_attr" object attribute type "obj_attr" object attribute type "obj_obj_attr" is duplicated`),
 		},
-		"provider-object-and-object-object-attribute-type-names-duplicated": {
+		"ephemeral-resource-block-and-single-nested-block-names-duplicated": {
 			spec: spec.Specification{
-				Provider: &provider.Provider{
-					Name: "example",
-					Schema: &provider.Schema{
-						Attributes: []provider.Attribute{
-							{
-								Name: "obj_attr",
-								Object: &provider.ObjectAttribute{
-									AttributeTypes: []schema.ObjectAttributeType{
-										{
-											Name: "obj_attr",
-											Object: &schema.ObjectType{
-												AttributeTypes: []schema.ObjectAttributeType{
-													{
-														Name: "obj_attr",
-													},
-												},
+				EphemeralResources: ephemeralresource.EphemeralResources{
+					{
+						Name: "example",
+						Schema: &ephemeralresource.Schema{
+							Blocks: []ephemeralresource.Block{
+								{
+									Name: "first_block",
+									SingleNested: &ephemeralresource.SingleNestedBlock{
+										Blocks: []ephemeralresource.Block{
+											{
+												Name: "first_block",
 											},
 										},
 									},
@@ -5062,96 +5176,1688 @@ func TestSpecification_Validate_Provider(t *testing.T) {
 				},
 			},
 		},
-	}
-
-	for name, testCase := range testCases {
-
-		t.Run(name, func(t *testing.T) {
-			t.Parallel()
-
-			err := testCase.spec.Validate(context.Background())
-
-			if err != nil {
-				if testCase.expectedError == nil {
-					t.Fatalf("expected no error, got: %s", err)
-				}
-
-				if err.Error() != testCase.expectedError.Error() {
-					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
-				}
-			}
-
-			if err == nil && testCase.expectedError != nil {
-				t.Fatalf("got no error, expected: %s", testCase.expectedError)
-			}
-		})
-	}
-}
-
-func TestSpecification_Validate_Resources(t *testing.T) {
-	t.Parallel()
-
-	testCases := map[string]struct {
-		spec          spec.Specification
-		expectedError error
-	}{
-		"resource-names-duplicated": {
+		"ephemeral-resource-object-attribute-type-names-duplicated": {
 			spec: spec.Specification{
-				Resources: resource.Resources{
-					{
-						Name: "example",
-					},
+				EphemeralResources: ephemeralresource.EphemeralResources{
 					{
 						Name: "example",
+						Schema: &ephemeralresource.Schema{
+							Attributes: []ephemeralresource.Attribute{
+								{
+									Name: "first_attr",
+									Object: &ephemeralresource.ObjectAttribute{
+										AttributeTypes: []schema.ObjectAttributeType{
+											{
+												Name: "obj_attr",
+											},
+											{
+												Name: "obj_attr",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`ephemeral resource "example" attribute "first_attr" object attribute type "obj_attr" is duplicated`),
+		},
+		"ephemeral-resource-object-object-attribute-type-names-duplicated": {
+			spec: spec.Specification{
+				EphemeralResources: ephemeralresource.EphemeralResources{
+					{
+						Name: "example",
+						Schema: &ephemeralresource.Schema{
+							Attributes: []ephemeralresource.Attribute{
+								{
+									Name: "first_attr",
+									Object: &ephemeralresource.ObjectAttribute{
+										AttributeTypes: []schema.ObjectAttributeType{
+											{
+												Name: "obj_attr",
+												Object: &schema.ObjectType{
+													AttributeTypes: []schema.ObjectAttributeType{
+														{
+															Name: "obj_obj_attr",
+														},
+														{
+															Name: "obj_obj_attr",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`ephemeral resource "example" attribute "first_attr" object attribute type "obj_attr" object attribute type "obj_obj_attr" is duplicated`),
+		},
+		"ephemeral-resource-object-and-object-object-attribute-type-names-duplicated": {
+			spec: spec.Specification{
+				EphemeralResources: ephemeralresource.EphemeralResources{
+					{
+						Name: "example",
+						Schema: &ephemeralresource.Schema{
+							Attributes: []ephemeralresource.Attribute{
+								{
+									Name: "obj_attr",
+									Object: &ephemeralresource.ObjectAttribute{
+										AttributeTypes: []schema.ObjectAttributeType{
+											{
+												Name: "obj_attr",
+												Object: &schema.ObjectType{
+													AttributeTypes: []schema.ObjectAttributeType{
+														{
+															Name: "obj_attr",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.spec.Validate(context.Background())
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError.Error() {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestSpecification_Validate_Functions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		spec          spec.Specification
+		expectedError error
+	}{
+		"function-names-duplicated": {
+			spec: spec.Specification{
+				Functions: function.Functions{
+					{
+						Name: "example",
+					},
+					{
+						Name: "example",
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`function "example" is duplicated`),
+		},
+		"function-names-unique": {
+			spec: spec.Specification{
+				Functions: function.Functions{
+					{
+						Name: "example",
+					},
+					{
+						Name: "different",
+					},
+				},
+			},
+		},
+		"function-parameter-names-duplicated": {
+			spec: spec.Specification{
+				Functions: function.Functions{
+					{
+						Name: "example",
+						Parameters: function.Parameters{
+							{
+								Name: "first_param",
+							},
+							{
+								Name: "first_param",
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`function "example" parameter "first_param" is duplicated`),
+		},
+		"function-variadic-parameter-name-duplicated": {
+			spec: spec.Specification{
+				Functions: function.Functions{
+					{
+						Name: "example",
+						Parameters: function.Parameters{
+							{
+								Name: "first_param",
+							},
+						},
+						VariadicParameter: &function.Parameter{
+							Name: "first_param",
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`function "example" parameter "first_param" is duplicated`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.spec.Validate(context.Background())
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError.Error() {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestSpecification_Validate_Provider(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		spec          spec.Specification
+		expectedError error
+	}{
+		"provider-attribute-names-duplicated": {
+			spec: spec.Specification{
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Attributes: []provider.Attribute{
+							{
+								Name: "first_attr",
+							},
+							{
+								Name: "first_attr",
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`provider "example" attribute "first_attr" is duplicated`),
+		},
+		"provider-attribute-names-unique": {
+			spec: spec.Specification{
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Attributes: []provider.Attribute{
+							{
+								Name: "first_attr",
+							},
+							{
+								Name: "second_attr",
+							},
+						},
+					},
+				},
+			},
+		},
+		"provider-block-names-duplicated": {
+			spec: spec.Specification{
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Blocks: []provider.Block{
+							{
+								Name: "first_block",
+							},
+							{
+								Name: "first_block",
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`provider "example" block "first_block" is duplicated`),
+		},
+		"provider-attribute-and-block-names-duplicated": {
+			spec: spec.Specification{
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Attributes: []provider.Attribute{
+							{
+								Name: "first",
+							},
+						},
+						Blocks: []provider.Block{
+							{
+								Name: "first",
+							},
+						},
+					},
+				},
+			},
+		},
+		"provider-block-names-unique": {
+			spec: spec.Specification{
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Blocks: []provider.Block{
+							{
+								Name: "first_block",
+							},
+							{
+								Name: "second_block",
+							},
+						},
+					},
+				},
+			},
+		},
+		"provider-list-nested-attribute-names-duplicated": {
+			spec: spec.Specification{
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Attributes: []provider.Attribute{
+							{
+								Name: "first_attr",
+								ListNested: &provider.ListNestedAttribute{
+									NestedObject: provider.NestedAttributeObject{
+										Attributes: []provider.Attribute{
+											{
+												Name: "nested_attr",
+											},
+											{
+												Name: "nested_attr",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`provider "example" attribute "first_attr" attribute "nested_attr" is duplicated`),
+		},
+		"provider-list-nested-attribute-names-unique": {
+			spec: spec.Specification{
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Attributes: []provider.Attribute{
+							{
+								Name: "first_attr",
+								ListNested: &provider.ListNestedAttribute{
+									NestedObject: provider.NestedAttributeObject{
+										Attributes: []provider.Attribute{
+											{
+												Name: "nested_first_attr",
+											},
+											{
+												Name: "nested_second_attr",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"provider-attribute-and-list-nested-attribute-names-duplicated": {
+			spec: spec.Specification{
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Attributes: []provider.Attribute{
+							{
+								Name: "first_attr",
+								ListNested: &provider.ListNestedAttribute{
+									NestedObject: provider.NestedAttributeObject{
+										Attributes: []provider.Attribute{
+											{
+												Name: "first_attr",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"provider-map-nested-attribute-names-duplicated": {
+			spec: spec.Specification{
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Attributes: []provider.Attribute{
+							{
+								Name: "first_attr",
+								MapNested: &provider.MapNestedAttribute{
+									NestedObject: provider.NestedAttributeObject{
+										Attributes: []provider.Attribute{
+											{
+												Name: "nested_attr",
+											},
+											{
+												Name: "nested_attr",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`provider "example" attribute "first_attr" attribute "nested_attr" is duplicated`),
+		},
+		"provider-map-nested-attribute-names-unique": {
+			spec: spec.Specification{
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Attributes: []provider.Attribute{
+							{
+								Name: "first_attr",
+								MapNested: &provider.MapNestedAttribute{
+									NestedObject: provider.NestedAttributeObject{
+										Attributes: []provider.Attribute{
+											{
+												Name: "nested_first_attr",
+											},
+											{
+												Name: "nested_second_attr",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"provider-attribute-and-map-nested-attribute-names-duplicated": {
+			spec: spec.Specification{
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Attributes: []provider.Attribute{
+							{
+								Name: "first_attr",
+								MapNested: &provider.MapNestedAttribute{
+									NestedObject: provider.NestedAttributeObject{
+										Attributes: []provider.Attribute{
+											{
+												Name: "first_attr",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"provider-set-nested-attribute-names-duplicated": {
+			spec: spec.Specification{
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Attributes: []provider.Attribute{
+							{
+								Name: "first_attr",
+								SetNested: &provider.SetNestedAttribute{
+									NestedObject: provider.NestedAttributeObject{
+										Attributes: []provider.Attribute{
+											{
+												Name: "nested_attr",
+											},
+											{
+												Name: "nested_attr",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`provider "example" attribute "first_attr" attribute "nested_attr" is duplicated`),
+		},
+		"provider-set-nested-attribute-names-unique": {
+			spec: spec.Specification{
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Attributes: []provider.Attribute{
+							{
+								Name: "first_attr",
+								SetNested: &provider.SetNestedAttribute{
+									NestedObject: provider.NestedAttributeObject{
+										Attributes: []provider.Attribute{
+											{
+												Name: "nested_first_attr",
+											},
+											{
+												Name: "nested_second_attr",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"provider-attribute-and-set-nested-attribute-names-duplicated": {
+			spec: spec.Specification{
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Attributes: []provider.Attribute{
+							{
+								Name: "first_attr",
+								SetNested: &provider.SetNestedAttribute{
+									NestedObject: provider.NestedAttributeObject{
+										Attributes: []provider.Attribute{
+											{
+												Name: "first_attr",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"provider-single-nested-attribute-names-duplicated": {
+			spec: spec.Specification{
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Attributes: []provider.Attribute{
+							{
+								Name: "first_attr",
+								SingleNested: &provider.SingleNestedAttribute{
+									Attributes: []provider.Attribute{
+										{
+											Name: "nested_attr",
+										},
+										{
+											Name: "nested_attr",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`provider "example" attribute "first_attr" attribute "nested_attr" is duplicated`),
+		},
+		"provider-single-nested-attribute-names-unique": {
+			spec: spec.Specification{
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Attributes: []provider.Attribute{
+							{
+								Name: "first_attr",
+								SingleNested: &provider.SingleNestedAttribute{
+									Attributes: []provider.Attribute{
+										{
+											Name: "nested_first_attr",
+										},
+										{
+											Name: "nested_second_attr",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"provider-attribute-and-single-nested-attribute-names-duplicated": {
+			spec: spec.Specification{
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Attributes: []provider.Attribute{
+							{
+								Name: "first_attr",
+								SingleNested: &provider.SingleNestedAttribute{
+									Attributes: []provider.Attribute{
+										{
+											Name: "first_attr",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"provider-list-nested-block-names-duplicated": {
+			spec: spec.Specification{
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Blocks: []provider.Block{
+							{
+								Name: "first_block",
+								ListNested: &provider.ListNestedBlock{
+									NestedObject: provider.NestedBlockObject{
+										Blocks: []provider.Block{
+											{
+												Name: "nested_block",
+											},
+											{
+												Name: "nested_block",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`provider "example" block "first_block" block "nested_block" is duplicated`),
+		},
+		"provider-list-nested-block-names-unique": {
+			spec: spec.Specification{
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Blocks: []provider.Block{
+							{
+								Name: "first_block",
+								ListNested: &provider.ListNestedBlock{
+									NestedObject: provider.NestedBlockObject{
+										Blocks: []provider.Block{
+											{
+												Name: "nested_first_block",
+											},
+											{
+												Name: "nested_second_block",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"provider-block-and-list-nested-block-names-duplicated": {
+			spec: spec.Specification{
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Blocks: []provider.Block{
+							{
+								Name: "first_block",
+								ListNested: &provider.ListNestedBlock{
+									NestedObject: provider.NestedBlockObject{
+										Blocks: []provider.Block{
+											{
+												Name: "first_block",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"provider-set-nested-block-names-duplicated": {
+			spec: spec.Specification{
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Blocks: []provider.Block{
+							{
+								Name: "first_block",
+								SetNested: &provider.SetNestedBlock{
+									NestedObject: provider.NestedBlockObject{
+										Blocks: []provider.Block{
+											{
+												Name: "nested_block",
+											},
+											{
+												Name: "nested_block",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`provider "example" block "first_block" block "nested_block" is duplicated`),
+		},
+		"provider-set-nested-block-names-unique": {
+			spec: spec.Specification{
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Blocks: []provider.Block{
+							{
+								Name: "first_block",
+								SetNested: &provider.SetNestedBlock{
+									NestedObject: provider.NestedBlockObject{
+										Blocks: []provider.Block{
+											{
+												Name: "nested_first_block",
+											},
+											{
+												Name: "nested_second_block",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"provider-block-and-set-nested-block-names-duplicated": {
+			spec: spec.Specification{
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Blocks: []provider.Block{
+							{
+								Name: "first_block",
+								SetNested: &provider.SetNestedBlock{
+									NestedObject: provider.NestedBlockObject{
+										Blocks: []provider.Block{
+											{
+												Name: "first_block",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"provider-single-nested-block-names-duplicated": {
+			spec: spec.Specification{
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Blocks: []provider.Block{
+							{
+								Name: "first_block",
+								SingleNested: &provider.SingleNestedBlock{
+									Blocks: []provider.Block{
+										{
+											Name: "nested_block",
+										},
+										{
+											Name: "nested_block",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`provider "example" block "first_block" block "nested_block" is duplicated`),
+		},
+		"provider-single-nested-block-names-unique": {
+			spec: spec.Specification{
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Blocks: []provider.Block{
+							{
+								Name: "first_block",
+								SingleNested: &provider.SingleNestedBlock{
+									Blocks: []provider.Block{
+										{
+											Name: "nested_first_block",
+										},
+										{
+											Name: "nested_second_block",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"provider-block-and-single-nested-block-names-duplicated": {
+			spec: spec.Specification{
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Blocks: []provider.Block{
+							{
+								Name: "first_block",
+								SingleNested: &provider.SingleNestedBlock{
+									Blocks: []provider.Block{
+										{
+											Name: "first_block",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"provider-object-attribute-type-names-duplicated": {
+			spec: spec.Specification{
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Attributes: []provider.Attribute{
+							{
+								Name: "first_attr",
+								Object: &provider.ObjectAttribute{
+									AttributeTypes: []schema.ObjectAttributeType{
+										{
+											Name: "obj_attr",
+										},
+										{
+											Name: "obj_attr",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`provider "example" attribute "first_attr" object attribute type "obj_attr" is duplicated`),
+		},
+		"provider-object-object-attribute-type-names-duplicated": {
+			spec: spec.Specification{
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Attributes: []provider.Attribute{
+							{
+								Name: "first_attr",
+								Object: &provider.ObjectAttribute{
+									AttributeTypes: []schema.ObjectAttributeType{
+										{
+											Name: "obj_attr",
+											Object: &schema.ObjectType{
+												AttributeTypes: []schema.ObjectAttributeType{
+													{
+														Name: "obj_obj_attr",
+													},
+													{
+														Name: "obj_obj_attr",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`provider "example" attribute "first_attr" object attribute type "obj_attr" object attribute type "obj_obj_attr" is duplicated`),
+		},
+		"provider-object-and-object-object-attribute-type-names-duplicated": {
+			spec: spec.Specification{
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Attributes: []provider.Attribute{
+							{
+								Name: "obj_attr",
+								Object: &provider.ObjectAttribute{
+									AttributeTypes: []schema.ObjectAttributeType{
+										{
+											Name: "obj_attr",
+											Object: &schema.ObjectType{
+												AttributeTypes: []schema.ObjectAttributeType{
+													{
+														Name: "obj_attr",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"provider-config-validator-path-unresolved": {
+			spec: spec.Specification{
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Attributes: provider.Attributes{
+							{
+								Name: "attr_one",
+								String: &provider.StringAttribute{
+									OptionalRequired: schema.Optional,
+								},
+							},
+						},
+						ConfigValidators: schema.ConfigValidators{
+							{
+								RequiredTogether: &schema.PathRelationshipValidator{
+									Paths: schema.PathExpressions{
+										"attr_one",
+										"attr_two",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`provider "example" required_together config validator path "attr_two" does not resolve to an attribute or block`),
+		},
+		"provider-reserved-name": {
+			spec: spec.Specification{
+				Version: spec.Version0_2,
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Attributes: provider.Attributes{
+							{
+								Name: "version",
+								String: &provider.StringAttribute{
+									OptionalRequired: schema.Optional,
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`provider "example" attribute "version" is a reserved name`),
+		},
+		"provider-reserved-name-version-0.1": {
+			spec: spec.Specification{
+				Version: spec.Version0_1,
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Attributes: provider.Attributes{
+							{
+								Name: "version",
+								String: &provider.StringAttribute{
+									OptionalRequired: schema.Optional,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.spec.Validate(context.Background())
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError.Error() {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestSpecification_Validate_Resources(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		spec          spec.Specification
+		expectedError error
+	}{
+		"resource-names-duplicated": {
+			spec: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "example",
+					},
+					{
+						Name: "example",
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" is duplicated`),
+		},
+		"resource-names-unique": {
+			spec: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "example",
+					},
+					{
+						Name: "different",
+					},
+				},
+			},
+		},
+		"resource-attribute-names-duplicated": {
+			spec: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "example",
+						Schema: &resource.Schema{
+							Attributes: []resource.Attribute{
+								{
+									Name: "first_attr",
+								},
+								{
+									Name: "first_attr",
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "first_attr" is duplicated`),
+		},
+		"resource-attribute-default-optional-version-0.1": {
+			spec: spec.Specification{
+				Version: spec.Version0_1,
+				Resources: resource.Resources{
+					{
+						Name: "example",
+						Schema: &resource.Schema{
+							Attributes: []resource.Attribute{
+								{
+									Name: "first_attr",
+									Bool: &resource.BoolAttribute{
+										ComputedOptionalRequired: schema.Optional,
+										Default: &schema.BoolDefault{
+											Static: pointer(true),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"resource-attribute-default-optional-version-empty": {
+			spec: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "example",
+						Schema: &resource.Schema{
+							Attributes: []resource.Attribute{
+								{
+									Name: "first_attr",
+									Bool: &resource.BoolAttribute{
+										ComputedOptionalRequired: schema.Optional,
+										Default: &schema.BoolDefault{
+											Static: pointer(true),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"resource-attribute-default-optional-version-0.2": {
+			spec: spec.Specification{
+				Version: spec.Version0_2,
+				Resources: resource.Resources{
+					{
+						Name: "example",
+						Schema: &resource.Schema{
+							Attributes: []resource.Attribute{
+								{
+									Name: "first_attr",
+									Bool: &resource.BoolAttribute{
+										ComputedOptionalRequired: schema.Optional,
+										Default: &schema.BoolDefault{
+											Static: pointer(true),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "first_attr" has a default and must be computed or computed_optional, not optional`),
+		},
+		"resource-attribute-names-unique": {
+			spec: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "example",
+						Schema: &resource.Schema{
+							Attributes: []resource.Attribute{
+								{
+									Name: "first_attr",
+								},
+								{
+									Name: "second_attr",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"resource-block-names-duplicated": {
+			spec: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "example",
+						Schema: &resource.Schema{
+							Blocks: []resource.Block{
+								{
+									Name: "first_block",
+								},
+								{
+									Name: "first_block",
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" block "first_block" is duplicated`),
+		},
+		"resource-attribute-and-block-names-duplicated": {
+			spec: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "example",
+						Schema: &resource.Schema{
+							Attributes: []resource.Attribute{
+								{
+									Name: "first",
+								},
+							},
+							Blocks: []resource.Block{
+								{
+									Name: "first",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"resource-block-names-unique": {
+			spec: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "example",
+						Schema: &resource.Schema{
+							Blocks: []resource.Block{
+								{
+									Name: "first_block",
+								},
+								{
+									Name: "second_block",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"resource-list-nested-attribute-names-duplicated": {
+			spec: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "example",
+						Schema: &resource.Schema{
+							Attributes: []resource.Attribute{
+								{
+									Name: "first_attr",
+									ListNested: &resource.ListNestedAttribute{
+										NestedObject: resource.NestedAttributeObject{
+											Attributes: []resource.Attribute{
+												{
+													Name: "nested_attr",
+												},
+												{
+													Name: "nested_attr",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "first_attr" attribute "nested_attr" is duplicated`),
+		},
+		"resource-list-nested-attribute-names-unique": {
+			spec: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "example",
+						Schema: &resource.Schema{
+							Attributes: []resource.Attribute{
+								{
+									Name: "first_attr",
+									ListNested: &resource.ListNestedAttribute{
+										NestedObject: resource.NestedAttributeObject{
+											Attributes: []resource.Attribute{
+												{
+													Name: "nested_first_attr",
+												},
+												{
+													Name: "nested_second_attr",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"resource-attribute-and-list-nested-attribute-names-duplicated": {
+			spec: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "example",
+						Schema: &resource.Schema{
+							Attributes: []resource.Attribute{
+								{
+									Name: "first_attr",
+									ListNested: &resource.ListNestedAttribute{
+										NestedObject: resource.NestedAttributeObject{
+											Attributes: []resource.Attribute{
+												{
+													Name: "first_attr",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"resource-map-nested-attribute-names-duplicated": {
+			spec: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "example",
+						Schema: &resource.Schema{
+							Attributes: []resource.Attribute{
+								{
+									Name: "first_attr",
+									MapNested: &resource.MapNestedAttribute{
+										NestedObject: resource.NestedAttributeObject{
+											Attributes: []resource.Attribute{
+												{
+													Name: "nested_attr",
+												},
+												{
+													Name: "nested_attr",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "first_attr" attribute "nested_attr" is duplicated`),
+		},
+		"resource-map-nested-attribute-names-unique": {
+			spec: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "example",
+						Schema: &resource.Schema{
+							Attributes: []resource.Attribute{
+								{
+									Name: "first_attr",
+									MapNested: &resource.MapNestedAttribute{
+										NestedObject: resource.NestedAttributeObject{
+											Attributes: []resource.Attribute{
+												{
+													Name: "nested_first_attr",
+												},
+												{
+													Name: "nested_second_attr",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"resource-attribute-and-map-nested-attribute-names-duplicated": {
+			spec: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "example",
+						Schema: &resource.Schema{
+							Attributes: []resource.Attribute{
+								{
+									Name: "first_attr",
+									MapNested: &resource.MapNestedAttribute{
+										NestedObject: resource.NestedAttributeObject{
+											Attributes: []resource.Attribute{
+												{
+													Name: "first_attr",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
-			expectedError: fmt.Errorf(`resource "example" is duplicated`),
 		},
-		"resource-names-unique": {
+		"resource-set-nested-attribute-names-duplicated": {
 			spec: spec.Specification{
 				Resources: resource.Resources{
 					{
 						Name: "example",
+						Schema: &resource.Schema{
+							Attributes: []resource.Attribute{
+								{
+									Name: "first_attr",
+									SetNested: &resource.SetNestedAttribute{
+										NestedObject: resource.NestedAttributeObject{
+											Attributes: []resource.Attribute{
+												{
+													Name: "nested_attr",
+												},
+												{
+													Name: "nested_attr",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 					},
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "first_attr" attribute "nested_attr" is duplicated`),
+		},
+		"resource-set-nested-attribute-names-unique": {
+			spec: spec.Specification{
+				Resources: resource.Resources{
 					{
-						Name: "different",
+						Name: "example",
+						Schema: &resource.Schema{
+							Attributes: []resource.Attribute{
+								{
+									Name: "first_attr",
+									SetNested: &resource.SetNestedAttribute{
+										NestedObject: resource.NestedAttributeObject{
+											Attributes: []resource.Attribute{
+												{
+													Name: "nested_first_attr",
+												},
+												{
+													Name: "nested_second_attr",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"resource-attribute-and-set-nested-attribute-names-duplicated": {
+			spec: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "example",
+						Schema: &resource.Schema{
+							Attributes: []resource.Attribute{
+								{
+									Name: "first_attr",
+									SetNested: &resource.SetNestedAttribute{
+										NestedObject: resource.NestedAttributeObject{
+											Attributes: []resource.Attribute{
+												{
+													Name: "first_attr",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"resource-single-nested-attribute-names-duplicated": {
+			spec: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "example",
+						Schema: &resource.Schema{
+							Attributes: []resource.Attribute{
+								{
+									Name: "first_attr",
+									SingleNested: &resource.SingleNestedAttribute{
+										Attributes: []resource.Attribute{
+											{
+												Name: "nested_attr",
+											},
+											{
+												Name: "nested_attr",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" attribute "first_attr" attribute "nested_attr" is duplicated`),
+		},
+		"resource-single-nested-attribute-names-unique": {
+			spec: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "example",
+						Schema: &resource.Schema{
+							Attributes: []resource.Attribute{
+								{
+									Name: "first_attr",
+									SingleNested: &resource.SingleNestedAttribute{
+										Attributes: []resource.Attribute{
+											{
+												Name: "nested_first_attr",
+											},
+											{
+												Name: "nested_second_attr",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"resource-attribute-and-single-nested-attribute-names-duplicated": {
+			spec: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "example",
+						Schema: &resource.Schema{
+							Attributes: []resource.Attribute{
+								{
+									Name: "first_attr",
+									SingleNested: &resource.SingleNestedAttribute{
+										Attributes: []resource.Attribute{
+											{
+												Name: "first_attr",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"resource-list-nested-block-names-duplicated": {
+			spec: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "example",
+						Schema: &resource.Schema{
+							Blocks: []resource.Block{
+								{
+									Name: "first_block",
+									ListNested: &resource.ListNestedBlock{
+										NestedObject: resource.NestedBlockObject{
+											Blocks: []resource.Block{
+												{
+													Name: "nested_block",
+												},
+												{
+													Name: "nested_block",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf(`resource "example" block "first_block" block "nested_block" is duplicated`),
+		},
+		"resource-list-nested-block-names-unique": {
+			spec: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "example",
+						Schema: &resource.Schema{
+							Blocks: []resource.Block{
+								{
+									Name: "first_block",
+									ListNested: &resource.ListNestedBlock{
+										NestedObject: resource.NestedBlockObject{
+											Blocks: []resource.Block{
+												{
+													Name: "nested_first_block",
+												},
+												{
+													Name: "nested_second_block",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"resource-block-and-list-nested-block-names-duplicated": {
+			spec: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "example",
+						Schema: &resource.Schema{
+							Blocks: []resource.Block{
+								{
+									Name: "first_block",
+									ListNested: &resource.ListNestedBlock{
+										NestedObject: resource.NestedBlockObject{
+											Blocks: []resource.Block{
+												{
+													Name: "first_block",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"resource-set-nested-block-names-duplicated": {
+			spec: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "example",
+						Schema: &resource.Schema{
+							Blocks: []resource.Block{
+								{
+									Name: "first_block",
+									SetNested: &resource.SetNestedBlock{
+										NestedObject: resource.NestedBlockObject{
+											Blocks: []resource.Block{
+												{
+													Name: "nested_block",
+												},
+												{
+													Name: "nested_block",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
+			expectedError: fmt.Errorf(`resource "example" block "first_block" block "nested_block" is duplicated`),
 		},
-		"resource-attribute-names-duplicated": {
+		"resource-set-nested-block-names-unique": {
 			spec: spec.Specification{
 				Resources: resource.Resources{
 					{
 						Name: "example",
 						Schema: &resource.Schema{
-							Attributes: []resource.Attribute{
-								{
-									Name: "first_attr",
-								},
+							Blocks: []resource.Block{
 								{
-									Name: "first_attr",
+									Name: "first_block",
+									SetNested: &resource.SetNestedBlock{
+										NestedObject: resource.NestedBlockObject{
+											Blocks: []resource.Block{
+												{
+													Name: "nested_first_block",
+												},
+												{
+													Name: "nested_second_block",
+												},
+											},
+										},
+									},
 								},
 							},
 						},
 					},
 				},
 			},
-			expectedError: fmt.Errorf(`resource "example" attribute "first_attr" is duplicated`),
 		},
-		"resource-attribute-names-unique": {
+		"resource-block-and-set-nested-block-names-duplicated": {
 			spec: spec.Specification{
 				Resources: resource.Resources{
 					{
 						Name: "example",
 						Schema: &resource.Schema{
-							Attributes: []resource.Attribute{
-								{
-									Name: "first_attr",
-								},
+							Blocks: []resource.Block{
 								{
-									Name: "second_attr",
+									Name: "first_block",
+									SetNested: &resource.SetNestedBlock{
+										NestedObject: resource.NestedBlockObject{
+											Blocks: []resource.Block{
+												{
+													Name: "first_block",
+												},
+											},
+										},
+									},
 								},
 							},
 						},
@@ -5159,7 +6865,7 @@ func TestSpecification_Validate_Resources(t *testing.T) {
 				},
 			},
 		},
-		"resource-block-names-duplicated": {
+		"resource-single-nested-block-names-duplicated": {
 			spec: spec.Specification{
 				Resources: resource.Resources{
 					{
@@ -5168,31 +6874,43 @@ func TestSpecification_Validate_Resources(t *testing.T) {
 							Blocks: []resource.Block{
 								{
 									Name: "first_block",
-								},
-								{
-									Name: "first_block",
+									SingleNested: &resource.SingleNestedBlock{
+										Blocks: []resource.Block{
+											{
+												Name: "nested_block",
+											},
+											{
+												Name: "nested_block",
+											},
+										},
+									},
 								},
 							},
 						},
 					},
 				},
 			},
-			expectedError: fmt.Errorf(`resource "example" block "first_block" is duplicated`),
+			expectedError: fmt.Errorf(`resource "example" block "first_block" block "nested_block" is duplicated`),
 		},
-		"resource-attribute-and-block-names-duplicated": {
+		"resource-single-nested-block-names-unique": {
 			spec: spec.Specification{
 				Resources: resource.Resources{
 					{
 						Name: "example",
 						Schema: &resource.Schema{
-							Attributes: []resource.Attribute{
-								{
-									Name: "first",
-								},
-							},
 							Blocks: []resource.Block{
 								{
-									Name: "first",
+									Name: "first_block",
+									SingleNested: &resource.SingleNestedBlock{
+										Blocks: []resource.Block{
+											{
+												Name: "nested_first_block",
+											},
+											{
+												Name: "nested_second_block",
+											},
+										},
+									},
 								},
 							},
 						},
@@ -5200,7 +6918,7 @@ func TestSpecification_Validate_Resources(t *testing.T) {
 				},
 			},
 		},
-		"resource-block-names-unique": {
+		"resource-block-and-single-nested-block-names-duplicated": {
 			spec: spec.Specification{
 				Resources: resource.Resources{
 					{
@@ -5209,9 +6927,13 @@ func TestSpecification_Validate_Resources(t *testing.T) {
 							Blocks: []resource.Block{
 								{
 									Name: "first_block",
-								},
-								{
-									Name: "second_block",
+									SingleNested: &resource.SingleNestedBlock{
+										Blocks: []resource.Block{
+											{
+												Name: "first_block",
+											},
+										},
+									},
 								},
 							},
 						},
@@ -5219,7 +6941,7 @@ func TestSpecification_Validate_Resources(t *testing.T) {
 				},
 			},
 		},
-		"resource-list-nested-attribute-names-duplicated": {
+		"resource-object-attribute-type-names-duplicated": {
 			spec: spec.Specification{
 				Resources: resource.Resources{
 					{
@@ -5228,15 +6950,13 @@ func TestSpecification_Validate_Resources(t *testing.T) {
 							Attributes: []resource.Attribute{
 								{
 									Name: "first_attr",
-									ListNested: &resource.ListNestedAttribute{
-										NestedObject: resource.NestedAttributeObject{
-											Attributes: []resource.Attribute{
-												{
-													Name: "nested_attr",
-												},
-												{
-													Name: "nested_attr",
-												},
+									Object: &resource.ObjectAttribute{
+										AttributeTypes: []schema.ObjectAttributeType{
+											{
+												Name: "obj_attr",
+											},
+											{
+												Name: "obj_attr",
 											},
 										},
 									},
@@ -5246,9 +6966,9 @@ func TestSpecification_Validate_Resources(t *testing.T) {
 					},
 				},
 			},
-			expectedError: fmt.Errorf(`resource "example" attribute "first_attr" attribute "nested_attr" is duplicated`),
+			expectedError: fmt.Errorf(`resource "example" attribute "first_attr" object attribute type "obj_attr" is duplicated`),
 		},
-		"resource-list-nested-attribute-names-unique": {
+		"resource-object-object-attribute-type-names-duplicated": {
 			spec: spec.Specification{
 				Resources: resource.Resources{
 					{
@@ -5257,14 +6977,19 @@ func TestSpecification_Validate_Resources(t *testing.T) {
 							Attributes: []resource.Attribute{
 								{
 									Name: "first_attr",
-									ListNested: &resource.ListNestedAttribute{
-										NestedObject: resource.NestedAttributeObject{
-											Attributes: []resource.Attribute{
-												{
-													Name: "nested_first_attr",
-												},
-												{
-													Name: "nested_second_attr",
+									Object: &resource.ObjectAttribute{
+										AttributeTypes: []schema.ObjectAttributeType{
+											{
+												Name: "obj_attr",
+												Object: &schema.ObjectType{
+													AttributeTypes: []schema.ObjectAttributeType{
+														{
+															Name: "obj_obj_attr",
+														},
+														{
+															Name: "obj_obj_attr",
+														},
+													},
 												},
 											},
 										},
@@ -5275,8 +7000,9 @@ func TestSpecification_Validate_Resources(t *testing.T) {
 					},
 				},
 			},
+			expectedError: fmt.Errorf(`resource "example" attribute "first_attr" object attribute type "obj_attr" object attribute type "obj_obj_attr" is duplicated`),
 		},
-		"resource-attribute-and-list-nested-attribute-names-duplicated": {
+		"resource-object-and-object-object-attribute-type-names-duplicated": {
 			spec: spec.Specification{
 				Resources: resource.Resources{
 					{
@@ -5284,12 +7010,17 @@ func TestSpecification_Validate_Resources(t *testing.T) {
 						Schema: &resource.Schema{
 							Attributes: []resource.Attribute{
 								{
-									Name: "first_attr",
-									ListNested: &resource.ListNestedAttribute{
-										NestedObject: resource.NestedAttributeObject{
-											Attributes: []resource.Attribute{
-												{
-													Name: "first_attr",
+									Name: "obj_attr",
+									Object: &resource.ObjectAttribute{
+										AttributeTypes: []schema.ObjectAttributeType{
+											{
+												Name: "obj_attr",
+												Object: &schema.ObjectType{
+													AttributeTypes: []schema.ObjectAttributeType{
+														{
+															Name: "obj_attr",
+														},
+													},
 												},
 											},
 										},
@@ -5301,23 +7032,31 @@ func TestSpecification_Validate_Resources(t *testing.T) {
 				},
 			},
 		},
-		"resource-map-nested-attribute-names-duplicated": {
+		"resource-set-nested-block-write-only-attribute": {
 			spec: spec.Specification{
 				Resources: resource.Resources{
 					{
 						Name: "example",
 						Schema: &resource.Schema{
-							Attributes: []resource.Attribute{
+							Blocks: resource.Blocks{
 								{
-									Name: "first_attr",
-									MapNested: &resource.MapNestedAttribute{
-										NestedObject: resource.NestedAttributeObject{
-											Attributes: []resource.Attribute{
-												{
-													Name: "nested_attr",
-												},
+									Name: "set_nested_block",
+									SetNested: &resource.SetNestedBlock{
+										NestedObject: resource.NestedBlockObject{
+											Blocks: resource.Blocks{
 												{
-													Name: "nested_attr",
+													Name: "single_nested_block",
+													SingleNested: &resource.SingleNestedBlock{
+														Attributes: resource.Attributes{
+															{
+																Name: "write_only_attr",
+																String: &resource.StringAttribute{
+																	ComputedOptionalRequired: schema.Optional,
+																	WriteOnly:                pointer(true),
+																},
+															},
+														},
+													},
 												},
 											},
 										},
@@ -5328,25 +7067,26 @@ func TestSpecification_Validate_Resources(t *testing.T) {
 					},
 				},
 			},
-			expectedError: fmt.Errorf(`resource "example" attribute "first_attr" attribute "nested_attr" is duplicated`),
+			expectedError: fmt.Errorf(`resource "example" block "set_nested_block" block "single_nested_block" attribute "write_only_attr" is write_only, which is not supported within a set nested attribute or block`),
 		},
-		"resource-map-nested-attribute-names-unique": {
+		"resource-list-nested-block-write-only-attribute": {
 			spec: spec.Specification{
 				Resources: resource.Resources{
 					{
 						Name: "example",
 						Schema: &resource.Schema{
-							Attributes: []resource.Attribute{
+							Blocks: resource.Blocks{
 								{
-									Name: "first_attr",
-									MapNested: &resource.MapNestedAttribute{
-										NestedObject: resource.NestedAttributeObject{
-											Attributes: []resource.Attribute{
-												{
-													Name: "nested_first_attr",
-												},
+									Name: "list_nested_block",
+									ListNested: &resource.ListNestedBlock{
+										NestedObject: resource.NestedBlockObject{
+											Attributes: resource.Attributes{
 												{
-													Name: "nested_second_attr",
+													Name: "write_only_attr",
+													String: &resource.StringAttribute{
+														ComputedOptionalRequired: schema.Optional,
+														WriteOnly:                pointer(true),
+													},
 												},
 											},
 										},
@@ -5358,77 +7098,89 @@ func TestSpecification_Validate_Resources(t *testing.T) {
 				},
 			},
 		},
-		"resource-attribute-and-map-nested-attribute-names-duplicated": {
+		"resource-state-upgrader-prior-version-without-version": {
 			spec: spec.Specification{
 				Resources: resource.Resources{
 					{
 						Name: "example",
 						Schema: &resource.Schema{
-							Attributes: []resource.Attribute{
+							StateUpgraders: resource.StateUpgraders{
 								{
-									Name: "first_attr",
-									MapNested: &resource.MapNestedAttribute{
-										NestedObject: resource.NestedAttributeObject{
-											Attributes: []resource.Attribute{
-												{
-													Name: "first_attr",
-												},
-											},
-										},
-									},
+									PriorVersion:     0,
+									SchemaDefinition: "upgrader.FromV0",
 								},
 							},
 						},
 					},
 				},
 			},
+			expectedError: fmt.Errorf(`resource "example" state upgrader prior version 0 must be less than schema version 0`),
 		},
-		"resource-set-nested-attribute-names-duplicated": {
+		"resource-state-upgraders-contiguous": {
 			spec: spec.Specification{
 				Resources: resource.Resources{
 					{
 						Name: "example",
 						Schema: &resource.Schema{
-							Attributes: []resource.Attribute{
+							Version: pointer(int64(2)),
+							StateUpgraders: resource.StateUpgraders{
 								{
-									Name: "first_attr",
-									SetNested: &resource.SetNestedAttribute{
-										NestedObject: resource.NestedAttributeObject{
-											Attributes: []resource.Attribute{
-												{
-													Name: "nested_attr",
-												},
-												{
-													Name: "nested_attr",
-												},
-											},
-										},
-									},
+									PriorVersion:     0,
+									SchemaDefinition: "upgrader.FromV0",
+								},
+								{
+									PriorVersion:     1,
+									SchemaDefinition: "upgrader.FromV1",
 								},
 							},
 						},
 					},
 				},
 			},
-			expectedError: fmt.Errorf(`resource "example" attribute "first_attr" attribute "nested_attr" is duplicated`),
 		},
-		"resource-set-nested-attribute-names-unique": {
+		"resource-block-path-relationship-unresolved": {
 			spec: spec.Specification{
 				Resources: resource.Resources{
 					{
 						Name: "example",
 						Schema: &resource.Schema{
-							Attributes: []resource.Attribute{
+							Attributes: resource.Attributes{
 								{
-									Name: "first_attr",
-									SetNested: &resource.SetNestedAttribute{
-										NestedObject: resource.NestedAttributeObject{
-											Attributes: []resource.Attribute{
+									Name: "attr_one",
+									Bool: &resource.BoolAttribute{
+										ComputedOptionalRequired: schema.Optional,
+									},
+								},
+							},
+							Blocks: resource.Blocks{
+								{
+									Name: "block_one",
+									ListNested: &resource.ListNestedBlock{
+										NestedObject: resource.NestedBlockObject{
+											Attributes: resource.Attributes{
 												{
-													Name: "nested_first_attr",
+													Name: "attr_two",
+													Bool: &resource.BoolAttribute{
+														ComputedOptionalRequired: schema.Optional,
+														Validators: schema.BoolValidators{
+															{
+																ConflictsWith: &schema.PathRelationshipValidator{
+																	Paths: schema.PathExpressions{
+																		"attr_one",
+																	},
+																},
+															},
+														},
+													},
 												},
-												{
-													Name: "nested_second_attr",
+											},
+										},
+										Validators: schema.ListValidators{
+											{
+												ConflictsWith: &schema.PathRelationshipValidator{
+													Paths: schema.PathExpressions{
+														"attr_one",
+													},
 												},
 											},
 										},
@@ -5439,48 +7191,66 @@ func TestSpecification_Validate_Resources(t *testing.T) {
 					},
 				},
 			},
+			expectedError: fmt.Errorf(`resource "example" block "block_one" attribute "attr_two" conflicts_with validator path "attr_one" does not resolve to an attribute or block`),
 		},
-		"resource-attribute-and-set-nested-attribute-names-duplicated": {
+		"resource-config-validator-path-unresolved": {
 			spec: spec.Specification{
 				Resources: resource.Resources{
 					{
 						Name: "example",
 						Schema: &resource.Schema{
-							Attributes: []resource.Attribute{
+							Attributes: resource.Attributes{
 								{
-									Name: "first_attr",
-									SetNested: &resource.SetNestedAttribute{
-										NestedObject: resource.NestedAttributeObject{
-											Attributes: []resource.Attribute{
-												{
-													Name: "first_attr",
-												},
-											},
+									Name: "attr_one",
+									Bool: &resource.BoolAttribute{
+										ComputedOptionalRequired: schema.Optional,
+									},
+								},
+							},
+							ConfigValidators: schema.ConfigValidators{
+								{
+									Conflicting: &schema.PathRelationshipValidator{
+										Paths: schema.PathExpressions{
+											"attr_one",
+											"block_one",
 										},
 									},
 								},
+								{
+									Custom: &schema.CustomValidator{
+										SchemaDefinition: "myresourcevalidator.Validate()",
+									},
+								},
 							},
 						},
 					},
 				},
 			},
+			expectedError: fmt.Errorf(`resource "example" conflicting config validator path "block_one" does not resolve to an attribute or block`),
 		},
-		"resource-single-nested-attribute-names-duplicated": {
+		"resource-reserved-names": {
 			spec: spec.Specification{
+				Version: spec.Version0_2,
 				Resources: resource.Resources{
 					{
 						Name: "example",
 						Schema: &resource.Schema{
-							Attributes: []resource.Attribute{
+							Attributes: resource.Attributes{
 								{
-									Name: "first_attr",
-									SingleNested: &resource.SingleNestedAttribute{
-										Attributes: []resource.Attribute{
-											{
-												Name: "nested_attr",
-											},
+									Name: "count",
+									Int64: &resource.Int64Attribute{
+										ComputedOptionalRequired: schema.Optional,
+									},
+								},
+							},
+							Blocks: resource.Blocks{
+								{
+									Name: "lifecycle",
+									SingleNested: &resource.SingleNestedBlock{
+										Blocks: resource.Blocks{
 											{
-												Name: "nested_attr",
+												Name:         "connection",
+												SingleNested: &resource.SingleNestedBlock{},
 											},
 										},
 									},
@@ -5490,24 +7260,32 @@ func TestSpecification_Validate_Resources(t *testing.T) {
 					},
 				},
 			},
-			expectedError: fmt.Errorf(`resource "example" attribute "first_attr" attribute "nested_attr" is duplicated`),
+			expectedError: fmt.Errorf(`resource "example" attribute "count" is a reserved name` + "\n" +
+				`resource "example" block "lifecycle" is a reserved name`),
 		},
-		"resource-single-nested-attribute-names-unique": {
+		"resource-reserved-names-version-0.1": {
 			spec: spec.Specification{
+				Version: spec.Version0_1,
 				Resources: resource.Resources{
 					{
 						Name: "example",
 						Schema: &resource.Schema{
-							Attributes: []resource.Attribute{
+							Attributes: resource.Attributes{
 								{
-									Name: "first_attr",
-									SingleNested: &resource.SingleNestedAttribute{
-										Attributes: []resource.Attribute{
-											{
-												Name: "nested_first_attr",
-											},
+									Name: "count",
+									Int64: &resource.Int64Attribute{
+										ComputedOptionalRequired: schema.Optional,
+									},
+								},
+							},
+							Blocks: resource.Blocks{
+								{
+									Name: "lifecycle",
+									SingleNested: &resource.SingleNestedBlock{
+										Blocks: resource.Blocks{
 											{
-												Name: "nested_second_attr",
+												Name:         "connection",
+												SingleNested: &resource.SingleNestedBlock{},
 											},
 										},
 									},
@@ -5518,49 +7296,81 @@ func TestSpecification_Validate_Resources(t *testing.T) {
 				},
 			},
 		},
-		"resource-attribute-and-single-nested-attribute-names-duplicated": {
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.spec.Validate(context.Background())
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError.Error() {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestSpecification_Validate_Diagnostics(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		spec     spec.Specification
+		expected diag.Diagnostics
+	}{
+		"data-source-names-duplicated": {
 			spec: spec.Specification{
-				Resources: resource.Resources{
+				DataSources: datasource.DataSources{
 					{
 						Name: "example",
-						Schema: &resource.Schema{
-							Attributes: []resource.Attribute{
-								{
-									Name: "first_attr",
-									SingleNested: &resource.SingleNestedAttribute{
-										Attributes: []resource.Attribute{
-											{
-												Name: "first_attr",
-											},
-										},
-									},
-								},
-							},
-						},
 					},
+					{
+						Name: "example",
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				{
+					Severity: diag.SeverityError,
+					Summary:  "Duplicated data source",
+					Detail:   "is duplicated",
+					Path:     `data source "example"`,
+					Pointer:  "/datasources/1",
 				},
 			},
 		},
-		"resource-list-nested-block-names-duplicated": {
+		"resource-config-validator-path-unresolved": {
 			spec: spec.Specification{
 				Resources: resource.Resources{
 					{
 						Name: "example",
 						Schema: &resource.Schema{
-							Blocks: []resource.Block{
+							Attributes: resource.Attributes{
 								{
-									Name: "first_block",
-									ListNested: &resource.ListNestedBlock{
-										NestedObject: resource.NestedBlockObject{
-											Blocks: []resource.Block{
-												{
-													Name: "nested_block",
-												},
-												{
-													Name: "nested_block",
-												},
-											},
-										},
+									Name:   "attr",
+									String: &resource.StringAttribute{},
+								},
+							},
+							ConfigValidators: schema.ConfigValidators{
+								{
+									Conflicting: &schema.PathRelationshipValidator{
+										Paths: schema.PathExpressions{"attr"},
+									},
+								},
+								{
+									ExactlyOneOf: &schema.PathRelationshipValidator{
+										Paths: schema.PathExpressions{"attr", "missing"},
 									},
 								},
 							},
@@ -5568,27 +7378,61 @@ func TestSpecification_Validate_Resources(t *testing.T) {
 					},
 				},
 			},
-			expectedError: fmt.Errorf(`resource "example" block "first_block" block "nested_block" is duplicated`),
+			expected: diag.Diagnostics{
+				{
+					Severity: diag.SeverityError,
+					Summary:  "Unresolved validator path",
+					Detail:   `exactly_one_of config validator path "missing" does not resolve to an attribute or block`,
+					Path:     `resource "example"`,
+					Pointer:  "/resources/0/schema/config_validators/1/exactly_one_of",
+				},
+			},
 		},
-		"resource-list-nested-block-names-unique": {
+		"function-variadic-parameter-names-duplicated": {
 			spec: spec.Specification{
-				Resources: resource.Resources{
+				Functions: function.Functions{
 					{
 						Name: "example",
-						Schema: &resource.Schema{
-							Blocks: []resource.Block{
-								{
-									Name: "first_block",
-									ListNested: &resource.ListNestedBlock{
-										NestedObject: resource.NestedBlockObject{
-											Blocks: []resource.Block{
-												{
-													Name: "nested_first_block",
-												},
-												{
-													Name: "nested_second_block",
-												},
-											},
+						Parameters: function.Parameters{
+							{
+								Name:   "param",
+								String: &function.StringParameter{},
+							},
+						},
+						VariadicParameter: &function.Parameter{
+							Name:   "param",
+							String: &function.StringParameter{},
+						},
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				{
+					Severity: diag.SeverityError,
+					Summary:  "Duplicated parameter",
+					Detail:   "is duplicated",
+					Path:     `function "example" parameter "param"`,
+					Pointer:  "/functions/0/variadic_parameter",
+				},
+			},
+		},
+		"provider-block-nested-attribute-names-duplicated": {
+			spec: spec.Specification{
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Blocks: provider.Blocks{
+							{
+								Name: "block",
+								SingleNested: &provider.SingleNestedBlock{
+									Attributes: provider.Attributes{
+										{
+											Name:   "attr",
+											String: &provider.StringAttribute{},
+										},
+										{
+											Name:   "attr",
+											String: &provider.StringAttribute{},
 										},
 									},
 								},
@@ -5597,207 +7441,330 @@ func TestSpecification_Validate_Resources(t *testing.T) {
 					},
 				},
 			},
+			expected: diag.Diagnostics{
+				{
+					Severity: diag.SeverityError,
+					Summary:  "Duplicated attribute",
+					Detail:   "is duplicated",
+					Path:     `provider "example" block "block" attribute "attr"`,
+					Pointer:  "/provider/schema/blocks/0/single_nested/attributes/1",
+				},
+			},
 		},
-		"resource-block-and-list-nested-block-names-duplicated": {
+		"resource": {
 			spec: spec.Specification{
+				Version: spec.Version0_2,
 				Resources: resource.Resources{
 					{
 						Name: "example",
 						Schema: &resource.Schema{
-							Blocks: []resource.Block{
+							Attributes: resource.Attributes{
 								{
-									Name: "first_block",
-									ListNested: &resource.ListNestedBlock{
-										NestedObject: resource.NestedBlockObject{
-											Blocks: []resource.Block{
+									Name: "count",
+									String: &resource.StringAttribute{
+										ComputedOptionalRequired: schema.Computed,
+									},
+								},
+								{
+									Name: "list_nested",
+									ListNested: &resource.ListNestedAttribute{
+										NestedObject: resource.NestedAttributeObject{
+											Attributes: resource.Attributes{
 												{
-													Name: "first_block",
+													Name: "int64",
+													Int64: &resource.Int64Attribute{
+														ComputedOptionalRequired: schema.Optional,
+														Validators: schema.Int64Validators{
+															{
+																Between: &schema.Int64BetweenValidator{
+																	Min: 10,
+																	Max: 1,
+																},
+															},
+														},
+													},
 												},
 											},
 										},
+										ComputedOptionalRequired: schema.Optional,
 									},
 								},
 							},
 						},
+						Identity: &resource.IdentitySchema{
+							Attributes: resource.IdentityAttributes{
+								{
+									Name:   "id",
+									String: &resource.IdentityStringAttribute{},
+								},
+							},
+						},
 					},
 				},
 			},
+			expected: diag.Diagnostics{
+				{
+					Severity: diag.SeverityError,
+					Summary:  "Reserved attribute name",
+					Detail:   "is a reserved name",
+					Path:     `resource "example" attribute "count"`,
+					Pointer:  "/resources/0/schema/attributes/0",
+				},
+				{
+					Severity: diag.SeverityError,
+					Summary:  "Invalid validator bounds",
+					Detail:   "between validator min 10 is greater than max 1",
+					Path:     `resource "example" attribute "list_nested" attribute "int64"`,
+					Pointer:  "/resources/0/schema/attributes/1/list_nested/nested_object/attributes/0/int64/validators/0",
+				},
+				{
+					Severity: diag.SeverityError,
+					Summary:  "Invalid import flags",
+					Detail:   "must set one of optional_for_import or required_for_import",
+					Path:     `resource "example" identity attribute "id"`,
+					Pointer:  "/resources/0/identity/attributes/0",
+				},
+			},
 		},
-		"resource-set-nested-block-names-duplicated": {
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.spec.ValidateDiagnostics(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSpecification_ValidateGoIdentifiers(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		spec          spec.Specification
+		expectedError error
+	}{
+		"unique": {
 			spec: spec.Specification{
 				Resources: resource.Resources{
 					{
 						Name: "example",
 						Schema: &resource.Schema{
-							Blocks: []resource.Block{
+							Attributes: resource.Attributes{
 								{
-									Name: "first_block",
-									SetNested: &resource.SetNestedBlock{
-										NestedObject: resource.NestedBlockObject{
-											Blocks: []resource.Block{
-												{
-													Name: "nested_block",
-												},
-												{
-													Name: "nested_block",
-												},
-											},
-										},
-									},
+									Name: "foo_bar",
+									Bool: &resource.BoolAttribute{},
+								},
+								{
+									Name: "foo_baz",
+									Bool: &resource.BoolAttribute{},
 								},
 							},
 						},
 					},
 				},
 			},
-			expectedError: fmt.Errorf(`resource "example" block "first_block" block "nested_block" is duplicated`),
 		},
-		"resource-set-nested-block-names-unique": {
+		"collisions": {
 			spec: spec.Specification{
-				Resources: resource.Resources{
+				DataSources: datasource.DataSources{
 					{
 						Name: "example",
-						Schema: &resource.Schema{
-							Blocks: []resource.Block{
+						Schema: &datasource.Schema{
+							Attributes: datasource.Attributes{
 								{
-									Name: "first_block",
-									SetNested: &resource.SetNestedBlock{
-										NestedObject: resource.NestedBlockObject{
-											Blocks: []resource.Block{
-												{
-													Name: "nested_first_block",
-												},
-												{
-													Name: "nested_second_block",
-												},
-											},
-										},
-									},
+									Name: "foo_bar",
+									Bool: &datasource.BoolAttribute{},
+								},
+								{
+									Name: "foo__bar",
+									Bool: &datasource.BoolAttribute{},
 								},
 							},
 						},
 					},
 				},
-			},
-		},
-		"resource-block-and-set-nested-block-names-duplicated": {
-			spec: spec.Specification{
-				Resources: resource.Resources{
+				EphemeralResources: ephemeralresource.EphemeralResources{
 					{
 						Name: "example",
-						Schema: &resource.Schema{
-							Blocks: []resource.Block{
+						Schema: &ephemeralresource.Schema{
+							Attributes: ephemeralresource.Attributes{
 								{
-									Name: "first_block",
-									SetNested: &resource.SetNestedBlock{
-										NestedObject: resource.NestedBlockObject{
-											Blocks: []resource.Block{
-												{
-													Name: "first_block",
-												},
-											},
-										},
-									},
+									Name: "foo_bar",
+									Bool: &ephemeralresource.BoolAttribute{},
+								},
+							},
+							Blocks: ephemeralresource.Blocks{
+								{
+									Name:         "foo_bar_",
+									SingleNested: &ephemeralresource.SingleNestedBlock{},
 								},
 							},
 						},
 					},
 				},
-			},
-		},
-		"resource-single-nested-block-names-duplicated": {
-			spec: spec.Specification{
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Attributes: provider.Attributes{
+							{
+								Name: "foo_1",
+								Bool: &provider.BoolAttribute{},
+							},
+							{
+								Name: "foo1",
+								Bool: &provider.BoolAttribute{},
+							},
+						},
+					},
+				},
 				Resources: resource.Resources{
 					{
 						Name: "example",
 						Schema: &resource.Schema{
-							Blocks: []resource.Block{
+							Blocks: resource.Blocks{
 								{
-									Name: "first_block",
-									SingleNested: &resource.SingleNestedBlock{
-										Blocks: []resource.Block{
-											{
-												Name: "nested_block",
-											},
-											{
-												Name: "nested_block",
-											},
-										},
-									},
+									Name:         "a_b",
+									SingleNested: &resource.SingleNestedBlock{},
+								},
+								{
+									Name:         "a__b",
+									SingleNested: &resource.SingleNestedBlock{},
 								},
 							},
 						},
 					},
 				},
 			},
-			expectedError: fmt.Errorf(`resource "example" block "first_block" block "nested_block" is duplicated`),
+			expectedError: fmt.Errorf(`data source "example" attribute "foo_bar" and attribute "foo__bar" both generate Go identifier "FooBar"` + "\n" +
+				`ephemeral resource "example" attribute "foo_bar" and block "foo_bar_" both generate Go identifier "FooBar"` + "\n" +
+				`provider "example" attribute "foo_1" and attribute "foo1" both generate Go identifier "Foo1"` + "\n" +
+				`resource "example" block "a_b" and block "a__b" both generate Go identifier "AB"`),
 		},
-		"resource-single-nested-block-names-unique": {
+		"collisions-functions-identities-state-upgraders": {
 			spec: spec.Specification{
-				Resources: resource.Resources{
+				Functions: function.Functions{
 					{
 						Name: "example",
-						Schema: &resource.Schema{
-							Blocks: []resource.Block{
-								{
-									Name: "first_block",
-									SingleNested: &resource.SingleNestedBlock{
-										Blocks: []resource.Block{
-											{
-												Name: "nested_first_block",
-											},
-											{
-												Name: "nested_second_block",
-											},
+						Parameters: function.Parameters{
+							{
+								Name: "param",
+								Object: &function.ObjectParameter{
+									AttributeTypes: schema.ObjectAttributeTypes{
+										{
+											Name:   "foo_bar",
+											String: &schema.StringType{},
+										},
+										{
+											Name:   "foobar_",
+											String: &schema.StringType{},
 										},
 									},
 								},
 							},
 						},
+						Return: function.Return{
+							String: &function.StringReturn{},
+						},
 					},
 				},
-			},
-		},
-		"resource-block-and-single-nested-block-names-duplicated": {
-			spec: spec.Specification{
 				Resources: resource.Resources{
 					{
 						Name: "example",
+						Identity: &resource.IdentitySchema{
+							Attributes: resource.IdentityAttributes{
+								{
+									Name:   "foo_bar",
+									String: &resource.IdentityStringAttribute{},
+								},
+								{
+									Name:   "foo__bar",
+									String: &resource.IdentityStringAttribute{},
+								},
+							},
+						},
 						Schema: &resource.Schema{
-							Blocks: []resource.Block{
+							StateUpgraders: resource.StateUpgraders{
 								{
-									Name: "first_block",
-									SingleNested: &resource.SingleNestedBlock{
-										Blocks: []resource.Block{
+									PriorVersion: 0,
+									PriorSchema: &resource.PriorSchema{
+										Attributes: resource.Attributes{
 											{
-												Name: "first_block",
+												Name: "a_b",
+												Bool: &resource.BoolAttribute{},
+											},
+											{
+												Name: "a__b",
+												Bool: &resource.BoolAttribute{},
 											},
 										},
 									},
 								},
 							},
+							Version: pointer(int64(1)),
 						},
 					},
 				},
 			},
+			expectedError: fmt.Errorf(`function "example" parameter "param" object attribute type "foo_bar" and object attribute type "foobar_" generate Go identifiers "FooBar" and "Foobar", which differ only in case` + "\n" +
+				`resource "example" state upgrader prior version 0 prior schema attribute "a_b" and attribute "a__b" both generate Go identifier "AB"` + "\n" +
+				`resource "example" identity attribute "foo_bar" and attribute "foo__bar" both generate Go identifier "FooBar"`),
 		},
-		"resource-object-attribute-type-names-duplicated": {
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.spec.ValidateGoIdentifiers(context.Background())
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError.Error() {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestSpecification_ValidateGoSyntax(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		spec          spec.Specification
+		expectedError error
+	}{
+		"valid": {
 			spec: spec.Specification{
-				Resources: resource.Resources{
+				DataSources: datasource.DataSources{
 					{
 						Name: "example",
-						Schema: &resource.Schema{
-							Attributes: []resource.Attribute{
+						Schema: &datasource.Schema{
+							Attributes: datasource.Attributes{
 								{
-									Name: "first_attr",
-									Object: &resource.ObjectAttribute{
-										AttributeTypes: []schema.ObjectAttributeType{
-											{
-												Name: "obj_attr",
-											},
-											{
-												Name: "obj_attr",
+									Name: "attr_one",
+									String: &datasource.StringAttribute{
+										ComputedOptionalRequired: schema.Computed,
+										CustomType: &schema.CustomType{
+											Import: &code.Import{
+												Path: "github.com/hashicorp/terraform-plugin-framework/types/basetypes",
 											},
+											Type:      "basetypes.StringType",
+											ValueType: "basetypes.StringValue",
 										},
 									},
 								},
@@ -5806,30 +7773,22 @@ func TestSpecification_Validate_Resources(t *testing.T) {
 					},
 				},
 			},
-			expectedError: fmt.Errorf(`resource "example" attribute "first_attr" object attribute type "obj_attr" is duplicated`),
 		},
-		"resource-object-object-attribute-type-names-duplicated": {
+		"invalid": {
 			spec: spec.Specification{
-				Resources: resource.Resources{
+				DataSources: datasource.DataSources{
 					{
 						Name: "example",
-						Schema: &resource.Schema{
-							Attributes: []resource.Attribute{
+						Schema: &datasource.Schema{
+							Attributes: datasource.Attributes{
 								{
-									Name: "first_attr",
-									Object: &resource.ObjectAttribute{
-										AttributeTypes: []schema.ObjectAttributeType{
+									Name: "attr_one",
+									String: &datasource.StringAttribute{
+										ComputedOptionalRequired: schema.Computed,
+										Validators: schema.StringValidators{
 											{
-												Name: "obj_attr",
-												Object: &schema.ObjectType{
-													AttributeTypes: []schema.ObjectAttributeType{
-														{
-															Name: "obj_obj_attr",
-														},
-														{
-															Name: "obj_obj_attr",
-														},
-													},
+												Custom: &schema.CustomValidator{
+													SchemaDefinition: "stringvalidator.LengthAtLeast(1)",
 												},
 											},
 										},
@@ -5839,31 +7798,40 @@ func TestSpecification_Validate_Resources(t *testing.T) {
 						},
 					},
 				},
-			},
-			expectedError: fmt.Errorf(`resource "example" attribute "first_attr" object attribute type "obj_attr" object attribute type "obj_obj_attr" is duplicated`),
-		},
-		"resource-object-and-object-object-attribute-type-names-duplicated": {
-			spec: spec.Specification{
-				Resources: resource.Resources{
+				Functions: function.Functions{
 					{
 						Name: "example",
-						Schema: &resource.Schema{
-							Attributes: []resource.Attribute{
-								{
-									Name: "obj_attr",
-									Object: &resource.ObjectAttribute{
-										AttributeTypes: []schema.ObjectAttributeType{
-											{
-												Name: "obj_attr",
-												Object: &schema.ObjectType{
-													AttributeTypes: []schema.ObjectAttributeType{
-														{
-															Name: "obj_attr",
-														},
-													},
-												},
-											},
-										},
+						Parameters: function.Parameters{
+							{
+								Name: "param_one",
+								String: &function.StringParameter{
+									CustomType: &schema.CustomType{
+										Type:      "myType",
+										ValueType: "my-value",
+									},
+								},
+							},
+						},
+						Return: function.Return{
+							String: &function.StringReturn{
+								CustomType: &schema.CustomType{
+									Type:      "basetypes.StringType",
+									ValueType: "myValue",
+								},
+							},
+						},
+					},
+				},
+				Provider: &provider.Provider{
+					Name: "example",
+					Schema: &provider.Schema{
+						Attributes: provider.Attributes{
+							{
+								Name: "attr_one",
+								Bool: &provider.BoolAttribute{
+									OptionalRequired: schema.Optional,
+									AssociatedExternalType: &schema.AssociatedExternalType{
+										Type: "[]",
 									},
 								},
 							},
@@ -5871,6 +7839,10 @@ func TestSpecification_Validate_Resources(t *testing.T) {
 					},
 				},
 			},
+			expectedError: fmt.Errorf(`data source "example" attribute "attr_one" custom validator schema definition "stringvalidator.LengthAtLeast(1)" references package "stringvalidator" which is not imported` + "\n" +
+				`function "example" parameter "param_one" custom type value type "my-value" is not a valid Go type expression` + "\n" +
+				`function "example" return custom type type "basetypes.StringType" references package "basetypes" which is not imported` + "\n" +
+				`provider "example" attribute "attr_one" associated external type type "[]" is not a valid Go type expression: 1:3: expected type, found newline`),
 		},
 	}
 
@@ -5879,7 +7851,7 @@ func TestSpecification_Validate_Resources(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			err := testCase.spec.Validate(context.Background())
+			err := testCase.spec.ValidateGoSyntax(context.Background())
 
 			if err != nil {
 				if testCase.expectedError == nil {
@@ -5898,6 +7870,132 @@ func TestSpecification_Validate_Resources(t *testing.T) {
 	}
 }
 
+func TestSpecification_CodeImports(t *testing.T) {
+	t.Parallel()
+
+	s := spec.Specification{
+		DataSources: datasource.DataSources{
+			{
+				Name: "example",
+				Schema: &datasource.Schema{
+					Attributes: datasource.Attributes{
+						{
+							Name: "attr_one",
+							Bool: &datasource.BoolAttribute{
+								ComputedOptionalRequired: schema.Computed,
+								CustomType: &schema.CustomType{
+									Import: &code.Import{
+										Alias: pointer("fwtype"),
+										Path:  "github.com/hashicorp/terraform-plugin-framework/types/basetypes",
+									},
+									Type:      "fwtype.BoolType",
+									ValueType: "fwtype.BoolValue",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Functions: function.Functions{
+			{
+				Name: "example",
+				Return: function.Return{
+					String: &function.StringReturn{
+						CustomType: &schema.CustomType{
+							Import: &code.Import{
+								Alias: pointer("fwtype"),
+								Path:  "example.com/fwtype",
+							},
+							Type:      "fwtype.StringType",
+							ValueType: "fwtype.StringValue",
+						},
+					},
+				},
+			},
+		},
+		Provider: &provider.Provider{
+			Name: "example",
+			Schema: &provider.Schema{
+				Attributes: provider.Attributes{
+					{
+						Name: "attr_one",
+						String: &provider.StringAttribute{
+							OptionalRequired: schema.Optional,
+							Validators: schema.StringValidators{
+								{
+									Custom: &schema.CustomValidator{
+										Imports: []code.Import{
+											{
+												Path: "github.com/hashicorp/terraform-plugin-framework/types/basetypes",
+											},
+										},
+										SchemaDefinition: "myvalidator.Validate(basetypes.StringValue{})",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resources: resource.Resources{
+			{
+				Name: "example",
+				Schema: &resource.Schema{
+					Attributes: resource.Attributes{
+						{
+							Name: "attr_one",
+							Bool: &resource.BoolAttribute{
+								ComputedOptionalRequired: schema.Computed,
+								CustomType: &schema.CustomType{
+									Import: &code.Import{
+										Alias: pointer("fwtype"),
+										Path:  "github.com/hashicorp/terraform-plugin-framework/types/basetypes",
+									},
+									Type:      "fwtype.BoolType",
+									ValueType: "fwtype.BoolValue",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expectedImports := []code.Import{
+		{
+			Alias: pointer("fwtype"),
+			Path:  "example.com/fwtype",
+		},
+		{
+			Path: "github.com/hashicorp/terraform-plugin-framework/types/basetypes",
+		},
+		{
+			Alias: pointer("fwtype"),
+			Path:  "github.com/hashicorp/terraform-plugin-framework/types/basetypes",
+		},
+	}
+
+	expectedError := fmt.Errorf(`import alias "fwtype" is assigned to multiple paths: "example.com/fwtype", "github.com/hashicorp/terraform-plugin-framework/types/basetypes"` + "\n" +
+		`import path "github.com/hashicorp/terraform-plugin-framework/types/basetypes" is assigned multiple aliases: "", "fwtype"`)
+
+	got, err := code.CollectImports(s)
+
+	if err == nil {
+		t.Fatalf("got no error, expected: %s", expectedError)
+	}
+
+	if err.Error() != expectedError.Error() {
+		t.Fatalf("expected error %q, got: %s", expectedError, err)
+	}
+
+	if diff := cmp.Diff(got, expectedImports); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
 func TestSpecification_Generate_Version0_1(t *testing.T) {
 	t.Parallel()
 
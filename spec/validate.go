@@ -6,35 +6,61 @@ package spec
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-codegen-spec/diag"
 	"github.com/xeipuuv/gojsonschema"
 )
 
 // Parse returns a Specification from the JSON document contents, or any validation errors.
+// The error is the diag.Diagnostics returned from ParseDiagnostics, if any have
+// diag.SeverityError.
 func Parse(ctx context.Context, document []byte) (Specification, error) {
-	if err := Validate(ctx, document); err != nil {
-		return Specification{}, err
+	spec, diagnostics := ParseDiagnostics(ctx, document)
+
+	return spec, diagnostics.Err()
+}
+
+// ParseDiagnostics returns a Specification from the JSON document contents, along
+// with the diagnostics found while validating the document and the Specification.
+// Each diag.Diagnostic includes the line and column of the problem within the
+// document. The Specification is empty if the document is not valid against the
+// JSON schema.
+func ParseDiagnostics(ctx context.Context, document []byte) (Specification, diag.Diagnostics) {
+	diagnostics := ValidateDiagnostics(ctx, document)
+
+	if diagnostics.HasError() {
+		return Specification{}, diagnostics
 	}
 
 	var spec Specification
 
 	if err := json.Unmarshal(document, &spec); err != nil {
-		return spec, err
-	}
-
-	if err := spec.Validate(ctx); err != nil {
-		return spec, err
+		return spec, append(diagnostics, jsonErrorDiagnostic(document, err))
 	}
 
-	return spec, nil
+	return spec, append(diagnostics, withPositions(document, spec.ValidateDiagnostics(ctx))...)
 }
 
 // Validate loads the schema version specified in the document, and validates the document.
+// The error is the diag.Diagnostics returned from ValidateDiagnostics, if any have
+// diag.SeverityError.
 func Validate(ctx context.Context, document []byte) error {
+	return ValidateDiagnostics(ctx, document).Err()
+}
+
+// ValidateDiagnostics loads the schema version specified in the document, and returns
+// the diagnostics found while validating the document. Each diag.Diagnostic includes
+// the line and column of the problem within the document.
+func ValidateDiagnostics(ctx context.Context, document []byte) diag.Diagnostics {
+	return withPositions(document, validate(ctx, document))
+}
+
+// validate returns the diagnostics of the document, without positions.
+func validate(ctx context.Context, document []byte) diag.Diagnostics {
 	if len(document) == 0 {
-		return errors.New("empty document")
+		return diag.Diagnostics{diag.NewErrorDiagnostic("", "", "Empty document", "empty document")}
 	}
 
 	documentLoader := gojsonschema.NewBytesLoader(document)
@@ -45,11 +71,11 @@ func Validate(ctx context.Context, document []byte) error {
 	}
 
 	if err := json.Unmarshal(document, &versionedDocument); err != nil {
-		return err
+		return diag.Diagnostics{jsonErrorDiagnostic(document, err)}
 	}
 
 	if versionedDocument.Version == "" {
-		return errors.New("version is required")
+		return diag.Diagnostics{diag.NewErrorDiagnostic("", "/version", "Missing version", "version is required")}
 	}
 
 	var schemaVersion []byte
@@ -57,8 +83,10 @@ func Validate(ctx context.Context, document []byte) error {
 	switch versionedDocument.Version {
 	case Version0_1:
 		schemaVersion = JSONSchemaVersion0_1
+	case Version0_2:
+		schemaVersion = JSONSchemaVersion0_2
 	default:
-		return fmt.Errorf("version: %q is unsupported", versionedDocument.Version)
+		return diag.Diagnostics{diag.NewErrorDiagnostic("", "/version", "Unsupported version", fmt.Sprintf("version: %q is unsupported", versionedDocument.Version))}
 	}
 
 	schemaLoader := gojsonschema.NewBytesLoader(schemaVersion)
@@ -66,16 +94,41 @@ func Validate(ctx context.Context, document []byte) error {
 	result, err := gojsonschema.Validate(schemaLoader, documentLoader)
 
 	if err != nil {
-		return err
+		return diag.FromError(err)
+	}
+
+	var diagnostics diag.Diagnostics
+
+	for _, resultError := range result.Errors() {
+		diagnostics = append(diagnostics, diag.NewErrorDiagnostic(fieldPath(resultError.Field()), fieldPointer(resultError.Field()), "Invalid document", resultError.Description()))
+	}
+
+	return diagnostics
+}
+
+// fieldPath returns the path of the given JSON schema error field, which is
+// empty for the (root) field.
+func fieldPath(field string) string {
+	if field == gojsonschema.STRING_CONTEXT_ROOT {
+		return ""
+	}
+
+	return field
+}
+
+// fieldPointer returns the JSON pointer of the given JSON schema error field,
+// which is either (root) or the dot separated names and indices of the field,
+// such as resources.0.schema.
+func fieldPointer(field string) string {
+	if field == gojsonschema.STRING_CONTEXT_ROOT {
+		return ""
 	}
 
-	var errs error
+	var tokens []any
 
-	if !result.Valid() {
-		for _, resultError := range result.Errors() {
-			errs = errors.Join(errors.New(resultError.String()))
-		}
+	for _, token := range strings.Split(field, ".") {
+		tokens = append(tokens, token)
 	}
 
-	return errs
+	return diag.Pointer("", tokens...)
 }
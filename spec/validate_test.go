@@ -9,6 +9,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/diag"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
 )
 
@@ -140,7 +143,7 @@ func TestValidate_Version0_1(t *testing.T) {
   },
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("datasources.0.schema: Must have at least 1 properties"),
+			expected: fmt.Errorf("datasources.0.schema Must have at least 1 properties"),
 		},
 		"resource-attributes-only": {
 			document: []byte(`{
@@ -205,7 +208,7 @@ func TestValidate_Version0_1(t *testing.T) {
   },
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("resources.0.schema: Must have at least 1 properties"),
+			expected: fmt.Errorf("resources.0.schema Must have at least 1 properties"),
 		},
 		"example": {
 			document: testReadFile("./v0.1/example.json"),
@@ -219,7 +222,7 @@ func TestValidate_Version0_1(t *testing.T) {
   ],
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("datasources.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("datasources.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"provider_name_invalid": {
 			document: []byte(`{
@@ -228,7 +231,7 @@ func TestValidate_Version0_1(t *testing.T) {
   },
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("provider.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("provider.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"resource_name_invalid": {
 			document: []byte(`{
@@ -239,7 +242,7 @@ func TestValidate_Version0_1(t *testing.T) {
   ],
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("resources.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("resources.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"datasource_bool_attribute_name_invalid": {
 			document: []byte(`{
@@ -260,7 +263,7 @@ func TestValidate_Version0_1(t *testing.T) {
   ],
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("datasources.0.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("datasources.0.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"datasource_float64_attribute_name_invalid": {
 			document: []byte(`{
@@ -281,7 +284,7 @@ func TestValidate_Version0_1(t *testing.T) {
   ],
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("datasources.0.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("datasources.0.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"datasource_int64_attribute_name_invalid": {
 			document: []byte(`{
@@ -302,7 +305,7 @@ func TestValidate_Version0_1(t *testing.T) {
   ],
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("datasources.0.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("datasources.0.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"datasource_list_attribute_name_invalid": {
 			document: []byte(`{
@@ -326,7 +329,7 @@ func TestValidate_Version0_1(t *testing.T) {
   ],
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("datasources.0.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("datasources.0.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"datasource_list_nested_attribute_name_invalid": {
 			document: []byte(`{
@@ -348,7 +351,7 @@ func TestValidate_Version0_1(t *testing.T) {
   ],
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("datasources.0.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("datasources.0.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"datasource_list_nested_block_name_invalid": {
 			document: []byte(`{
@@ -369,7 +372,7 @@ func TestValidate_Version0_1(t *testing.T) {
   ],
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("datasources.0.schema.blocks.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("datasources.0.schema.blocks.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"datasource_map_attribute_name_invalid": {
 			document: []byte(`{
@@ -393,7 +396,7 @@ func TestValidate_Version0_1(t *testing.T) {
   ],
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("datasources.0.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("datasources.0.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"datasource_map_nested_attribute_name_invalid": {
 			document: []byte(`{
@@ -415,7 +418,7 @@ func TestValidate_Version0_1(t *testing.T) {
   ],
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("datasources.0.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("datasources.0.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"datasource_number_attribute_name_invalid": {
 			document: []byte(`{
@@ -436,7 +439,7 @@ func TestValidate_Version0_1(t *testing.T) {
   ],
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("datasources.0.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("datasources.0.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"datasource_object_attribute_name_invalid": {
 			document: []byte(`{
@@ -463,7 +466,7 @@ func TestValidate_Version0_1(t *testing.T) {
   ],
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("datasources.0.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("datasources.0.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"datasource_set_attribute_name_invalid": {
 			document: []byte(`{
@@ -487,7 +490,7 @@ func TestValidate_Version0_1(t *testing.T) {
   ],
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("datasources.0.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("datasources.0.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"datasource_set_nested_attribute_name_invalid": {
 			document: []byte(`{
@@ -509,7 +512,7 @@ func TestValidate_Version0_1(t *testing.T) {
   ],
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("datasources.0.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("datasources.0.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"datasource_set_nested_block_name_invalid": {
 			document: []byte(`{
@@ -530,7 +533,7 @@ func TestValidate_Version0_1(t *testing.T) {
   ],
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("datasources.0.schema.blocks.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("datasources.0.schema.blocks.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"datasource_single_nested_attribute_name_invalid": {
 			document: []byte(`{
@@ -551,7 +554,7 @@ func TestValidate_Version0_1(t *testing.T) {
   ],
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("datasources.0.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("datasources.0.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"datasource_single_nested_block_name_invalid": {
 			document: []byte(`{
@@ -571,7 +574,7 @@ func TestValidate_Version0_1(t *testing.T) {
   ],
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("datasources.0.schema.blocks.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("datasources.0.schema.blocks.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"datasource_string_attribute_name_invalid": {
 			document: []byte(`{
@@ -592,7 +595,7 @@ func TestValidate_Version0_1(t *testing.T) {
   ],
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("datasources.0.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("datasources.0.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"provider_bool_attribute_name_invalid": {
 			document: []byte(`{
@@ -611,7 +614,7 @@ func TestValidate_Version0_1(t *testing.T) {
     },
   "version": "0.1"
   }`),
-			expected: fmt.Errorf("provider.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("provider.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"provider_float64_attribute_name_invalid": {
 			document: []byte(`{
@@ -630,7 +633,7 @@ func TestValidate_Version0_1(t *testing.T) {
     },
   "version": "0.1"
   }`),
-			expected: fmt.Errorf("provider.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("provider.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"provider_int64_attribute_name_invalid": {
 			document: []byte(`{
@@ -649,7 +652,7 @@ func TestValidate_Version0_1(t *testing.T) {
     },
   "version": "0.1"
   }`),
-			expected: fmt.Errorf("provider.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("provider.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"provider_list_attribute_name_invalid": {
 			document: []byte(`{
@@ -671,7 +674,7 @@ func TestValidate_Version0_1(t *testing.T) {
     },
   "version": "0.1"
   }`),
-			expected: fmt.Errorf("provider.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("provider.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"provider_list_nested_attribute_name_invalid": {
 			document: []byte(`{
@@ -691,7 +694,7 @@ func TestValidate_Version0_1(t *testing.T) {
     },
   "version": "0.1"
   }`),
-			expected: fmt.Errorf("provider.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("provider.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"provider_list_nested_block_name_invalid": {
 			document: []byte(`{
@@ -710,7 +713,7 @@ func TestValidate_Version0_1(t *testing.T) {
     },
   "version": "0.1"
   }`),
-			expected: fmt.Errorf("provider.schema.blocks.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("provider.schema.blocks.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"provider_map_attribute_name_invalid": {
 			document: []byte(`{
@@ -732,7 +735,7 @@ func TestValidate_Version0_1(t *testing.T) {
     },
   "version": "0.1"
   }`),
-			expected: fmt.Errorf("provider.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("provider.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"provider_map_nested_attribute_name_invalid": {
 			document: []byte(`{
@@ -752,7 +755,7 @@ func TestValidate_Version0_1(t *testing.T) {
     },
   "version": "0.1"
   }`),
-			expected: fmt.Errorf("provider.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("provider.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"provider_number_attribute_name_invalid": {
 			document: []byte(`{
@@ -771,7 +774,7 @@ func TestValidate_Version0_1(t *testing.T) {
     },
   "version": "0.1"
   }`),
-			expected: fmt.Errorf("provider.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("provider.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"provider_object_attribute_name_invalid": {
 			document: []byte(`{
@@ -796,7 +799,7 @@ func TestValidate_Version0_1(t *testing.T) {
     },
   "version": "0.1"
   }`),
-			expected: fmt.Errorf("provider.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("provider.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"provider_set_attribute_name_invalid": {
 			document: []byte(`{
@@ -818,7 +821,7 @@ func TestValidate_Version0_1(t *testing.T) {
     },
   "version": "0.1"
   }`),
-			expected: fmt.Errorf("provider.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("provider.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"provider_set_nested_attribute_name_invalid": {
 			document: []byte(`{
@@ -838,7 +841,7 @@ func TestValidate_Version0_1(t *testing.T) {
     },
   "version": "0.1"
   }`),
-			expected: fmt.Errorf("provider.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("provider.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"provider_set_nested_block_name_invalid": {
 			document: []byte(`{
@@ -857,7 +860,7 @@ func TestValidate_Version0_1(t *testing.T) {
     },
   "version": "0.1"
   }`),
-			expected: fmt.Errorf("provider.schema.blocks.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("provider.schema.blocks.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"provider_single_nested_attribute_name_invalid": {
 			document: []byte(`{
@@ -876,7 +879,7 @@ func TestValidate_Version0_1(t *testing.T) {
     },
   "version": "0.1"
   }`),
-			expected: fmt.Errorf("provider.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("provider.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"provider_single_nested_block_name_invalid": {
 			document: []byte(`{
@@ -894,7 +897,7 @@ func TestValidate_Version0_1(t *testing.T) {
     },
   "version": "0.1"
   }`),
-			expected: fmt.Errorf("provider.schema.blocks.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("provider.schema.blocks.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"provider_string_attribute_name_invalid": {
 			document: []byte(`{
@@ -913,7 +916,7 @@ func TestValidate_Version0_1(t *testing.T) {
     },
   "version": "0.1"
   }`),
-			expected: fmt.Errorf("provider.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("provider.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"resource_bool_attribute_name_invalid": {
 			document: []byte(`{
@@ -934,7 +937,7 @@ func TestValidate_Version0_1(t *testing.T) {
   ],
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("resources.0.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("resources.0.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"resource_float64_attribute_name_invalid": {
 			document: []byte(`{
@@ -955,7 +958,7 @@ func TestValidate_Version0_1(t *testing.T) {
   ],
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("resources.0.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("resources.0.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"resource_int64_attribute_name_invalid": {
 			document: []byte(`{
@@ -976,7 +979,7 @@ func TestValidate_Version0_1(t *testing.T) {
   ],
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("resources.0.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("resources.0.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"resource_list_attribute_name_invalid": {
 			document: []byte(`{
@@ -1000,7 +1003,7 @@ func TestValidate_Version0_1(t *testing.T) {
   ],
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("resources.0.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("resources.0.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"resource_list_nested_attribute_name_invalid": {
 			document: []byte(`{
@@ -1022,7 +1025,7 @@ func TestValidate_Version0_1(t *testing.T) {
   ],
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("resources.0.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("resources.0.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"resource_list_nested_block_name_invalid": {
 			document: []byte(`{
@@ -1043,7 +1046,7 @@ func TestValidate_Version0_1(t *testing.T) {
   ],
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("resources.0.schema.blocks.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("resources.0.schema.blocks.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"resource_map_attribute_name_invalid": {
 			document: []byte(`{
@@ -1067,7 +1070,7 @@ func TestValidate_Version0_1(t *testing.T) {
   ],
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("resources.0.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("resources.0.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"resource_map_nested_attribute_name_invalid": {
 			document: []byte(`{
@@ -1089,7 +1092,7 @@ func TestValidate_Version0_1(t *testing.T) {
   ],
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("resources.0.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("resources.0.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"resource_number_attribute_name_invalid": {
 			document: []byte(`{
@@ -1110,7 +1113,7 @@ func TestValidate_Version0_1(t *testing.T) {
   ],
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("resources.0.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("resources.0.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"resource_object_attribute_name_invalid": {
 			document: []byte(`{
@@ -1137,7 +1140,7 @@ func TestValidate_Version0_1(t *testing.T) {
   ],
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("resources.0.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("resources.0.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"resource_set_attribute_name_invalid": {
 			document: []byte(`{
@@ -1161,7 +1164,7 @@ func TestValidate_Version0_1(t *testing.T) {
   ],
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("resources.0.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("resources.0.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"resource_set_nested_attribute_name_invalid": {
 			document: []byte(`{
@@ -1183,7 +1186,7 @@ func TestValidate_Version0_1(t *testing.T) {
   ],
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("resources.0.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("resources.0.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"resource_set_nested_block_name_invalid": {
 			document: []byte(`{
@@ -1204,7 +1207,7 @@ func TestValidate_Version0_1(t *testing.T) {
   ],
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("resources.0.schema.blocks.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("resources.0.schema.blocks.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"resource_single_nested_attribute_name_invalid": {
 			document: []byte(`{
@@ -1225,7 +1228,7 @@ func TestValidate_Version0_1(t *testing.T) {
   ],
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("resources.0.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("resources.0.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"resource_single_nested_block_name_invalid": {
 			document: []byte(`{
@@ -1245,7 +1248,7 @@ func TestValidate_Version0_1(t *testing.T) {
   ],
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("resources.0.schema.blocks.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("resources.0.schema.blocks.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 		"resource_string_attribute_name_invalid": {
 			document: []byte(`{
@@ -1266,7 +1269,7 @@ func TestValidate_Version0_1(t *testing.T) {
   ],
   "version": "0.1"
 }`),
-			expected: fmt.Errorf("resources.0.schema.attributes.0.name: Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+			expected: fmt.Errorf("resources.0.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
 		},
 	}
 
@@ -1293,3 +1296,1085 @@ func TestValidate_Version0_1(t *testing.T) {
 		})
 	}
 }
+
+func TestValidate_Version0_2(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		document []byte
+		expected error
+	}{
+		"example": {
+			document: testReadFile("./v0.2/example.json"),
+		},
+		"function-no-return": {
+			document: []byte(`{
+  "functions": [
+    {
+      "name": "example"
+    }
+  ],
+  "provider": {
+    "name": "provider"
+  },
+  "version": "0.2"
+}`),
+			expected: fmt.Errorf("functions.0 return is required"),
+		},
+		"function-return-multiple-types": {
+			document: []byte(`{
+  "functions": [
+    {
+      "name": "example",
+      "return": {
+        "bool": {},
+        "string": {}
+      }
+    }
+  ],
+  "provider": {
+    "name": "provider"
+  },
+  "version": "0.2"
+}`),
+			expected: fmt.Errorf("functions.0.return Must validate one and only one schema (oneOf)"),
+		},
+		"function-list-parameter-element-type-missing": {
+			document: []byte(`{
+  "functions": [
+    {
+      "name": "example",
+      "parameters": [
+        {
+          "name": "list_parameter",
+          "list": {}
+        }
+      ],
+      "return": {
+        "bool": {}
+      }
+    }
+  ],
+  "provider": {
+    "name": "provider"
+  },
+  "version": "0.2"
+}`),
+			expected: fmt.Errorf("functions.0.parameters.0.list element_type is required"),
+		},
+		"function_name_invalid": {
+			document: []byte(`{
+  "functions": [
+    {
+      "name": "Example",
+      "return": {
+        "bool": {}
+      }
+    }
+  ],
+  "provider": {
+    "name": "provider"
+  },
+  "version": "0.2"
+}`),
+			expected: fmt.Errorf("functions.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+		},
+		"function_variadic_parameter_name_invalid": {
+			document: []byte(`{
+  "functions": [
+    {
+      "name": "example",
+      "return": {
+        "bool": {}
+      },
+      "variadic_parameter": {
+        "name": "Variadic",
+        "string": {}
+      }
+    }
+  ],
+  "provider": {
+    "name": "provider"
+  },
+  "version": "0.2"
+}`),
+			expected: fmt.Errorf("functions.0.variadic_parameter.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+		},
+		"resource-identity-list-attribute-nested-element-type": {
+			document: []byte(`{
+  "provider": {
+    "name": "provider"
+  },
+  "resources": [
+    {
+      "name": "example",
+      "schema": {
+        "attributes": []
+      },
+      "identity": {
+        "attributes": [
+          {
+            "name": "list_attribute",
+            "list": {
+              "element_type": {
+                "list": {
+                  "element_type": {
+                    "string": {}
+                  }
+                }
+              },
+              "required_for_import": true
+            }
+          }
+        ]
+      }
+    }
+  ],
+  "version": "0.2"
+}`),
+			expected: fmt.Errorf("resources.0.identity.attributes.0.list.element_type Additional property list is not allowed"),
+		},
+		"resource-identity-object-attribute-unsupported": {
+			document: []byte(`{
+  "provider": {
+    "name": "provider"
+  },
+  "resources": [
+    {
+      "name": "example",
+      "schema": {
+        "attributes": []
+      },
+      "identity": {
+        "attributes": [
+          {
+            "name": "object_attribute",
+            "object": {
+              "attribute_types": [],
+              "required_for_import": true
+            }
+          }
+        ]
+      }
+    }
+  ],
+  "version": "0.2"
+}`),
+			expected: fmt.Errorf("resources.0.identity.attributes.0 Additional property object is not allowed"),
+		},
+		"resource-identity-version-negative": {
+			document: []byte(`{
+  "provider": {
+    "name": "provider"
+  },
+  "resources": [
+    {
+      "name": "example",
+      "schema": {
+        "attributes": []
+      },
+      "identity": {
+        "attributes": [
+          {
+            "name": "id",
+            "string": {
+              "required_for_import": true
+            }
+          }
+        ],
+        "version": -1
+      }
+    }
+  ],
+  "version": "0.2"
+}`),
+			expected: fmt.Errorf("resources.0.identity.version Must be greater than or equal to 0"),
+		},
+		"datasource-string-attribute-write-only-unsupported": {
+			document: []byte(`{
+  "datasources": [
+    {
+      "name": "example",
+      "schema": {
+        "attributes": [
+          {
+            "name": "string_attribute",
+            "string": {
+              "computed_optional_required": "optional",
+              "write_only": true
+            }
+          }
+        ]
+      }
+    }
+  ],
+  "provider": {
+    "name": "provider"
+  },
+  "version": "0.2"
+}`),
+			expected: fmt.Errorf("datasources.0.schema.attributes.0.string Additional property write_only is not allowed"),
+		},
+		"provider-string-attribute-write-only-unsupported": {
+			document: []byte(`{
+  "provider": {
+    "name": "provider",
+    "schema": {
+      "attributes": [
+        {
+          "name": "string_attribute",
+          "string": {
+            "optional_required": "optional",
+            "write_only": true
+          }
+        }
+      ]
+    }
+  },
+  "version": "0.2"
+}`),
+			expected: fmt.Errorf("provider.schema.attributes.0.string Additional property write_only is not allowed"),
+		},
+		"resource-string-attribute-write-only": {
+			document: []byte(`{
+  "provider": {
+    "name": "provider"
+  },
+  "resources": [
+    {
+      "name": "example",
+      "schema": {
+        "attributes": [
+          {
+            "name": "string_attribute",
+            "string": {
+              "computed_optional_required": "required",
+              "write_only": true
+            }
+          }
+        ]
+      }
+    }
+  ],
+  "version": "0.2"
+}`),
+		},
+		"ephemeral-resource-attributes-only": {
+			document: []byte(`{
+  "ephemeral_resources": [
+    {
+      "name": "example",
+      "schema": {
+		"attributes": []
+      }
+    }
+  ],
+  "provider": {
+    "name": "provider"
+  },
+  "version": "0.2"
+}`),
+		},
+		"ephemeral-resource-blocks-only": {
+			document: []byte(`{
+  "ephemeral_resources": [
+    {
+      "name": "example",
+      "schema": {
+		"blocks": []
+      }
+    }
+  ],
+  "provider": {
+    "name": "provider"
+  },
+  "version": "0.2"
+}`),
+		},
+		"ephemeral-resource-no-attributes-or-blocks": {
+			document: []byte(`{
+  "ephemeral_resources": [
+    {
+      "name": "example",
+      "schema": {
+      }
+    }
+  ],
+  "provider": {
+    "name": "provider"
+  },
+  "version": "0.2"
+}`),
+			expected: fmt.Errorf("ephemeral_resources.0.schema Must have at least 1 properties"),
+		},
+		"ephemeral_resource_name_invalid": {
+			document: []byte(`{
+  "ephemeral_resources": [
+    {
+      "name": "Example"
+    }
+  ],
+  "version": "0.2"
+}`),
+			expected: fmt.Errorf("ephemeral_resources.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+		},
+		"ephemeral_resource_string_attribute_name_invalid": {
+			document: []byte(`{
+  "ephemeral_resources": [
+    {
+      "name": "example",
+      "schema": {
+        "attributes": [
+          {
+            "name": "String_attribute",
+            "string": {
+              "computed_optional_required": "optional"
+            }
+          }
+        ]
+      }
+    }
+  ],
+  "version": "0.2"
+}`),
+			expected: fmt.Errorf("ephemeral_resources.0.schema.attributes.0.name Does not match pattern '^[a-z_][a-z0-9_]*$'"),
+		},
+		"ephemeral_resource_string_attribute_plan_modifiers_unsupported": {
+			document: []byte(`{
+  "ephemeral_resources": [
+    {
+      "name": "example",
+      "schema": {
+        "attributes": [
+          {
+            "name": "string_attribute",
+            "string": {
+              "computed_optional_required": "optional",
+              "plan_modifiers": []
+            }
+          }
+        ]
+      }
+    }
+  ],
+  "provider": {
+    "name": "provider"
+  },
+  "version": "0.2"
+}`),
+			expected: fmt.Errorf("Additional property plan_modifiers is not allowed"),
+		},
+		"resource-state-upgrader-schema-definition-missing": {
+			document: []byte(`{
+  "provider": {
+    "name": "provider"
+  },
+  "resources": [
+    {
+      "name": "example",
+      "schema": {
+        "state_upgraders": [
+          {
+            "prior_version": 0
+          }
+        ],
+        "version": 1
+      }
+    }
+  ],
+  "version": "0.2"
+}`),
+			expected: fmt.Errorf("resources.0.schema.state_upgraders.0 schema_definition is required"),
+		},
+		"string-validator-multiple": {
+			document: []byte(`{
+  "provider": {
+    "name": "provider",
+    "schema": {
+      "attributes": [
+        {
+          "name": "string_attribute",
+          "string": {
+            "optional_required": "optional",
+            "validators": [
+              {
+                "length_at_least": {
+                  "min": 1
+                },
+                "length_at_most": {
+                  "max": 2
+                }
+              }
+            ]
+          }
+        }
+      ]
+    }
+  },
+  "version": "0.2"
+}`),
+			expected: fmt.Errorf("provider.schema.attributes.0.string.validators.0 Must validate one and only one schema (oneOf)"),
+		},
+		"string-validator-length-at-least-negative": {
+			document: []byte(`{
+  "provider": {
+    "name": "provider",
+    "schema": {
+      "attributes": [
+        {
+          "name": "string_attribute",
+          "string": {
+            "optional_required": "optional",
+            "validators": [
+              {
+                "length_at_least": {
+                  "min": -1
+                }
+              }
+            ]
+          }
+        }
+      ]
+    }
+  },
+  "version": "0.2"
+}`),
+			expected: fmt.Errorf("provider.schema.attributes.0.string.validators.0.length_at_least.min Must be greater than or equal to 0"),
+		},
+		"string-validator-one-of-empty": {
+			document: []byte(`{
+  "provider": {
+    "name": "provider",
+    "schema": {
+      "attributes": [
+        {
+          "name": "string_attribute",
+          "string": {
+            "optional_required": "optional",
+            "validators": [
+              {
+                "one_of": {
+                  "values": []
+                }
+              }
+            ]
+          }
+        }
+      ]
+    }
+  },
+  "version": "0.2"
+}`),
+			expected: fmt.Errorf("provider.schema.attributes.0.string.validators.0.one_of.values Array must have at least 1 items"),
+		},
+		"int64-validator-between-missing-max": {
+			document: []byte(`{
+  "provider": {
+    "name": "provider",
+    "schema": {
+      "attributes": [
+        {
+          "name": "int64_attribute",
+          "int64": {
+            "optional_required": "optional",
+            "validators": [
+              {
+                "between": {
+                  "min": 1
+                }
+              }
+            ]
+          }
+        }
+      ]
+    }
+  },
+  "version": "0.2"
+}`),
+			expected: fmt.Errorf("provider.schema.attributes.0.int64.validators.0.between max is required"),
+		},
+		"int64-validator-at-least-not-integer": {
+			document: []byte(`{
+  "provider": {
+    "name": "provider",
+    "schema": {
+      "attributes": [
+        {
+          "name": "int64_attribute",
+          "int64": {
+            "optional_required": "optional",
+            "validators": [
+              {
+                "at_least": {
+                  "min": 1.5
+                }
+              }
+            ]
+          }
+        }
+      ]
+    }
+  },
+  "version": "0.2"
+}`),
+			expected: fmt.Errorf("provider.schema.attributes.0.int64.validators.0.at_least.min Invalid type. Expected: integer, given: number"),
+		},
+		"list-validator-size-at-least-negative": {
+			document: []byte(`{
+  "provider": {
+    "name": "provider",
+    "schema": {
+      "attributes": [
+        {
+          "name": "list_attribute",
+          "list": {
+            "element_type": {
+              "string": {}
+            },
+            "optional_required": "optional",
+            "validators": [
+              {
+                "size_at_least": {
+                  "min": -1
+                }
+              }
+            ]
+          }
+        }
+      ]
+    }
+  },
+  "version": "0.2"
+}`),
+			expected: fmt.Errorf("provider.schema.attributes.0.list.validators.0.size_at_least.min Must be greater than or equal to 0"),
+		},
+		"map-validator-values-match-multiple": {
+			document: []byte(`{
+  "provider": {
+    "name": "provider",
+    "schema": {
+      "attributes": [
+        {
+          "name": "map_attribute",
+          "map": {
+            "element_type": {
+              "string": {}
+            },
+            "optional_required": "optional",
+            "validators": [
+              {
+                "values_match": {
+                  "int64": [],
+                  "string": []
+                }
+              }
+            ]
+          }
+        }
+      ]
+    }
+  },
+  "version": "0.2"
+}`),
+			expected: fmt.Errorf("provider.schema.attributes.0.map.validators.0.values_match Must validate one and only one schema (oneOf)"),
+		},
+		"path-relationship-validator-path-invalid": {
+			document: []byte(`{
+  "provider": {
+    "name": "provider",
+    "schema": {
+      "attributes": [
+        {
+          "name": "bool_attribute",
+          "bool": {
+            "optional_required": "optional",
+            "validators": [
+              {
+                "conflicts_with": {
+                  "paths": [
+                    "other..attribute"
+                  ]
+                }
+              }
+            ]
+          }
+        }
+      ]
+    }
+  },
+  "version": "0.2"
+}`),
+			expected: fmt.Errorf(`provider.schema.attributes.0.bool.validators.0.conflicts_with.paths.0 Does not match pattern '^[a-z_][a-z0-9_]*(\.[a-z_][a-z0-9_]*)*$'`),
+		},
+		"config-validator-conflicting-single-path": {
+			document: []byte(`{
+  "resources": [
+    {
+      "name": "resource",
+      "schema": {
+        "attributes": [
+          {
+            "name": "bool_attribute",
+            "bool": {
+              "computed_optional_required": "optional"
+            }
+          }
+        ],
+        "config_validators": [
+          {
+            "conflicting": {
+              "paths": [
+                "bool_attribute"
+              ]
+            }
+          }
+        ]
+      }
+    }
+  ],
+  "version": "0.2"
+}`),
+			expected: fmt.Errorf("resources.0.schema.config_validators.0.conflicting.paths Array must have at least 2 items"),
+		},
+		"datasource-requires-replace-plan-modifier-unsupported": {
+			document: []byte(`{
+  "datasources": [
+    {
+      "name": "datasource",
+      "schema": {
+        "attributes": [
+          {
+            "name": "string_attribute",
+            "string": {
+              "computed_optional_required": "optional",
+              "plan_modifiers": [
+                {
+                  "requires_replace": {}
+                }
+              ]
+            }
+          }
+        ]
+      }
+    }
+  ],
+  "version": "0.2"
+}`),
+			expected: fmt.Errorf("Additional property plan_modifiers is not allowed"),
+		},
+		"provider-use-state-for-unknown-plan-modifier-unsupported": {
+			document: []byte(`{
+  "provider": {
+    "name": "provider",
+    "schema": {
+      "attributes": [
+        {
+          "name": "string_attribute",
+          "string": {
+            "optional_required": "optional",
+            "plan_modifiers": [
+              {
+                "use_state_for_unknown": {}
+              }
+            ]
+          }
+        }
+      ]
+    }
+  },
+  "version": "0.2"
+}`),
+			expected: fmt.Errorf("Additional property plan_modifiers is not allowed"),
+		},
+		"resource-plan-modifier-multiple": {
+			document: []byte(`{
+  "resources": [
+    {
+      "name": "resource",
+      "schema": {
+        "attributes": [
+          {
+            "name": "string_attribute",
+            "string": {
+              "computed_optional_required": "computed_optional",
+              "plan_modifiers": [
+                {
+                  "requires_replace": {},
+                  "use_state_for_unknown": {}
+                }
+              ]
+            }
+          }
+        ]
+      }
+    }
+  ],
+  "version": "0.2"
+}`),
+			expected: fmt.Errorf("resources.0.schema.attributes.0.string.plan_modifiers.0 Must validate one and only one schema (oneOf)"),
+		},
+		"resource-requires-replace-plan-modifier-properties": {
+			document: []byte(`{
+  "resources": [
+    {
+      "name": "resource",
+      "schema": {
+        "attributes": [
+          {
+            "name": "string_attribute",
+            "string": {
+              "computed_optional_required": "optional",
+              "plan_modifiers": [
+                {
+                  "requires_replace": {
+                    "if": "configured"
+                  }
+                }
+              ]
+            }
+          }
+        ]
+      }
+    }
+  ],
+  "version": "0.2"
+}`),
+			expected: fmt.Errorf("Additional property if is not allowed"),
+		},
+		"resource-map-default-static-not-object": {
+			document: []byte(`{
+  "resources": [
+    {
+      "name": "resource",
+      "schema": {
+        "attributes": [
+          {
+            "name": "map_attribute",
+            "map": {
+              "computed_optional_required": "computed_optional",
+              "default": {
+                "static": ["one"]
+              },
+              "element_type": {
+                "string": {}
+              }
+            }
+          }
+        ]
+      }
+    }
+  ],
+  "version": "0.2"
+}`),
+			expected: fmt.Errorf("resources.0.schema.attributes.0.map.default.static Invalid type. Expected: object, given: array"),
+		},
+		"resource-dynamic-default-static-type-missing": {
+			document: []byte(`{
+  "resources": [
+    {
+      "name": "resource",
+      "schema": {
+        "attributes": [
+          {
+            "name": "dynamic_attribute",
+            "dynamic": {
+              "computed_optional_required": "computed_optional",
+              "default": {
+                "static": {
+                  "value": "example"
+                }
+              }
+            }
+          }
+        ]
+      }
+    }
+  ],
+  "version": "0.2"
+}`),
+			expected: fmt.Errorf("resources.0.schema.attributes.0.dynamic.default.static type is required"),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := spec.Validate(context.Background(), testCase.document)
+
+			if err != nil {
+				if testCase.expected == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expected.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expected, err)
+				}
+			}
+
+			if err == nil && testCase.expected != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expected)
+			}
+		})
+	}
+}
+
+func TestValidate_Diagnostics(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		document []byte
+		expected diag.Diagnostics
+	}{
+		"empty": {
+			expected: diag.Diagnostics{
+				{
+					Severity: diag.SeverityError,
+					Summary:  "Empty document",
+					Detail:   "empty document",
+				},
+			},
+		},
+		"version-unsupported": {
+			document: []byte(`{"version": "0.0"}`),
+			expected: diag.Diagnostics{
+				{
+					Severity: diag.SeverityError,
+					Summary:  "Unsupported version",
+					Detail:   `version: "0.0" is unsupported`,
+					Pointer:  "/version",
+					Position: diag.Position{
+						Offset: 1,
+						Line:   1,
+						Column: 2,
+					},
+				},
+			},
+		},
+		"invalid-json": {
+			document: []byte(`{
+  "version": "0.2",
+}`),
+			expected: diag.Diagnostics{
+				{
+					Severity: diag.SeverityError,
+					Summary:  "Invalid JSON",
+					Detail:   "invalid character '}' looking for beginning of object key string",
+					Position: diag.Position{
+						Offset: 22,
+						Line:   3,
+						Column: 1,
+					},
+				},
+			},
+		},
+		"function-no-return": {
+			document: []byte(`{
+  "functions": [
+    {
+      "name": "example"
+    }
+  ],
+  "provider": {
+    "name": "provider"
+  },
+  "version": "0.2"
+}`),
+			expected: diag.Diagnostics{
+				{
+					Severity: diag.SeverityError,
+					Summary:  "Invalid document",
+					Detail:   "return is required",
+					Path:     "functions.0",
+					Pointer:  "/functions/0",
+					Position: diag.Position{
+						Offset: 23,
+						Line:   3,
+						Column: 5,
+					},
+				},
+			},
+		},
+		"multiple-errors": {
+			document: []byte(`{
+  "functions": [
+    {
+      "name": "first"
+    },
+    {
+      "name": "second"
+    }
+  ],
+  "provider": {
+    "name": "provider"
+  },
+  "version": "0.2"
+}`),
+			expected: diag.Diagnostics{
+				{
+					Severity: diag.SeverityError,
+					Summary:  "Invalid document",
+					Detail:   "return is required",
+					Path:     "functions.0",
+					Pointer:  "/functions/0",
+					Position: diag.Position{
+						Offset: 23,
+						Line:   3,
+						Column: 5,
+					},
+				},
+				{
+					Severity: diag.SeverityError,
+					Summary:  "Invalid document",
+					Detail:   "return is required",
+					Path:     "functions.1",
+					Pointer:  "/functions/1",
+					Position: diag.Position{
+						Offset: 58,
+						Line:   6,
+						Column: 5,
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := spec.ValidateDiagnostics(context.Background(), testCase.document)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestParse_Diagnostics(t *testing.T) {
+	t.Parallel()
+
+	document := []byte(`{
+  "datasources": [
+    {
+      "name": "example",
+      "schema": {
+        "attributes": [
+          {
+            "name": "attr",
+            "string": {
+              "computed_optional_required": "computed"
+            }
+          }
+        ]
+      }
+    },
+    {
+      "name": "example",
+      "schema": {
+        "attributes": [
+          {
+            "name": "attr",
+            "string": {
+              "computed_optional_required": "computed"
+            }
+          }
+        ]
+      }
+    }
+  ],
+  "provider": {
+    "name": "provider",
+    "schema": {
+      "attributes": [
+        {
+          "name": "attr",
+          "string": {
+            "optional_required": "optional"
+          }
+        },
+        {
+          "name": "attr",
+          "string": {
+            "optional_required": "optional"
+          }
+        }
+      ]
+    }
+  },
+  "resources": [
+    {
+      "name": "example",
+      "schema": {
+        "attributes": [
+          {
+            "name": "count",
+            "string": {
+              "computed_optional_required": "computed"
+            }
+          }
+        ]
+      }
+    }
+  ],
+  "version": "0.2"
+}`)
+
+	expected := diag.Diagnostics{
+		{
+			Severity: diag.SeverityError,
+			Summary:  "Duplicated data source",
+			Detail:   "is duplicated",
+			Path:     `data source "example"`,
+			Pointer:  "/datasources/1",
+			Position: diag.Position{
+				Offset: 268,
+				Line:   16,
+				Column: 5,
+			},
+		},
+		{
+			Severity: diag.SeverityError,
+			Summary:  "Duplicated attribute",
+			Detail:   "is duplicated",
+			Path:     `provider "provider" attribute "attr"`,
+			Pointer:  "/provider/schema/attributes/1",
+			Position: diag.Position{
+				Offset: 722,
+				Line:   40,
+				Column: 9,
+			},
+		},
+		{
+			Severity: diag.SeverityError,
+			Summary:  "Reserved attribute name",
+			Detail:   "is a reserved name",
+			Path:     `resource "example" attribute "count"`,
+			Pointer:  "/resources/0/schema/attributes/0",
+			Position: diag.Position{
+				Offset: 957,
+				Line:   54,
+				Column: 11,
+			},
+		},
+	}
+
+	_, diagnostics := spec.ParseDiagnostics(context.Background(), document)
+
+	if diff := cmp.Diff(diagnostics, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	_, err := spec.Parse(context.Background(), document)
+
+	if diff := cmp.Diff(diag.FromError(err), expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	if !strings.HasPrefix(err.Error(), `16:5: data source "example" is duplicated`) {
+		t.Errorf("expected error to begin with position, got: %s", err)
+	}
+}